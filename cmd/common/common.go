@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -9,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/pkg/errors"
@@ -250,7 +252,7 @@ func selectP2pCreationMethod() (int, error) {
 }
 
 func createP2pKeyFromWallet(nickname, password string) (fwcryptotypes.Address, error) {
-	walletKey, err := loadWalletKey()
+	walletKey, err := LoadWalletKey()
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +297,8 @@ func createP2pKeyFromWallet(nickname, password string) (fwcryptotypes.Address, e
 	return p2pKeyAddress, nil
 }
 
-func loadWalletKey() (*fwtypes.AccountKey, error) {
+// LoadWalletKey decrypts the key of the wallet in the config, asking for the password when it is not saved in the config
+func LoadWalletKey() (*fwtypes.AccountKey, error) {
 	if setting.Config.Keys.WalletAddress == "" {
 		return nil, errors.New("no wallet specified in the config file")
 	}
@@ -352,6 +355,21 @@ func NodePP(_ *cobra.Command, _ []string) error {
 
 	closure := GetQuitChannel()
 	sig := <-closure
+	if sig == syscall.SIGTERM && setting.Config.Node.DrainTimeout > 0 {
+		utils.Logf("Quit signal detected: [%s]. Draining before shutting down, send it again to shut down at once...", sig.String())
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			select {
+			case <-closure:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		BaseServer.Drain(ctx, time.Duration(setting.Config.Node.DrainTimeout)*time.Second)
+		cancel()
+		utils.Log("Shutting down...")
+		return nil
+	}
 	utils.Logf("Quit signal detected: [%s]. Shutting down...", sig.String())
 	return nil
 }
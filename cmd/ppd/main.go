@@ -18,6 +18,9 @@ func main() {
 	verCmd := getVersionCmd()
 	exportCmd := getExportCmd()
 	cleanCmd := getCleanCmd()
+	mountCmd := getMountCmd()
+	replayCmd := getReplayCmd()
+	storageCmd := getStorageCmd()
 
 	rootCmd.AddCommand(nodeCmd)
 	rootCmd.AddCommand(terminalCmd)
@@ -25,6 +28,9 @@ func main() {
 	rootCmd.AddCommand(verCmd)
 	rootCmd.AddCommand(exportCmd)
 	rootCmd.AddCommand(cleanCmd)
+	rootCmd.AddCommand(mountCmd)
+	rootCmd.AddCommand(replayCmd)
+	rootCmd.AddCommand(storageCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
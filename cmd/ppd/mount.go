@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/stratosnet/sds/cmd/common"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/mount"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/rpc"
+)
+
+const (
+	writableFlag = "writable"
+)
+
+func getMountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mount <mountpoint>",
+		Short:   "mount the files of the node wallet as a read-only folder, the node must be running",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: terminalPreRunE,
+		RunE:    mountWallet,
+	}
+	cmd.Flags().BoolP(writableFlag, "w", false, "upload the new files copied into the folder, need to consume ozone")
+	return cmd
+}
+
+func mountWallet(cmd *cobra.Command, args []string) error {
+	writable, err := cmd.Flags().GetBool(writableFlag)
+	if err != nil {
+		return err
+	}
+	walletKey, err := common.LoadWalletKey()
+	if err != nil {
+		return errors.Wrap(err, "failed loading the wallet key")
+	}
+
+	c, err := rpc.Dial(setting.IpcEndpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed attaching to the node, is it running?")
+	}
+	defer c.Close()
+
+	client, err := mount.NewClient(c, fwtypes.WalletAddress(walletKey.Address).String(), walletKey.PrivateKey)
+	if err != nil {
+		return err
+	}
+	server, err := mount.Mount(args[0], client, writable)
+	if err != nil {
+		return err
+	}
+	utils.Log("Files of wallet", setting.Config.Keys.WalletAddress, "mounted at", args[0])
+
+	go func() {
+		sig := <-common.GetQuitChannel()
+		utils.Logf("Quit signal detected: [%s]. Unmounting...", sig.String())
+		if err := server.Unmount(); err != nil {
+			utils.ErrorLog("failed unmounting, the folder is probably in use", err)
+		}
+	}()
+	server.Wait()
+	return nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/stratosnet/sds/cmd/common"
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/capture"
+	"github.com/stratosnet/sds/pp/event"
+	"github.com/stratosnet/sds/pp/network"
+	"github.com/stratosnet/sds/pp/p2pserver"
+	"github.com/stratosnet/sds/pp/types"
+)
+
+const (
+	waitFlag = "wait"
+)
+
+func getReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <capture file>",
+		Short: "hand the messages recorded by the capture over to the event handlers of the node, offline",
+		Long: "Hand the messages received in a capture file (see [traffic.capture] in the config) over to the event " +
+			"handlers, one after the other, without connecting to the network. The replies of the handlers are " +
+			"printed instead of being sent. The node must not be running.",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: common.NodePreRunE,
+		RunE:    replay,
+	}
+	cmd.Flags().Duration(waitFlag, 3*time.Second, "time given to the work the handlers left running after the last message")
+	return cmd
+}
+
+func replay(cmd *cobra.Command, args []string) error {
+	wait, err := cmd.Flags().GetDuration(waitFlag)
+	if err != nil {
+		return err
+	}
+
+	p2pServ := &p2pserver.P2pServer{}
+	if err = p2pServ.Init(); err != nil {
+		return errors.Wrap(err, "failed init p2p server")
+	}
+	if err = utils.InitIdWorker(p2pServ.GetP2PAddress().Bytes()[0]); err != nil {
+		return err
+	}
+	event.RegisterAllEventHandlers()
+	ctx := context.WithValue(context.Background(), types.P2P_SERVER_KEY, p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, &network.Network{})
+
+	conn := &capture.ReplayConn{
+		OnWrite: func(m *msg.RelayMsgBuf) {
+			reply, err := json.Marshal(capture.NewRecord(capture.DirectionOut, m, false))
+			if err != nil {
+				utils.ErrorLog("failed encoding a reply", err)
+				return
+			}
+			fmt.Println(string(reply))
+		},
+	}
+	handled, err := capture.Replay(ctx, args[0], conn)
+	if err != nil {
+		return err
+	}
+	utils.Logf("replayed %v messages", handled)
+	time.Sleep(wait)
+	return nil
+}
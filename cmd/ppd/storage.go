@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/rpc"
+)
+
+const (
+	quickFlag             = "quick"
+	quarantineOrphansFlag = "quarantine-orphans"
+	reportMissingFlag     = "report-missing"
+)
+
+func getStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage",
+		Short: "inspect and repair the storage of the node",
+	}
+
+	fsckCmd := &cobra.Command{
+		Use:   "fsck",
+		Short: "reconcile the slices on disk with the slices the node is known to store, the node must not be running",
+		Long: "Compare the slices on disk with the slice index, which holds the slices the SP was told the node " +
+			"stores. Orphan slices are on disk but not in the index, missing slices are in the index but not on " +
+			"disk, and damaged slices are empty or, unless --quick is given, don't match their hash. The missing " +
+			"and damaged slices can be reported to the SP to be replicated again, which happens the next time " +
+			"the node starts. The slices stored before the index existed are added to it once the node has " +
+			"registered to the SP, until then no slice is called orphan.",
+		PreRunE: terminalPreRunE,
+		RunE:    checkStorage,
+	}
+	fsckCmd.Flags().Bool(quickFlag, false, "don't read the slices to check their hash, only look for empty ones")
+	fsckCmd.Flags().Bool(quarantineOrphansFlag, false, "move the orphan slices to the quarantine folder of the storage, after confirmation")
+	fsckCmd.Flags().Bool(reportMissingFlag, false, "ask the SP to replicate the missing and damaged slices again, damaged slices are moved to quarantine")
+	cmd.AddCommand(fsckCmd)
+	return cmd
+}
+
+func checkStorage(cmd *cobra.Command, _ []string) error {
+	quick, err := cmd.Flags().GetBool(quickFlag)
+	if err != nil {
+		return err
+	}
+	quarantineOrphans, err := cmd.Flags().GetBool(quarantineOrphansFlag)
+	if err != nil {
+		return err
+	}
+	reportMissing, err := cmd.Flags().GetBool(reportMissingFlag)
+	if err != nil {
+		return err
+	}
+
+	// the slices the node is receiving would show up as orphans
+	if c, err := rpc.Dial(setting.IpcEndpoint); err == nil {
+		c.Close()
+		return errors.New("the node is running, stop it before checking the storage")
+	}
+	defer func() {
+		_ = file.CloseSliceStore()
+	}()
+
+	check, err := file.CheckStorage(!quick)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%v slices on disk, %v bytes\n", check.Slices, check.Bytes)
+
+	orphans := make([]string, 0, len(check.Orphans))
+	for sliceHash := range check.Orphans {
+		orphans = append(orphans, sliceHash)
+	}
+	sort.Strings(orphans)
+	if check.Unindexed > 0 {
+		fmt.Printf("\n%v slices on disk are not in the index yet, they are added to it once the node registers to the SP\n", check.Unindexed)
+	}
+	fmt.Printf("\n%v orphan slices, on disk but unknown to the node:\n", len(orphans))
+	for _, sliceHash := range orphans {
+		fmt.Printf("  %v  %v bytes\n", sliceHash, check.Orphans[sliceHash])
+	}
+	fmt.Printf("\n%v missing slices, known to the node but not on disk:\n", len(check.Missing))
+	for _, meta := range check.Missing {
+		fmt.Printf("  %v  of file %v\n", meta.SliceHash, meta.FileHash)
+	}
+	fmt.Printf("\n%v damaged slices, empty or partial:\n", len(check.Damaged))
+	for _, meta := range check.Damaged {
+		fmt.Printf("  %v  of file %v\n", meta.SliceHash, meta.FileHash)
+	}
+	if check.Lost > 0 {
+		fmt.Printf("\n%v lost slices will be reported to the SP when the node starts\n", check.Lost)
+	}
+
+	if quarantineOrphans && len(orphans) > 0 && confirmQuarantine(len(orphans)) {
+		if err = file.QuarantineOrphanSlices(orphans); err != nil {
+			return err
+		}
+		fmt.Printf("\nMoved %v orphan slices to quarantine\n", len(orphans))
+	}
+	if reportMissing && len(check.Missing)+len(check.Damaged) > 0 {
+		if err = file.MarkSlicesLost(append(check.Missing, check.Damaged...)); err != nil {
+			return err
+		}
+		fmt.Printf("\n%v slices will be reported to the SP when the node starts\n", len(check.Missing)+len(check.Damaged))
+	}
+	return nil
+}
+
+func confirmQuarantine(orphans int) bool {
+	fmt.Printf("\nPlease confirm to move the %v orphan slices out of the storage to %v: [y/N]", orphans, file.QUARANTINE_FOLDER)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.TrimSpace(answer)
+	if answer == "Y" || answer == "y" {
+		return true
+	}
+	fmt.Println("The orphan slices are NOT moved.")
+	return false
+}
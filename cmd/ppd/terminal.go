@@ -45,15 +45,22 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 		"startmining                                                    start mining\n" +
 		"prepay <amount> <fee> [--beneficiary=<beneficiary>] [--gas=<gas>]\n" +
 		"                                                               prepay stos to get ozone\n" +
-		"put <filepath> [--isEncrypted=<isEncrypted>] [--nodeTier=<nodeTier>] [--allowHigherTier=<allowHigherTier>]\n" +
+		"put <filepath> [--isEncrypted=<isEncrypted>] [--nodeTier=<nodeTier>] [--allowHigherTier=<allowHigherTier>] [--contentDefinedChunking=<contentDefinedChunking>] [--erasureCoding=<dataSlices>+<paritySlices>] [--compress=zstd|none]\n" +
 		"                                                               upload file, need to consume ozone\n" +
+		"put -r <dirpath> [--isEncrypted=<isEncrypted>] [--nodeTier=<nodeTier>] [--allowHigherTier=<allowHigherTier>] [--contentDefinedChunking=<contentDefinedChunking>] [--erasureCoding=<dataSlices>+<paritySlices>] [--compress=zstd|none]\n" +
+		"                                                               upload every file of a directory and its manifest, need to consume ozone\n" +
 		"putstream <filepath> [--nodeTier=<nodeTier>] [--allowHigherTier=<allowHigherTier>]\n" +
 		"                                                               upload video file for streaming, need to consume ozone. (alpha version, encode format config impossible)\n" +
+		"uploads                                                        list the uploads in the journal, ongoing or paused\n" +
+		"resumeput <filehash>                                           resume a paused or interrupted upload\n" +
+		"downloads                                                      list the downloads in the journal, ongoing or paused\n" +
+		"resumeget <filehash>                                           resume a paused or interrupted download\n" +
 		"list <filename>                                                query uploaded file by self\n" +
 		"list <page id>                                                 query all files owned by the wallet, paginated\n" +
 		"delete <filehash>                                              delete file\n" +
 		"get <sdm://account/filehash> <saveAs>                          download file, need to consume ozone\n" +
 		"                                                               e.g: get sdm://st1jn9skjsnxv26mekd8eu8a8aquh34v0m4mwgahg/v05ahm50ugfjrgd3ga8mqi6bqka32ks3dooe1p9g\n" +
+		"get -r <sdm://account/manifesthash> <dest>                     download a directory uploaded by 'put -r' into dest\n" +
 		"sharefile <filehash> <duration> <is_private> [--ipfsCid=<cid>]\n" +
 		"                                                               share an uploaded file\n" +
 		"allshare                                                       list all shared files\n" +
@@ -71,7 +78,16 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 		"backupstatus <filehash>                                        get backup status of an file\n" +
 		"maintenance start <duration>                                   put the node in maintenance mode for the requested duration (in seconds)\n" +
 		"maintenance stop                                               stop the current maintenance, restart pp is required after this command is executed\n" +
+		"drain start <timeout> [--maintenance=<duration>]               refuse new slices and wait for the ongoing tasks to finish (timeout in seconds),\n" +
+		"                                                               then start a maintenance for the duration (in seconds) when requested\n" +
+		"drain stop                                                     accept new slices again\n" +
+		"drain status                                                   get the progress of the drain\n" +
 		"downgradeinfo                                                  get information of last downgrade happened on this pp node\n" +
+		"scrub [start|stop|status]                                      check the hash of the stored slices in the background, quarantine the corrupt ones\n" +
+		"                                                               and report them to SP. Without parameter, get the result of the last check\n" +
+		"reputation [clear [p2pAddress]]                                show the reputation of the nodes slices were transferred with, or clear it\n" +
+		"usage [files [count]|owners|days [count]]                      show what the stored slices are used for: the files with the most bytes, the\n" +
+		"                                                               bytes by file owner, or the slices stored each day\n" +
 		"replicas                                                       check or set the expect replicas of a file\n" +
 		"performancemeasure                                             turn on performance measurement log for 60 seconds\n" +
 		"withdraw <amount> <fee> [--targetAddr=<targetAddr>] [--gas=<gas>]\n" +
@@ -213,6 +229,22 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 		return callRpc(c, terminalId, "pausePut", param)
 	}
 
+	resumeput := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "resumePut", param)
+	}
+
+	uploads := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "uploads", param)
+	}
+
+	resumeget := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "resumeGet", param)
+	}
+
+	downloads := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "downloads", param)
+	}
+
 	cancelget := func(line string, param []string) bool {
 		return callRpc(c, terminalId, "cancelGet", param)
 	}
@@ -225,6 +257,18 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 	downgradeInfo := func(line string, param []string) bool {
 		return callRpc(c, terminalId, "downgradeInfo", param)
 	}
+	drain := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "drain", param)
+	}
+	scrub := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "scrub", param)
+	}
+	reputation := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "reputation", param)
+	}
+	usage := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "usage", param)
+	}
 	performanceMeasure := func(line string, param []string) bool {
 		return callRpc(c, terminalId, "performanceMeasure", param)
 	}
@@ -290,10 +334,18 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 
 	console.Mystdin.RegisterProcessFunc("pauseget", pauseget, true)
 	console.Mystdin.RegisterProcessFunc("pauseput", pauseput, true)
+	console.Mystdin.RegisterProcessFunc("resumeput", resumeput, true)
+	console.Mystdin.RegisterProcessFunc("uploads", uploads, false)
+	console.Mystdin.RegisterProcessFunc("resumeget", resumeget, true)
+	console.Mystdin.RegisterProcessFunc("downloads", downloads, false)
 	console.Mystdin.RegisterProcessFunc("cancelget", cancelget, true)
 	console.Mystdin.RegisterProcessFunc("monitortoken", monitortoken, true)
 	console.Mystdin.RegisterProcessFunc("maintenance", maintenance, true)
+	console.Mystdin.RegisterProcessFunc("drain", drain, true)
 	console.Mystdin.RegisterProcessFunc("downgradeinfo", downgradeInfo, true)
+	console.Mystdin.RegisterProcessFunc("scrub", scrub, true)
+	console.Mystdin.RegisterProcessFunc("reputation", reputation, true)
+	console.Mystdin.RegisterProcessFunc("usage", usage, true)
 	console.Mystdin.RegisterProcessFunc("performancemeasure", performanceMeasure, true)
 	console.Mystdin.RegisterProcessFunc("replicas", replica, true)
 	console.Mystdin.RegisterProcessFunc("withdraw", withdraw, true)
@@ -167,7 +167,7 @@ func put(cmd *cobra.Command, args []string) error {
 
 	// args[0] is the first param, instead of the subcommand "put"
 	filePath := args[0]
-	hash := file.GetFileHash(filePath, "")
+	hash := file.GetFileHash(filePath, "", "")
 	utils.Log("- start uploading the file:", filePath)
 
 	// compose request file upload params
@@ -27,8 +27,8 @@ import (
 )
 
 var (
-	maxDownloadRate uint64
-	maxUploadRate   uint64
+	// ErrNoDialBack the server didn't connect back to the server ip and port of the client during the handshake
+	ErrNoDialBack = errors.New("Timed out when reading from server channel")
 )
 
 const (
@@ -43,6 +43,8 @@ type MsgHandler struct {
 	message   msg.RelayMsgBuf
 	handler   core.HandlerFunc
 	recvStart int64
+	stream    *core.Stream // nil when the connection is not multiplexed
+	handled   func()       // gives the credit of the stream back, nil when the connection is not multiplexed
 }
 
 type onConnectFunc func(core.WriteCloser) bool
@@ -72,6 +74,9 @@ type options struct {
 	serverPort  uint16
 	contextkv   []ContextKV
 	readTimeout int64
+	transport   core.Transport
+	mux         bool
+	auth        *core.HandshakeAuth
 }
 
 // ClientOption client configuration
@@ -89,7 +94,7 @@ type ClientConn struct {
 	spbConn   net.Conn
 	once      *sync.Once
 	wg        *sync.WaitGroup
-	sendCh    chan *msg.RelayMsgBuf
+	sendQueue *core.SendQueue
 	handlerCh chan MsgHandler
 	// timing    *TimingWheel
 	mu   sync.Mutex // guards following
@@ -116,6 +121,11 @@ type ClientConn struct {
 	remoteP2pAddress string
 	writeHook        []WriteHook
 	encryptMessage   bool
+	multiplexed      bool // both nodes announced core.HandshakeFlagMux
+	authenticated    bool // the server proved it owns its p2p address
+	handshakeErr     error
+	mux              *core.MuxSession
+	fetchQueue       *core.FetchQueue // the received slice messages waiting for the Shaper
 }
 
 func ReconnectOption(rec bool) ClientOption {
@@ -194,6 +204,28 @@ func ReadDeadlineOption(timeout int64) ClientOption {
 	}
 }
 
+// TransportOption sets the transport the connection is dialed with. A connection that fails to be dialed with another
+// transport falls back to TCP, which every node supports
+func TransportOption(transport core.Transport) ClientOption {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// MuxOption multiplexes the messages of the connection over streams, when the server supports it
+func MuxOption(b bool) ClientOption {
+	return func(o *options) {
+		o.mux = b
+	}
+}
+
+// HandshakeAuthOption makes the handshake prove the p2p address of the client and check the one of the server
+func HandshakeAuthOption(auth *core.HandshakeAuth) ClientOption {
+	return func(o *options) {
+		o.auth = auth
+	}
+}
+
 func Mylog(b bool, module string, v ...interface{}) {
 	if b {
 		utils.DebugLogfWithCalldepth(5, "Client Conn: "+module+"%v", v...)
@@ -211,7 +243,7 @@ func newClientConnWithOptions(netid int64, addr string, opts options) *ClientCon
 		netid:            netid,
 		once:             &sync.Once{},
 		wg:               &sync.WaitGroup{},
-		sendCh:           make(chan *msg.RelayMsgBuf, opts.bufferSize),
+		sendQueue:        core.NewSendQueue(opts.bufferSize),
 		handlerCh:        make(chan MsgHandler, opts.bufferSize),
 		secondReadAtomA:  utils.CreateAtomicInt64(0),
 		secondReadAtomB:  utils.CreateAtomicInt64(0),
@@ -248,12 +280,18 @@ func (cc *ClientConn) GetName() string {
 	return name
 }
 
+// SetMaxDownloadRate limits the slices received from each peer, in KB/s. 0 means unlimited
 func SetMaxDownloadRate(rate uint64) {
-	maxDownloadRate = rate
+	limits := core.DefaultShaper.Limits()
+	limits.PeerFetch = rate * 1024
+	core.SetShaping(limits)
 }
 
+// SetMaxUploadRate limits the slices sent to each peer, in KB/s. 0 means unlimited
 func SetMaxUploadRate(rate uint64) {
-	maxUploadRate = rate
+	limits := core.DefaultShaper.Limits()
+	limits.PeerServe = rate * 1024
+	core.SetShaping(limits)
 }
 
 // GetIP get connection ip
@@ -274,7 +312,7 @@ func (cc *ClientConn) GetPort() string {
 func (cc *ClientConn) GetLocalAddr() string {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
-	if cc.spbConn == nil || cc.spbConn.(*net.TCPConn) == nil || cc.spbConn.LocalAddr() == nil {
+	if cc.spbConn == nil || cc.spbConn.LocalAddr() == nil {
 		return ""
 	}
 	return cc.spbConn.LocalAddr().String()
@@ -283,7 +321,7 @@ func (cc *ClientConn) GetLocalAddr() string {
 func (cc *ClientConn) GetRemoteAddr() string {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
-	if cc.spbConn == nil || cc.spbConn.(*net.TCPConn) == nil || cc.spbConn.RemoteAddr() == nil {
+	if cc.spbConn == nil || cc.spbConn.RemoteAddr() == nil {
 		return ""
 	}
 	return cc.spbConn.RemoteAddr().String()
@@ -297,6 +335,16 @@ func (cc *ClientConn) GetRemoteP2pAddress() string {
 	return cc.remoteP2pAddress
 }
 
+// HandshakeError returns why the handshake failed, if it did
+func (cc *ClientConn) HandshakeError() error {
+	return cc.handshakeErr
+}
+
+// PeerAuthenticated tells whether the server proved during the handshake that it owns its p2p address
+func (cc *ClientConn) PeerAuthenticated() bool {
+	return cc.authenticated
+}
+
 func (cc *ClientConn) SetContextValue(k, v interface{}) {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
@@ -358,13 +406,17 @@ func (cc *ClientConn) handshake() error {
 			return errors.Errorf("Handshake message too small (%v bytes)", len(tmpKeyMsg))
 		}
 	case <-time.After(utils.HandshakeTimeOut * time.Second):
-		return errors.New("Timed out when reading from server channel")
+		return ErrNoDialBack
 	}
 
 	peerPubKeyBytes := tmpKeyMsg[:fwed25519.PubKeySize]
 
 	peerPubKey := fwed25519.PubKeyFromBytes(peerPubKeyBytes)
-	peerSignature := tmpKeyMsg[fwed25519.PubKeySize:]
+	peerSignature := tmpKeyMsg[fwed25519.PubKeySize : fwed25519.PubKeySize+fwed25519.SignatureSize]
+	var serverFlags uint16
+	if flags := tmpKeyMsg[fwed25519.PubKeySize+fwed25519.SignatureSize:]; len(flags) == 2 {
+		serverFlags = binary.BigEndian.Uint16(flags)
+	}
 	if !peerPubKey.VerifySignature([]byte(core.HandshakeMessage), peerSignature) {
 		return errors.New("Invalid signature in tmp key from peer")
 	}
@@ -376,8 +428,21 @@ func (cc *ClientConn) handshake() error {
 	}
 	cc.sharedKey = sharedPrivKeyBytes
 
-	// Send local p2p address
-	encryptedMsg, err := core.Pack(sharedPrivKeyBytes, []byte(cc.GetLocalP2pAddress()))
+	// Send local p2p address, with the handshake flags supported by both nodes if the server announced its own
+	var flags uint16
+	if cc.opts.mux {
+		flags |= core.HandshakeFlagMux
+	}
+	if cc.opts.auth != nil {
+		flags |= core.HandshakeFlagAuth
+	}
+	flags &= serverFlags
+	p2pAddress := []byte(cc.GetLocalP2pAddress())
+	if flags != 0 {
+		p2pAddress = core.AppendHandshakeFlags(p2pAddress, flags)
+	}
+	cc.multiplexed = flags&core.HandshakeFlagMux != 0
+	encryptedMsg, err := core.Pack(sharedPrivKeyBytes, p2pAddress)
 	if err != nil {
 		return err
 	}
@@ -395,6 +460,12 @@ func (cc *ClientConn) handshake() error {
 		return errors.Wrap(err, "incorrect P2pAddress")
 	}
 
+	cc.authenticated, err = cc.opts.auth.Authenticate(cc.spbConn, sharedPrivKeyBytes, flags, cc.remoteP2pAddress,
+		tmpPubKeyBytes, peerPubKeyBytes, channelId)
+	if err != nil {
+		return err
+	}
+
 	return cc.spbConn.SetDeadline(time.Time{}) // Remove handshake timeout
 }
 
@@ -402,16 +473,18 @@ func (cc *ClientConn) handshake() error {
 func (cc *ClientConn) Start() {
 	cc.encryptMessage = true
 
-	tcpAddr, err := net.ResolveTCPAddr("tcp4", cc.addr)
-	if err != nil {
-		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("bad server address: %v, %v", cc.addr, err.Error()))
-		cc.ClientClose(false)
-		return
+	transport := cc.opts.transport
+	if transport == nil {
+		transport = core.TCPTransport
+	}
+	var err error
+	cc.spbConn, err = transport.Dial(cc.addr)
+	if err != nil && transport != core.TCPTransport {
+		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("failed to dial %v: %v, %v, falling back to %v", transport.Name(), cc.addr, err.Error(), core.TransportTCP))
+		cc.spbConn, err = core.TCPTransport.Dial(cc.addr)
 	}
-	cc.spbConn, err = net.DialTCP("tcp", nil, tcpAddr)
 	if err != nil {
-		utils.DebugLogf("cc.spbConn:%p", cc.spbConn)
-		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("failed to dial tcp: %v, %v", tcpAddr.String(), err.Error()))
+		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("failed to dial: %v, %v", cc.addr, err.Error()))
 		cc.ClientClose(false)
 		return
 	}
@@ -419,17 +492,25 @@ func (cc *ClientConn) Start() {
 
 	err = cc.handshake()
 	if err != nil {
+		cc.handshakeErr = err
 		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("handshake error %v -> %v, %v", cc.spbConn.LocalAddr(), cc.spbConn.RemoteAddr(), err.Error()))
 		cc.ClientClose(true)
 		return
 	}
 
-	Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("start conn %v -> %v (%v)", cc.spbConn.LocalAddr(), cc.spbConn.RemoteAddr(), cc.remoteP2pAddress))
+	Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("start conn %v -> %v (%v, authenticated: %v)", cc.spbConn.LocalAddr(), cc.spbConn.RemoteAddr(), cc.remoteP2pAddress, cc.authenticated))
 	onConnect := cc.opts.onConnect
 	if onConnect != nil {
 		onConnect(cc)
 	}
+	cc.fetchQueue = core.NewFetchQueue(cc.shapingPeer(), cc.ctx.Done())
 	loopers := []func(core.WriteCloser, *sync.WaitGroup){readLoop, writeLoop, handleLoop}
+	if cc.multiplexed {
+		cc.startMux()
+		// the dispatch loop hands the messages over to the task pool, without handleLoop
+		loopers = []func(core.WriteCloser, *sync.WaitGroup){muxLoop("read", cc.mux.ReadLoop), muxLoop("write", cc.mux.WriteLoop),
+			muxLoop("dispatch", cc.mux.DispatchLoop)}
+	}
 	for _, l := range loopers {
 		looper := l
 		cc.wg.Add(1)
@@ -486,6 +567,9 @@ func (cc *ClientConn) ClientClose(closeLowLayerConn bool) {
 		cc.cancel()
 		cc.pending = nil
 		cc.mu.Unlock()
+		if cc.mux != nil {
+			cc.mux.Close()
+		}
 
 		// wait until all go-routines exited.
 		cc.wg.Wait()
@@ -493,7 +577,7 @@ func (cc *ClientConn) ClientClose(closeLowLayerConn bool) {
 		utils.DetailLog("cc.wg.Wait() finished")
 
 		// close all channels.
-		close(cc.sendCh)
+		cc.sendQueue.Close()
 		close(cc.handlerCh)
 		if len(cc.jobs) > 0 {
 			utils.DetailLogf("cancel %v jobs, %v", len(cc.jobs), cc.GetName())
@@ -523,12 +607,15 @@ func (cc *ClientConn) Close() {
 		cc.cancel()
 		cc.pending = nil
 		cc.mu.Unlock()
+		if cc.mux != nil {
+			cc.mux.Close()
+		}
 
 		// wait until all go-routines exited.
 		cc.wg.Wait()
 
 		// close all channels.
-		close(cc.sendCh)
+		cc.sendQueue.Close()
 		close(cc.handlerCh)
 		if len(cc.jobs) > 0 {
 			utils.DetailLogf("cancel %v jobs, %v", len(cc.jobs), cc.GetName())
@@ -603,8 +690,6 @@ func asyncWrite(c *ClientConn, m *msg.RelayMsgBuf, ctx context.Context) (err err
 		}
 	}()
 
-	sendCh := c.sendCh
-
 	if m.MSGHead.ReqId == 0 {
 		reqId := core.GetReqIdFromContext(ctx)
 		if reqId == 0 {
@@ -616,8 +701,18 @@ func asyncWrite(c *ClientConn, m *msg.RelayMsgBuf, ctx context.Context) (err err
 	}
 
 	m.PacketId = core.GetPacketIdFromContext(ctx)
+	// the slices wait for the Shaper in the goroutine sending them, the other messages are sent right away
+	if core.IsShapedMsg(m.MSGHead.Cmd) {
+		core.DefaultShaper.Wait(c.shapingPeer(), core.ShapeServe, len(m.MSGData))
+	}
 
-	sendCh <- m
+	if c.mux != nil {
+		if err = c.mux.Write(m, ctx); err != nil {
+			return err
+		}
+	} else {
+		c.sendQueue.Push(m)
+	}
 	if c.opts.onWrite != nil {
 		c.opts.onWrite(ctx, m)
 	}
@@ -650,7 +745,6 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 
 	var msgH header.MessageHead
 	var msgS msg.MessageSign
-	var lr utils.LimitRate
 	var headerBytes []byte
 	var n int
 	var err error
@@ -735,12 +829,6 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 						Mylog(cc.opts.logOpen, LOG_MODULE_READLOOP, "read server body err: "+err.Error())
 						return
 					}
-					if cmd == header.RspDownloadSlice.Id {
-						if maxDownloadRate > 0 {
-							lr.SetRate(maxDownloadRate)
-							lr.Limit()
-						}
-					}
 				}
 
 				// handle the second part after all bytes are received
@@ -793,7 +881,7 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 						listenHeader = true
 						continue
 					}
-					handlerCh <- MsgHandler{*message, handler, recvStart}
+					handlerCh <- MsgHandler{*message, handler, recvStart, nil, nil}
 
 					i = 0
 					listenHeader = true
@@ -812,13 +900,13 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 
 func writeLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 	var (
-		sendCh chan *msg.RelayMsgBuf
-		cDone  <-chan struct{}
-		packet *msg.RelayMsgBuf
-		cc     *ClientConn
+		sendQueue *core.SendQueue
+		cDone     <-chan struct{}
+		packet    *msg.RelayMsgBuf
+		cc        *ClientConn
 	)
 	cc = c.(*ClientConn)
-	sendCh = c.(*ClientConn).sendCh
+	sendQueue = c.(*ClientConn).sendQueue
 	cDone = c.(*ClientConn).ctx.Done()
 	defer func() {
 		if p := recover(); p != nil {
@@ -832,23 +920,24 @@ func writeLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 	}()
 
 	for {
+		// the messages of the highest priority class are sent first
+		if packet = sendQueue.Pop(); packet != nil {
+			if err := cc.writePacket(packet); err != nil {
+				Mylog(cc.opts.logOpen, LOG_MODULE_WRITELOOP, "write packet err: "+err.Error())
+				return
+			}
+			continue
+		}
 		select {
 		case <-cDone: // connection closed
 			Mylog(cc.opts.logOpen, LOG_MODULE_WRITELOOP, "closes by conn")
 			return
-		case packet = <-sendCh:
-			if packet != nil {
-				if err := cc.writePacket(packet); err != nil {
-					Mylog(cc.opts.logOpen, LOG_MODULE_WRITELOOP, "write packet err: "+err.Error())
-					return
-				}
-			}
+		case <-sendQueue.Ready():
 		}
 	}
 }
 
 func (cc *ClientConn) writePacket(m *msg.RelayMsgBuf) error {
-	var lr utils.LimitRate
 	var encodedHeader []byte
 	var encodedData []byte
 	var err error
@@ -903,12 +992,6 @@ func (cc *ClientConn) writePacket(m *msg.RelayMsgBuf) error {
 			break
 		}
 		cc.secondWriteFlowA = cc.secondWriteAtomA.AddAndGetNew(int64(n))
-		if cmd == header.ReqUploadFileSlice.Id {
-			if maxUploadRate > 0 {
-				lr.SetRate(maxUploadRate)
-				lr.Limit()
-			}
-		}
 	}
 	writeEnd := time.Now()
 	costTime := writeEnd.Sub(writeStart).Milliseconds() + 1 // +1 in case of LT 1 ms
@@ -925,15 +1008,11 @@ func handleLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 	var (
 		cDone     <-chan struct{}
 		handlerCh chan MsgHandler
-		netID     int64
-		ctx       context.Context
 		cc        *ClientConn
 	)
 	cc = c.(*ClientConn)
 	cDone = c.(*ClientConn).ctx.Done()
 	handlerCh = c.(*ClientConn).handlerCh
-	netID = c.(*ClientConn).netid
-	ctx = c.(*ClientConn).ctx
 	log := "handler start"
 	defer func() {
 		if p := recover(); p != nil {
@@ -951,24 +1030,124 @@ func handleLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 			Mylog(cc.opts.logOpen, LOG_MODULE_HANDLELOOP, "closes by conn")
 			return
 		case msgHandler := <-handlerCh:
-			msg, handler, recvStart := msgHandler.message, msgHandler.handler, msgHandler.recvStart
-			if handler != nil {
-				core.GlobalTaskPool.Job(netID, func() {
-					ctxWithParentReqId := core.CreateContextWithParentReqId(ctx, msg.MSGHead.ReqId)
-					ctxWithRecvStart := core.CreateContextWithRecvStartTime(ctxWithParentReqId, recvStart)
-					ctx = core.CreateContextWithMessage(ctxWithRecvStart, &msg)
-					ctx = core.CreateContextWithNetID(ctx, netID)
-					ctx = core.CreateContextWithSrcP2pAddr(ctx, c.(*ClientConn).remoteP2pAddress)
-					if cc.opts.onHandle != nil {
-						cc.opts.onHandle(ctx, &msg)
-					}
-					if msgType := header.GetMsgTypeFromId(msgHandler.message.MSGHead.Cmd); msgType != nil {
-						log = msgType.Name
-					}
-					handler(ctx, c)
-				})
+			if msgType := header.GetMsgTypeFromId(msgHandler.message.MSGHead.Cmd); msgType != nil {
+				log = msgType.Name
 			}
+			cc.runHandler(msgHandler)
+		}
+	}
+}
+
+// runHandler queues the handler of the message in the task pool, once the Shaper lets it through for a slice message
+func (cc *ClientConn) runHandler(msgHandler MsgHandler) {
+	if msgHandler.handler == nil {
+		return
+	}
+	if core.IsShapedMsg(msgHandler.message.MSGHead.Cmd) && cc.fetchQueue != nil {
+		cc.fetchQueue.Add(len(msgHandler.message.MSGData), func() { cc.queueHandler(msgHandler) })
+		return
+	}
+	cc.queueHandler(msgHandler)
+}
+
+func (cc *ClientConn) queueHandler(msgHandler MsgHandler) {
+	msg, handler, recvStart, stream, handled := msgHandler.message, msgHandler.handler, msgHandler.recvStart, msgHandler.stream, msgHandler.handled
+	netID := cc.netid
+	err := core.GlobalTaskPool.Job(netID, header.GetPriorityFromId(msg.MSGHead.Cmd), func() {
+		if handled != nil {
+			defer handled()
+		}
+		ctxWithParentReqId := core.CreateContextWithParentReqId(cc.ctx, msg.MSGHead.ReqId)
+		ctxWithRecvStart := core.CreateContextWithRecvStartTime(ctxWithParentReqId, recvStart)
+		ctx := core.CreateContextWithMessage(ctxWithRecvStart, &msg)
+		ctx = core.CreateContextWithNetID(ctx, netID)
+		ctx = core.CreateContextWithSrcP2pAddr(ctx, cc.remoteP2pAddress)
+		ctx = core.CreateContextWithPeerAuthenticated(ctx, cc.authenticated)
+		if stream != nil {
+			ctx = core.CreateContextWithStream(ctx, stream)
+		}
+		if cc.opts.onHandle != nil {
+			cc.opts.onHandle(ctx, &msg)
 		}
+		handler(ctx, cc)
+	})
+	if err != nil && handled != nil {
+		handled()
+	}
+}
+
+// shapingPeer the key of the peer in the Shaper
+func (cc *ClientConn) shapingPeer() string {
+	return core.ShapingPeer(cc.remoteP2pAddress, cc.authenticated, cc.spbConn.RemoteAddr())
+}
+
+// OpenStream creates a new stream on the connection, or returns nil when the server doesn't multiplex its connections
+func (cc *ClientConn) OpenStream(priority core.StreamPriority) *core.Stream {
+	if cc.mux == nil {
+		return nil
+	}
+	return cc.mux.OpenStream(priority)
+}
+
+func (cc *ClientConn) startMux() {
+	readTimeout := time.Duration(utils.DefReadTimeOut) * time.Second
+	if cc.opts.readTimeout != 0 {
+		readTimeout = time.Duration(cc.opts.readTimeout) * time.Second
+	}
+	cc.mux = core.NewMuxSession(cc.spbConn, cc.sharedKey, true, cc.remoteP2pAddress, cc.opts.bufferSize, readTimeout, core.MuxHandlers{
+		OnRead: func(n int) {
+			cc.secondReadFlowA = cc.secondReadAtomA.AddAndGetNew(int64(n))
+		},
+		OnWrite: func(n int) {
+			cc.secondWriteFlowA = cc.secondWriteAtomA.AddAndGetNew(int64(n))
+		},
+		OnMessage: cc.handleMuxMessage,
+		OnSent: func(packet *msg.RelayMsgBuf, costTime int64) {
+			for _, c := range cc.writeHook {
+				if packet.MSGHead.Cmd == c.MessageId && c.Fn != nil {
+					c.Fn(cc.ctx, packet.PacketId, costTime, cc)
+				}
+			}
+		},
+	})
+}
+
+// handleMuxMessage does for the messages received on a stream what readLoop does once a message is received
+func (cc *ClientConn) handleMuxMessage(message *msg.RelayMsgBuf, stream *core.Stream, recvStart int64, handled func()) error {
+	if message.MSGHead.Version < cc.opts.minAppVer {
+		utils.DebugLogf("received a message with an outdated [%v] version (min version [%v])", message.MSGHead.Version, cc.opts.minAppVer)
+		handled()
+		return nil
+	}
+	if cc.opts.onRead != nil {
+		cc.opts.onRead(message)
+	}
+
+	handler := core.GetHandlerFunc(message.MSGHead.Cmd)
+	if handler == nil {
+		Mylog(cc.opts.logOpen, LOG_MODULE_READLOOP, fmt.Sprintf("no handler or onMessage() found for message: %d", message.MSGHead.Cmd))
+		handled()
+		return nil
+	}
+	cc.runHandler(MsgHandler{*message, handler, recvStart, stream, handled})
+	return nil
+}
+
+// muxLoop runs one of the loops of the multiplexing session of the connection in place of readLoop or writeLoop
+func muxLoop(name string, loop func() error) func(core.WriteCloser, *sync.WaitGroup) {
+	return func(c core.WriteCloser, wg *sync.WaitGroup) {
+		cc := c.(*ClientConn)
+		defer func() {
+			if p := recover(); p != nil {
+				Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("%v loop panics: %v", name, p))
+			}
+			wg.Done()
+			if !cc.is_active {
+				c.Close()
+			}
+		}()
+		err := loop()
+		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("%v loop closes: %v", name, err))
 	}
 }
 
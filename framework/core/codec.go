@@ -21,6 +21,8 @@ const (
 	parentReqIDCtxKey ctxkey = "parentReqId"
 	recvStartKey      ctxkey = "recvStartTime"
 	srcP2pAddrCtxKey  ctxkey = "srcP2pAddr"
+	peerAuthCtxKey    ctxkey = "peerAuthenticated"
+	streamCtxKey      ctxkey = "stream"
 )
 
 var (
@@ -127,6 +129,18 @@ func GetSrcP2pAddrFromContext(ctx context.Context) string {
 	return srcP2pAddress
 }
 
+// GetAuthenticatedSrcP2pAddrFromContext returns the p2p address of the peer when it proved during the handshake that it
+// owns it, or an empty string
+func GetAuthenticatedSrcP2pAddrFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if authenticated, ok := ctx.Value(peerAuthCtxKey).(bool); !ok || !authenticated {
+		return ""
+	}
+	return GetSrcP2pAddrFromContext(ctx)
+}
+
 func CreateContextWithReqId(ctx context.Context, reqId int64) context.Context {
 	return context.WithValue(ctx, reqIDCtxKey, reqId)
 }
@@ -155,6 +169,23 @@ func CreateContextWithSrcP2pAddr(ctx context.Context, srcP2pAddress string) cont
 	return context.WithValue(ctx, srcP2pAddrCtxKey, srcP2pAddress)
 }
 
+func CreateContextWithPeerAuthenticated(ctx context.Context, authenticated bool) context.Context {
+	return context.WithValue(ctx, peerAuthCtxKey, authenticated)
+}
+
+// CreateContextWithStream makes the messages written with the context go on the stream, when the connection is the one
+// of the stream
+func CreateContextWithStream(ctx context.Context, stream *Stream) context.Context {
+	return context.WithValue(ctx, streamCtxKey, stream)
+}
+
+func StreamFromContext(ctx context.Context) *Stream {
+	if ctx == nil || ctx.Value(streamCtxKey) == nil {
+		return nil
+	}
+	return ctx.Value(streamCtxKey).(*Stream)
+}
+
 func reqIdFromSnowFlake(snowflake int64, msgid uint8) int64 {
 	// lsb of snowflake is replaced by msg id without losing the uniqueness. There are still 8 bits in the
 	// second-lowest byte for sequence number.
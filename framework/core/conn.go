@@ -3,6 +3,7 @@ package core
 // server readloop writeloop handleloop
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"net"
@@ -25,6 +26,8 @@ type MsgHandler struct {
 	message   fwmsg.RelayMsgBuf
 	handler   HandlerFunc
 	recvStart int64
+	stream    *Stream // nil when the connection is not multiplexed
+	handled   func()  // gives the credit of the stream back, nil when the connection is not multiplexed
 }
 
 type WriteCloser interface {
@@ -44,13 +47,14 @@ var (
 )
 
 type ServerConn struct {
-	netid   int64
-	belong  *Server
-	spbConn net.Conn
+	netid     int64
+	belong    *Server
+	spbConn   net.Conn
+	transport Transport // transport of the listener that accepted spbConn, used to dial back to the peer
 
 	once      *sync.Once
 	wg        *sync.WaitGroup
-	sendCh    chan *fwmsg.RelayMsgBuf
+	sendQueue *SendQueue
 	handlerCh chan MsgHandler
 
 	mu    sync.Mutex // guards following
@@ -68,6 +72,10 @@ type ServerConn struct {
 	writeHook []WriteHook
 
 	encryptMessage bool
+	multiplexed    bool // both nodes announced HandshakeFlagMux
+	authenticated  bool // the client proved it owns its p2p address
+	mux            *MuxSession
+	fetchQueue     *FetchQueue // the received slice messages waiting for the Shaper
 }
 
 func CreateServerConn(id int64, s *Server, c net.Conn) *ServerConn {
@@ -75,9 +83,10 @@ func CreateServerConn(id int64, s *Server, c net.Conn) *ServerConn {
 		netid:     id,
 		belong:    s,
 		spbConn:   c,
+		transport: TCPTransport,
 		once:      &sync.Once{},
 		wg:        &sync.WaitGroup{},
-		sendCh:    make(chan *fwmsg.RelayMsgBuf, s.opts.bufferSize),
+		sendQueue: NewSendQueue(s.opts.bufferSize),
 		handlerCh: make(chan MsgHandler, s.opts.bufferSize),
 		heart:     time.Now().UnixNano(),
 	}
@@ -127,7 +136,7 @@ func (sc *ServerConn) GetPort() string {
 func (sc *ServerConn) GetLocalAddr() string {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
-	if sc.spbConn == nil || sc.spbConn.(*net.TCPConn) == nil || sc.spbConn.LocalAddr() == nil {
+	if sc.spbConn == nil || sc.spbConn.LocalAddr() == nil {
 		return ""
 	}
 	return sc.spbConn.LocalAddr().String()
@@ -137,7 +146,7 @@ func (sc *ServerConn) GetLocalAddr() string {
 func (sc *ServerConn) GetRemoteAddr() string {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
-	if sc.spbConn == nil || sc.spbConn.(*net.TCPConn) == nil || sc.spbConn.RemoteAddr() == nil {
+	if sc.spbConn == nil || sc.spbConn.RemoteAddr() == nil {
 		return ""
 	}
 	return sc.spbConn.RemoteAddr().String()
@@ -160,6 +169,11 @@ func (sc *ServerConn) GetRemoteP2pAddress() string {
 	return sc.remoteP2pAddress
 }
 
+// PeerAuthenticated tells whether the client proved during the handshake that it owns its p2p address
+func (sc *ServerConn) PeerAuthenticated() bool {
+	return sc.authenticated
+}
+
 func (sc *ServerConn) SetWriteHook(h []WriteHook) {
 	sc.mu.Lock()
 	sc.writeHook = h
@@ -188,23 +202,25 @@ func (sc *ServerConn) handshake() (error, bool) {
 		remoteServer := serverIP.String() + ":" + strconv.FormatUint(uint64(serverPort), 10)
 		sc.remoteNetworkAddress = remoteServer
 
-		// Open a new tcp connection to the remote addr from current conn
-		handshakeAddr, err := net.ResolveTCPAddr("tcp4", remoteServer)
-		if err != nil {
-			utils.ErrorLog("Couldn't resolve TCP address", err)
-			return err, false
-		}
-		handshakeConn, err := net.DialTCP("tcp", nil, handshakeAddr)
+		// Open a new connection to the remote addr from current conn, over the same transport
+		handshakeConn, err := sc.transport.Dial(remoteServer)
 		if err != nil {
-			utils.ErrorLog("DialTCP failed for new connection handshake", err)
+			utils.ErrorLogf("Dial over %v failed for new connection handshake: %v", sc.transport.Name(), err)
 			return err, false
 		}
 		if err = handshakeConn.SetDeadline(time.Now().Add(time.Duration(utils.HandshakeTimeOut) * time.Second)); err != nil {
 			return err, false
 		}
 
-		// Write the connection type as first fwmsg
-		firstMessage := CreateFirstMessage(ConnTypeHandshake, nil, 0, channelId)
+		// Write the connection type as first fwmsg, with the handshake flags in place of the port
+		var flags uint16
+		if sc.belong.opts.mux {
+			flags |= HandshakeFlagMux
+		}
+		if sc.belong.opts.auth != nil {
+			flags |= HandshakeFlagAuth
+		}
+		firstMessage := CreateFirstMessage(ConnTypeHandshake, nil, flags, channelId)
 		if err = WriteFull(handshakeConn, firstMessage); err != nil {
 			return err, false
 		}
@@ -256,10 +272,18 @@ func (sc *ServerConn) handshake() (error, bool) {
 		if err != nil {
 			return err, false
 		}
-		sc.remoteP2pAddress = string(p2pAddressBytes)
+		var remoteFlags uint16
+		sc.remoteP2pAddress, remoteFlags = SplitHandshakeFlags(p2pAddressBytes)
 		if _, err = fwtypes.P2PAddressFromBech32(sc.remoteP2pAddress); err != nil {
 			return errors.Wrap(err, "incorrect P2pAddress"), false
 		}
+		sc.multiplexed = flags&remoteFlags&HandshakeFlagMux != 0
+
+		sc.authenticated, err = sc.belong.opts.auth.Authenticate(sc.spbConn, sharedPrivKeyBytes, flags&remoteFlags,
+			sc.remoteP2pAddress, tmpPubKeyBytes, peerPubKeyBytes, channelId)
+		if err != nil {
+			return err, false
+		}
 
 		_ = handshakeConn.Close()
 	case ConnTypeHandshake:
@@ -269,7 +293,8 @@ func (sc *ServerConn) handshake() (error, bool) {
 			return err, false
 		}
 
-		// Write tmp key to channel for the corresponding client conn
+		// Write tmp key to channel for the corresponding client conn, followed by the handshake flags of the server
+		buffer = binary.BigEndian.AppendUint16(buffer, serverPort)
 		value, ok := HandshakeChanMap.Load(strconv.FormatUint(uint64(channelId), 10))
 		if !ok {
 			return errors.Errorf("No corresponding client conn was found for %v", sc.GetLocalAddr()), false
@@ -303,14 +328,22 @@ func (sc *ServerConn) Start() {
 		return
 	}
 
-	Mylog(sc.belong.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("start %v -> %v (%v)", sc.spbConn.LocalAddr(), sc.spbConn.RemoteAddr(), sc.remoteP2pAddress))
+	Mylog(sc.belong.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("start %v -> %v (%v, authenticated: %v)", sc.spbConn.LocalAddr(), sc.spbConn.RemoteAddr(), sc.remoteP2pAddress, sc.authenticated))
 	onConnect := sc.belong.opts.onConnect
 	if onConnect != nil {
 		onConnect(sc)
 	}
 
+	sc.fetchQueue = NewFetchQueue(sc.shapingPeer(), sc.ctx.Done())
 	loopers := []func(WriteCloser, *sync.WaitGroup){readLoop, writeLoop, handleLoop}
 	strArr := []string{"read", "write", "handle"}
+	if sc.multiplexed {
+		sc.startMux()
+		// the dispatch loop hands the messages over to the task pool, without handleLoop
+		loopers = []func(WriteCloser, *sync.WaitGroup){muxLoop("read", sc.mux.ReadLoop), muxLoop("write", sc.mux.WriteLoop),
+			muxLoop("dispatch", sc.mux.DispatchLoop)}
+		strArr = []string{"read", "write", "dispatch"}
+	}
 	for i, l := range loopers {
 		looper := l
 		sc.wg.Add(1)
@@ -338,7 +371,6 @@ func asyncWrite(c interface{}, m *fwmsg.RelayMsgBuf, ctx context.Context) (err e
 		}
 	}()
 
-	sendCh := c.(*ServerConn).sendCh
 	if m.MSGHead.ReqId == 0 {
 		reqId := GetReqIdFromContext(ctx)
 		if reqId == 0 {
@@ -349,7 +381,17 @@ func asyncWrite(c interface{}, m *fwmsg.RelayMsgBuf, ctx context.Context) (err e
 		m.MSGHead.ReqId = reqId
 	}
 	m.PacketId = GetPacketIdFromContext(ctx)
-	sendCh <- m
+	// the slices wait for the Shaper in the goroutine sending them, the other messages are sent right away
+	if IsShapedMsg(m.MSGHead.Cmd) {
+		DefaultShaper.Wait(c.(*ServerConn).shapingPeer(), ShapeServe, len(m.MSGData))
+	}
+	if mux := c.(*ServerConn).mux; mux != nil {
+		if err = mux.Write(m, ctx); err != nil {
+			return err
+		}
+	} else {
+		c.(*ServerConn).sendQueue.Push(m)
+	}
 	if c.(*ServerConn).belong.opts.onWrite != nil {
 		c.(*ServerConn).belong.opts.onWrite(ctx, m)
 	}
@@ -386,10 +428,13 @@ func (sc *ServerConn) Close() {
 		sc.mu.Lock()
 		sc.cancel()
 		sc.mu.Unlock()
+		if sc.mux != nil {
+			sc.mux.Close()
+		}
 
 		sc.wg.Wait()
 
-		close(sc.sendCh)
+		sc.sendQueue.Close()
 		close(sc.handlerCh)
 		metrics.ConnNumbers.WithLabelValues("server").Dec()
 		sc.belong.wg.Done()
@@ -563,7 +608,7 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 					if msgType := header.GetMsgTypeFromId(msgH.Cmd); msgType != nil {
 						metrics.Events.WithLabelValues(msgType.Name).Inc()
 					}
-					handlerCh <- MsgHandler{*msg, handler, recvStart}
+					handlerCh <- MsgHandler{*msg, handler, recvStart, nil, nil}
 					i = 0
 					listenHeader = true
 				} else {
@@ -581,14 +626,14 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 
 func writeLoop(c WriteCloser, wg *sync.WaitGroup) {
 	var (
-		sendCh chan *fwmsg.RelayMsgBuf
-		cDone  <-chan struct{}
-		sDone  <-chan struct{}
-		packet *fwmsg.RelayMsgBuf
-		sc     *ServerConn
+		sendQueue *SendQueue
+		cDone     <-chan struct{}
+		sDone     <-chan struct{}
+		packet    *fwmsg.RelayMsgBuf
+		sc        *ServerConn
 	)
 
-	sendCh = c.(*ServerConn).sendCh
+	sendQueue = c.(*ServerConn).sendQueue
 	cDone = c.(*ServerConn).ctx.Done()
 	sDone = c.(*ServerConn).belong.ctx.Done()
 	sc = c.(*ServerConn)
@@ -597,23 +642,10 @@ func writeLoop(c WriteCloser, wg *sync.WaitGroup) {
 			Mylog(sc.belong.opts.logOpen, LOG_MODULE_WRITELOOP, fmt.Sprintf("panics: %v", p))
 		}
 		// drain all pending messages before exit
-	OuterFor:
-		for {
-			select {
-			case packet, ok := <-sendCh:
-				// selected, not received: break from the loop
-				if !ok {
-					break OuterFor
-				}
-				// drain pending messages
-				if packet != nil {
-					if err := sc.writePacket(packet); err != nil {
-						utils.ErrorLog(err)
-						break OuterFor
-					}
-				}
-			default:
-				break OuterFor
+		for packet = sendQueue.Pop(); packet != nil; packet = sendQueue.Pop() {
+			if err := sc.writePacket(packet); err != nil {
+				utils.ErrorLog(err)
+				break
 			}
 		}
 		wg.Done()
@@ -622,6 +654,14 @@ func writeLoop(c WriteCloser, wg *sync.WaitGroup) {
 	}()
 
 	for {
+		// the messages of the highest priority class are sent first
+		if packet = sendQueue.Pop(); packet != nil {
+			if err := sc.writePacket(packet); err != nil {
+				Mylog(sc.belong.opts.logOpen, LOG_MODULE_WRITELOOP, "write packet err", err.Error())
+				return
+			}
+			continue
+		}
 		select {
 		case <-cDone: // connection closed
 			Mylog(sc.belong.opts.logOpen, LOG_MODULE_WRITELOOP, "closes by conn")
@@ -629,13 +669,7 @@ func writeLoop(c WriteCloser, wg *sync.WaitGroup) {
 		case <-sDone: // server closed
 			Mylog(sc.belong.opts.logOpen, LOG_MODULE_WRITELOOP, "closes by server")
 			return
-		case packet = <-sendCh:
-			if packet != nil {
-				if err := sc.writePacket(packet); err != nil {
-					Mylog(sc.belong.opts.logOpen, LOG_MODULE_WRITELOOP, "write packet err", err.Error())
-					return
-				}
-			}
+		case <-sendQueue.Ready():
 		}
 	}
 }
@@ -710,17 +744,12 @@ func handleLoop(c WriteCloser, wg *sync.WaitGroup) {
 		cDone     <-chan struct{}
 		sDone     <-chan struct{}
 		handlerCh chan MsgHandler
-		netID     int64
-		ctx       context.Context
-		err       error
 		sc        *ServerConn
 	)
 
 	cDone = c.(*ServerConn).ctx.Done()
 	sDone = c.(*ServerConn).belong.ctx.Done()
 	handlerCh = c.(*ServerConn).handlerCh
-	netID = c.(*ServerConn).netid
-	ctx = c.(*ServerConn).ctx
 	sc = c.(*ServerConn)
 	var log string
 	defer func() {
@@ -741,30 +770,127 @@ func handleLoop(c WriteCloser, wg *sync.WaitGroup) {
 			Mylog(sc.belong.opts.logOpen, LOG_MODULE_HANDLELOOP, "closes by server")
 			return
 		case msgHandler := <-handlerCh:
-			msg, handler, recvStart := msgHandler.message, msgHandler.handler, msgHandler.recvStart
-			if handler != nil {
-				// if askForWorker {
-				err = GlobalTaskPool.Job(netID, func() {
-					ctxWithReqId := CreateContextWithReqId(ctx, msg.MSGHead.ReqId)
-					ctxWithRecvStart := CreateContextWithRecvStartTime(ctxWithReqId, recvStart)
-					ctx := CreateContextWithMessage(ctxWithRecvStart, &msg)
-					ctx = CreateContextWithNetID(ctx, netID)
-					ctx = CreateContextWithSrcP2pAddr(ctx, sc.remoteP2pAddress)
-					if c.(*ServerConn).belong.opts.onHandle != nil {
-						ctx = context.WithValue(ctx, "conn_type", "server")
-						c.(*ServerConn).belong.opts.onHandle(ctx, &msg)
-					}
+			if msgType := header.GetMsgTypeFromId(msgHandler.message.MSGHead.Cmd); msgType != nil {
+				log = msgType.Name
+			}
+			sc.runHandler(msgHandler)
+		}
+	}
+}
 
-					if msgType := header.GetMsgTypeFromId(msgHandler.message.MSGHead.Cmd); msgType != nil {
-						log = msgType.Name
-					}
-					handler(ctx, c)
-				})
-				if err != nil {
-					utils.ErrorLog(err)
+// runHandler queues the handler of the message in the task pool, once the Shaper lets it through for a slice message
+func (sc *ServerConn) runHandler(msgHandler MsgHandler) {
+	if msgHandler.handler == nil {
+		return
+	}
+	if IsShapedMsg(msgHandler.message.MSGHead.Cmd) && sc.fetchQueue != nil {
+		sc.fetchQueue.Add(len(msgHandler.message.MSGData), func() { sc.queueHandler(msgHandler) })
+		return
+	}
+	sc.queueHandler(msgHandler)
+}
+
+func (sc *ServerConn) queueHandler(msgHandler MsgHandler) {
+	msg, handler, recvStart, stream, handled := msgHandler.message, msgHandler.handler, msgHandler.recvStart, msgHandler.stream, msgHandler.handled
+	netID := sc.netid
+	err := GlobalTaskPool.Job(netID, header.GetPriorityFromId(msg.MSGHead.Cmd), func() {
+		if handled != nil {
+			defer handled()
+		}
+		ctxWithReqId := CreateContextWithReqId(sc.ctx, msg.MSGHead.ReqId)
+		ctxWithRecvStart := CreateContextWithRecvStartTime(ctxWithReqId, recvStart)
+		ctx := CreateContextWithMessage(ctxWithRecvStart, &msg)
+		ctx = CreateContextWithNetID(ctx, netID)
+		ctx = CreateContextWithSrcP2pAddr(ctx, sc.remoteP2pAddress)
+		ctx = CreateContextWithPeerAuthenticated(ctx, sc.authenticated)
+		if stream != nil {
+			ctx = CreateContextWithStream(ctx, stream)
+		}
+		if sc.belong.opts.onHandle != nil {
+			ctx = context.WithValue(ctx, "conn_type", "server")
+			sc.belong.opts.onHandle(ctx, &msg)
+		}
+		handler(ctx, sc)
+	})
+	if err != nil {
+		utils.ErrorLog(err)
+		if handled != nil {
+			handled()
+		}
+	}
+}
+
+// shapingPeer the key of the peer in the Shaper
+func (sc *ServerConn) shapingPeer() string {
+	return ShapingPeer(sc.remoteP2pAddress, sc.authenticated, sc.spbConn.RemoteAddr())
+}
+
+// OpenStream creates a new stream on the connection, or returns nil when the peer doesn't multiplex its connections
+func (sc *ServerConn) OpenStream(priority StreamPriority) *Stream {
+	if sc.mux == nil {
+		return nil
+	}
+	return sc.mux.OpenStream(priority)
+}
+
+func (sc *ServerConn) startMux() {
+	readTimeout := time.Duration(utils.DefReadTimeOut) * time.Second
+	if sc.belong.opts.readTimeout != 0 {
+		readTimeout = time.Duration(sc.belong.opts.readTimeout) * time.Second
+	}
+	sc.mux = NewMuxSession(sc.spbConn, sc.sharedKey, false, sc.remoteP2pAddress, sc.belong.opts.bufferSize, readTimeout, MuxHandlers{
+		OnRead:    sc.increaseReadFlow,
+		OnWrite:   sc.increaseWriteFlow,
+		OnMessage: sc.handleMuxMessage,
+		OnSent: func(packet *fwmsg.RelayMsgBuf, costTime int64) {
+			for _, c := range sc.writeHook {
+				if packet.MSGHead.Cmd == c.MessageId && c.Fn != nil {
+					c.Fn(sc.ctx, packet.PacketId, costTime, sc)
 				}
 			}
-		}
+		},
+	})
+}
+
+// handleMuxMessage does for the messages received on a stream what readLoop does once a message is received
+func (sc *ServerConn) handleMuxMessage(msg *fwmsg.RelayMsgBuf, stream *Stream, recvStart int64, handled func()) error {
+	if msg.MSGHead.Version < sc.minAppVer {
+		badAppVerReqData := sc.belong.opts.onBadAppVer(msg.MSGHead.Version, msg.MSGHead.Cmd, sc.minAppVer)
+		sc.SendBadVersionMsg(badAppVerReqData)
+		return errors.New("fwmsg versions don't match")
+	}
+	if sc.belong.opts.onRead != nil {
+		sc.belong.opts.onRead(msg)
+	}
+
+	TimeRcv = time.Now().UnixMicro()
+	handler := GetHandlerFunc(msg.MSGHead.Cmd)
+	if handler == nil {
+		Mylog(sc.belong.opts.logOpen, LOG_MODULE_READLOOP, "no handler or onMessage() found for fwmsg: "+strconv.FormatUint(uint64(msg.MSGHead.Cmd), 10))
+		handled()
+		return nil
+	}
+	if msgType := header.GetMsgTypeFromId(msg.MSGHead.Cmd); msgType != nil {
+		metrics.Events.WithLabelValues(msgType.Name).Inc()
+	}
+	sc.runHandler(MsgHandler{*msg, handler, recvStart, stream, handled})
+	return nil
+}
+
+// muxLoop runs one of the loops of the multiplexing session of the connection in place of readLoop or writeLoop
+func muxLoop(name string, loop func() error) func(WriteCloser, *sync.WaitGroup) {
+	return func(c WriteCloser, wg *sync.WaitGroup) {
+		sc := c.(*ServerConn)
+		defer func() {
+			if p := recover(); p != nil {
+				Mylog(sc.belong.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("%v loop panics: %v", name, p))
+			}
+			wg.Done()
+			GoroutineMap.Delete(sc.GetName() + name)
+			c.Close()
+		}()
+		err := loop()
+		Mylog(sc.belong.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("%v loop closes: %v", name, err))
 	}
 }
 
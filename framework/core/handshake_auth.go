@@ -0,0 +1,99 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+
+	"github.com/pkg/errors"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	HandshakeAuthMessage = "sds_handshake_auth"
+	// HandshakeAuthProofSize P2P pubkey (32) + signature (64)
+	HandshakeAuthProofSize = fwed25519.PubKeySize + fwed25519.SignatureSize
+)
+
+// ErrPeerUnverified is wrapped by the errors of Verify when the registered key couldn't be checked. A peer announcing
+// HandshakeFlagAuth is rejected all the same, as its proof can't be trusted
+var ErrPeerUnverified = errors.New("peer key unverified")
+
+// HandshakeAuth proves during the handshake that the node owns the P2P address it announces, by signing the tmp keys and
+// the channel id of the handshake with its P2P key, and checks the proof of the peer.
+// Nodes supporting it announce HandshakeFlagAuth, and are rejected when their proof fails. With a peer that doesn't
+// announce it, the connection is made without proof, unless Required is set
+type HandshakeAuth struct {
+	P2pPubKey []byte
+	Sign      func(msg []byte) ([]byte, error)
+	// Verify checks that the P2P pubkey is the one registered for the P2P address. Optional. It returns an error wrapping
+	// ErrPeerUnverified when the registered key can't be found
+	Verify func(p2pAddress string, p2pPubKey []byte) error
+	// Required rejects the peers that don't authenticate
+	Required bool
+}
+
+// Authenticate exchanges the proofs with the peer once the p2p addresses are exchanged. flags are the handshake flags
+// announced by both nodes. It returns whether the peer proved it owns remoteP2pAddress
+func (a *HandshakeAuth) Authenticate(conn net.Conn, sharedKey []byte, flags uint16, remoteP2pAddress string,
+	tmpPubKey, peerTmpPubKey []byte, channelId uint32) (bool, error) {
+	if a == nil {
+		return false, nil
+	}
+	if flags&HandshakeFlagAuth == 0 {
+		if a.Required {
+			return false, errors.Errorf("peer %v didn't authenticate", remoteP2pAddress)
+		}
+		return false, nil
+	}
+
+	signature, err := a.Sign(handshakeAuthPayload(tmpPubKey, peerTmpPubKey, channelId))
+	if err != nil {
+		return false, errors.Wrap(err, "failed signing the handshake")
+	}
+	encryptedMsg, err := Pack(sharedKey, append(append([]byte{}, a.P2pPubKey...), signature...))
+	if err != nil {
+		return false, err
+	}
+	if err = WriteFull(conn, encryptedMsg); err != nil {
+		return false, err
+	}
+
+	proof, _, err := Unpack(conn, sharedKey, utils.MessageBeatLen)
+	if err != nil {
+		return false, err
+	}
+	if err = a.verifyProof(proof, remoteP2pAddress, peerTmpPubKey, tmpPubKey, channelId); err != nil {
+		return false, errors.Wrapf(err, "failed authenticating peer %v", remoteP2pAddress)
+	}
+	return true, nil
+}
+
+func (a *HandshakeAuth) verifyProof(proof []byte, p2pAddress string, signerTmpPubKey, verifierTmpPubKey []byte, channelId uint32) error {
+	if len(proof) != HandshakeAuthProofSize {
+		return errors.Errorf("invalid proof size [%v]", len(proof))
+	}
+	p2pPubKeyBytes := proof[:fwed25519.PubKeySize]
+	p2pPubKey := fwed25519.PubKeyFromBytes(p2pPubKeyBytes)
+	if fwtypes.P2PAddress(p2pPubKey.Address()).String() != p2pAddress {
+		return errors.New("P2P pubkey doesn't match the P2P address")
+	}
+	if !p2pPubKey.VerifySignature(handshakeAuthPayload(signerTmpPubKey, verifierTmpPubKey, channelId), proof[fwed25519.PubKeySize:]) {
+		return errors.New("invalid signature")
+	}
+	if a.Verify != nil {
+		return a.Verify(p2pAddress, p2pPubKeyBytes)
+	}
+	return nil
+}
+
+// handshakeAuthPayload is what a node signs: its own tmp key first, so that a proof can't be sent back to its signer
+func handshakeAuthPayload(signerTmpPubKey, verifierTmpPubKey []byte, channelId uint32) []byte {
+	payload := bytes.NewBufferString(HandshakeAuthMessage)
+	payload.Write(signerTmpPubKey)
+	payload.Write(verifierTmpPubKey)
+	return binary.BigEndian.AppendUint32(payload.Bytes(), channelId)
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+)
+
+func newHandshakeAuth(privKey *fwed25519.PrivKey) *HandshakeAuth {
+	return &HandshakeAuth{
+		P2pPubKey: privKey.PubKey().Bytes(),
+		Sign:      privKey.Sign,
+	}
+}
+
+// authenticatePair runs the authentication on both ends of a connection, a claiming to be the node of the P2P address
+// aAddress and b the node of bAddress
+func authenticatePair(t *testing.T, a, b *HandshakeAuth, aAddress, bAddress string) (error, error) {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = l.Close()
+	}()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, _ := l.Accept()
+		accepted <- conn
+	}()
+	aConn, err := net.Dial("tcp4", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bConn := <-accepted
+	defer func() {
+		_ = aConn.Close()
+		_ = bConn.Close()
+	}()
+
+	key := bytes.Repeat([]byte{7}, 32)
+	aTmpKey, bTmpKey := fwed25519.GenPrivKey().PubKey().Bytes(), fwed25519.GenPrivKey().PubKey().Bytes()
+	bErr := make(chan error, 1)
+	go func() {
+		_, err := b.Authenticate(bConn, key, HandshakeFlagAuth, aAddress, bTmpKey, aTmpKey, 42)
+		_ = bConn.Close() // unblocks a when b gave up
+		bErr <- err
+	}()
+	_, aErr := a.Authenticate(aConn, key, HandshakeFlagAuth, bAddress, aTmpKey, bTmpKey, 42)
+	return aErr, <-bErr
+}
+
+func TestHandshakeAuth(t *testing.T) {
+	aKey, bKey, otherKey := fwed25519.GenPrivKey(), fwed25519.GenPrivKey(), fwed25519.GenPrivKey()
+	aAddress := fwtypes.P2PAddress(aKey.PubKey().Address()).String()
+	bAddress := fwtypes.P2PAddress(bKey.PubKey().Address()).String()
+	otherAddress := fwtypes.P2PAddress(otherKey.PubKey().Address()).String()
+
+	aErr, bErr := authenticatePair(t, newHandshakeAuth(aKey), newHandshakeAuth(bKey), aAddress, bAddress)
+	if aErr != nil || bErr != nil {
+		t.Fatalf("authentication failed: %v, %v", aErr, bErr)
+	}
+
+	// a claims the address of another node
+	_, bErr = authenticatePair(t, newHandshakeAuth(aKey), newHandshakeAuth(bKey), otherAddress, bAddress)
+	if bErr == nil || !strings.Contains(bErr.Error(), "doesn't match") {
+		t.Fatalf("expected the impersonation to be rejected, got %v", bErr)
+	}
+
+	// the key of a isn't the registered one
+	b := newHandshakeAuth(bKey)
+	b.Verify = func(string, []byte) error {
+		return errors.New("not registered")
+	}
+	_, bErr = authenticatePair(t, newHandshakeAuth(aKey), b, aAddress, bAddress)
+	if bErr == nil || !strings.Contains(bErr.Error(), "not registered") {
+		t.Fatalf("expected the unregistered key to be rejected, got %v", bErr)
+	}
+
+	// the registered key couldn't be queried: a peer announcing the authentication fails closed
+	b.Verify = func(string, []byte) error {
+		return errors.Wrap(ErrPeerUnverified, "query failed")
+	}
+	_, bErr = authenticatePair(t, newHandshakeAuth(aKey), b, aAddress, bAddress)
+	if bErr == nil || !errors.Is(bErr, ErrPeerUnverified) {
+		t.Fatalf("expected the unverified peer to be rejected, got %v", bErr)
+	}
+
+	// a peer without authentication is connected without proof, unless required
+	if authenticated, err := b.Authenticate(nil, nil, 0, aAddress, nil, nil, 42); authenticated || err != nil {
+		t.Fatalf("expected the peer without authentication to be connected without proof, got %v, %v", authenticated, err)
+	}
+	b.Required = true
+	if _, err := b.Authenticate(nil, nil, 0, aAddress, nil, nil, 42); err == nil {
+		t.Fatal("expected the peer without authentication to be rejected")
+	}
+}
@@ -0,0 +1,574 @@
+package core
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	fwmsg "github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+// StreamPriority orders the streams of a multiplexed connection: the frames of the stream with the highest priority are
+// sent first, and its messages are handed over to the handlers first
+type StreamPriority uint8
+
+const (
+	StreamPriorityBulk StreamPriority = iota
+	StreamPriorityNormal
+	StreamPriorityControl
+)
+
+const (
+	// the messages written without a stream in their context go to one of these two streams
+	muxStreamControl uint32 = 0
+	muxStreamBulk    uint32 = 1
+
+	muxFrameData   uint8 = 1
+	muxFrameWindow uint8 = 2 // credit given back to the sender of a stream
+	muxFrameReset  uint8 = 3 // the sender aborted the message it was sending on a stream
+
+	muxFlagEnd uint8 = 1 // last frame of a message
+
+	muxFrameHeaderLen  = 7 // frame type (1) + flags (1) + priority (1) + stream id (4)
+	muxMaxFramePayload = 16 * 1024
+	muxMaxFrameLen     = muxFrameHeaderLen + muxMaxFramePayload + 64 // room for the authentication tag of the encryption
+
+	// muxMaxMessageLen the largest message carried by a stream: header, body, sign and data
+	muxMaxMessageLen = header.MsgHeaderLen + utils.MessageBeatLen
+	// muxStreamWindow how many bytes of a stream can be sent before the handlers of the peer are done with its messages.
+	// It must hold the largest message, otherwise the sender would wait for credit that is never given back
+	muxStreamWindow = 2 * muxMaxMessageLen
+	// muxMaxStreams how many streams can have a message partially received at the same time
+	muxMaxStreams = 1024
+)
+
+var errMuxClosed = errors.Wrap(utils.ErrServerClosed, "multiplexed connection closed")
+
+// Stream is a logical stream of a multiplexed connection. The messages of a stream are delivered in order, but a stream
+// never waits for the messages of the other streams: a large message is sent frame by frame, and the frames of the
+// streams with a higher priority go first.
+// A message is written on a stream by adding the stream to the context given to Write. Handlers receive the stream of
+// their message in their context, so the responses go back on the stream of the request
+type Stream struct {
+	session  *MuxSession
+	id       uint32
+	priority StreamPriority
+}
+
+func (st *Stream) Id() uint32 {
+	return st.id
+}
+
+func (st *Stream) Priority() StreamPriority {
+	return st.priority
+}
+
+// Close drops the messages written on the stream that are not sent yet. The peer discards what it received of a
+// message being sent
+func (st *Stream) Close() {
+	st.session.resetStream(st)
+}
+
+// MuxHandlers connects a multiplexing session to the connection it runs over
+type MuxHandlers struct {
+	OnRead  func(n int)
+	OnWrite func(n int)
+	// OnMessage hands a message over to its handler. handled must be called once the handler returns, or once the
+	// message is dropped: the credit of the stream is given back then, so a stream whose handler is slow stops sending.
+	// OnMessage is called for the messages of each priority class from a different goroutine. An error closes the
+	// connection
+	OnMessage func(message *fwmsg.RelayMsgBuf, stream *Stream, recvStart int64, handled func()) error
+	// OnSent is called once the last frame of a message is written
+	OnSent func(packet *fwmsg.RelayMsgBuf, costTime int64)
+}
+
+// MuxSession carries the messages of many streams over a single connection, once both nodes agreed on it during the
+// handshake. Every message is cut into frames encrypted one by one. A stream can only have muxStreamWindow bytes sent
+// and not handled yet, so a stream whose handler is slow doesn't hold back the other streams
+type MuxSession struct {
+	conn             net.Conn
+	key              []byte
+	remoteP2pAddress string
+	queueSize        int
+	readTimeout      time.Duration
+	handlers         MuxHandlers
+	control          *Stream
+	bulk             *Stream
+
+	mu       sync.Mutex // guards following
+	cond     *sync.Cond // signaled whenever a frame can be sent, a message received or room made in a queue
+	closed   bool
+	nextId   uint32
+	lastSent uint32
+	send     map[uint32]*muxSendStream
+	ctrl     [][]byte // window and reset frames, sent before the data
+	inbox    []muxInMessage
+}
+
+type muxSendStream struct {
+	id       uint32
+	priority StreamPriority
+	queue    []*fwmsg.RelayMsgBuf
+	current  *muxOutMessage
+	window   int
+}
+
+// muxOutMessage a message being sent frame by frame
+type muxOutMessage struct {
+	packet     *fwmsg.RelayMsgBuf
+	offset     int
+	writeStart time.Time
+}
+
+// muxOutFrame a frame ready to be written, with the message it ends if any
+type muxOutFrame struct {
+	frame []byte
+	sent  *muxOutMessage
+}
+
+type muxInMessage struct {
+	message   *fwmsg.RelayMsgBuf
+	stream    *Stream
+	size      int
+	recvStart int64
+}
+
+// muxPartial a message being received frame by frame
+type muxPartial struct {
+	buf       []byte
+	recvStart int64
+}
+
+type muxFrame struct {
+	frameType uint8
+	flags     uint8
+	priority  StreamPriority
+	id        uint32
+	payload   []byte
+}
+
+// NewMuxSession creates the session of a connection after its handshake. The node that dialed the connection opens the
+// streams with odd ids, the other node the streams with even ids. Up to queueSize messages wait to be sent on a stream
+func NewMuxSession(conn net.Conn, key []byte, dialer bool, remoteP2pAddress string, queueSize int, readTimeout time.Duration,
+	handlers MuxHandlers) *MuxSession {
+	s := &MuxSession{
+		conn:             conn,
+		key:              key,
+		remoteP2pAddress: remoteP2pAddress,
+		queueSize:        queueSize,
+		readTimeout:      readTimeout,
+		handlers:         handlers,
+		send:             make(map[uint32]*muxSendStream),
+	}
+	s.cond = sync.NewCond(&s.mu)
+	s.control = &Stream{session: s, id: muxStreamControl, priority: StreamPriorityControl}
+	s.bulk = &Stream{session: s, id: muxStreamBulk, priority: StreamPriorityBulk}
+	s.nextId = 2
+	if dialer {
+		s.nextId = 3
+	}
+	return s
+}
+
+// OpenStream creates a new stream. It doesn't cost anything until a message is written on it
+func (s *MuxSession) OpenStream(priority StreamPriority) *Stream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.nextId
+	s.nextId += 2
+	return &Stream{session: s, id: id, priority: priority}
+}
+
+// Write queues the message on the stream found in ctx. Without one, the messages carrying data go to the bulk stream and
+// the others to the control stream. It blocks while the queue of the stream is full
+func (s *MuxSession) Write(m *fwmsg.RelayMsgBuf, ctx context.Context) error {
+	stream := StreamFromContext(ctx)
+	if stream == nil || stream.session != s {
+		stream = s.control
+		if len(m.MSGData) > 0 || header.GetPriorityFromId(m.MSGHead.Cmd) == header.PriorityBulk {
+			stream = s.bulk
+		}
+	}
+
+	packet := &fwmsg.RelayMsgBuf{
+		MSGHead:  m.MSGHead,
+		MSGSign:  m.MSGSign,
+		PacketId: m.PacketId,
+	}
+	packet.PutIntoBuffer(m)
+	if len(m.MSGData) > 0 {
+		utils.ReleaseBuffer(m.MSGData)
+	}
+	if len(packet.MSGData) > muxMaxMessageLen {
+		packet.ReleaseAlloc()
+		return errors.Errorf("message over sized [%v]", len(packet.MSGData))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		if s.closed {
+			packet.ReleaseAlloc()
+			return errMuxClosed
+		}
+		st := s.sendStream(stream)
+		if len(st.queue) < s.queueSize {
+			st.queue = append(st.queue, packet)
+			s.cond.Broadcast()
+			return nil
+		}
+		s.cond.Wait()
+	}
+}
+
+// Close stops the loops of the session and drops the messages not sent yet
+func (s *MuxSession) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	for _, st := range s.send {
+		s.dropMessages(st)
+	}
+	s.ctrl, s.inbox = nil, nil
+	s.cond.Broadcast()
+}
+
+// WriteLoop writes the frames until the session is closed or a write fails
+func (s *MuxSession) WriteLoop() error {
+	for {
+		out, err := s.nextFrame()
+		if err != nil {
+			return err
+		}
+		encoded, err := Pack(s.key, out.frame)
+		if err != nil {
+			return errors.Wrap(err, "cannot encrypt frame")
+		}
+		_ = s.conn.SetWriteDeadline(time.Now().Add(time.Duration(utils.WriteTimeOut) * time.Second))
+		if err = WriteFull(s.conn, encoded); err != nil {
+			return errors.Wrap(err, "write frame err")
+		}
+		s.handlers.OnWrite(len(encoded))
+
+		if out.sent != nil {
+			costTime := time.Since(out.sent.writeStart).Milliseconds() + 1 // +1 in case of LT 1 ms
+			s.handlers.OnSent(out.sent.packet, costTime)
+			out.sent.packet.ReleaseAlloc()
+		}
+	}
+}
+
+// ReadLoop reads the frames and puts the complete messages in the inbox, until a read fails
+func (s *MuxSession) ReadLoop() error {
+	partials := make(map[uint32]*muxPartial)
+	for {
+		_ = s.conn.SetReadDeadline(time.Now().Add(s.readTimeout))
+		plaintext, n, err := Unpack(s.conn, s.key, muxMaxFrameLen)
+		s.handlers.OnRead(n)
+		if err != nil {
+			return errors.Wrap(err, "read frame err")
+		}
+		frame, err := decodeMuxFrame(plaintext)
+		if err != nil {
+			return err
+		}
+
+		switch frame.frameType {
+		case muxFrameData:
+			p := partials[frame.id]
+			if p == nil {
+				if len(partials) >= muxMaxStreams {
+					return errors.Errorf("too many streams receiving a message [%v]", len(partials))
+				}
+				p = &muxPartial{recvStart: time.Now().UnixMilli()}
+				partials[frame.id] = p
+			}
+			if len(p.buf)+len(frame.payload) > muxMaxMessageLen {
+				return errors.Errorf("message over sized on stream [%v]", frame.id)
+			}
+			p.buf = append(p.buf, frame.payload...)
+			if frame.flags&muxFlagEnd == 0 {
+				continue
+			}
+
+			delete(partials, frame.id)
+			priority := frame.priority
+			if priority > StreamPriorityControl {
+				priority = StreamPriorityControl
+			}
+			stream := &Stream{session: s, id: frame.id, priority: priority}
+			message, err := decodeMuxMessage(p.buf, s.remoteP2pAddress)
+			if err != nil {
+				utils.DebugLogf("dropped a message received on stream [%v] from %v: %v", frame.id, s.remoteP2pAddress, err.Error())
+				s.giveCredit(stream, len(p.buf))
+				continue
+			}
+			s.mu.Lock()
+			s.inbox = append(s.inbox, muxInMessage{message: message, stream: stream, size: len(p.buf), recvStart: p.recvStart})
+			s.cond.Broadcast()
+			s.mu.Unlock()
+		case muxFrameWindow:
+			if len(frame.payload) != 4 {
+				return errors.Errorf("invalid window frame size [%v]", len(frame.payload))
+			}
+			s.addCredit(frame.id, int(binary.BigEndian.Uint32(frame.payload)))
+		case muxFrameReset:
+			delete(partials, frame.id)
+		default:
+			return errors.Errorf("invalid frame type [%v]", frame.frameType)
+		}
+	}
+}
+
+// DispatchLoop hands the received messages over. Each priority class is dispatched by its own goroutine, so the
+// control messages are handed over while the handlers of the bulk messages are busy
+func (s *MuxSession) DispatchLoop() error {
+	errs := make(chan error, StreamPriorityControl+1)
+	for priority := StreamPriorityBulk; priority <= StreamPriorityControl; priority++ {
+		go func(priority StreamPriority) {
+			errs <- s.dispatch(priority)
+		}(priority)
+	}
+	err := <-errs
+	s.Close()
+	return err
+}
+
+func (s *MuxSession) dispatch(priority StreamPriority) error {
+	for {
+		in, err := s.nextMessage(priority)
+		if err != nil {
+			return err
+		}
+		stream, size := in.stream, in.size
+		handled := sync.Once{}
+		err = s.handlers.OnMessage(in.message, stream, in.recvStart, func() {
+			handled.Do(func() {
+				s.giveCredit(stream, size)
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// nextMessage waits for the oldest message of the priority class, which keeps the messages of a stream in order
+func (s *MuxSession) nextMessage(priority StreamPriority) (muxInMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		if s.closed {
+			return muxInMessage{}, errMuxClosed
+		}
+		for i, in := range s.inbox {
+			if in.stream.priority == priority {
+				s.inbox = append(s.inbox[:i], s.inbox[i+1:]...)
+				return in, nil
+			}
+		}
+		s.cond.Wait()
+	}
+}
+
+// nextFrame waits for a frame to write: the control frames first, then the data of the stream with the highest
+// priority that has credit left. The streams of the same priority take turns
+func (s *MuxSession) nextFrame() (muxOutFrame, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		if s.closed {
+			return muxOutFrame{}, errMuxClosed
+		}
+		if len(s.ctrl) > 0 {
+			frame := s.ctrl[0]
+			s.ctrl = s.ctrl[1:]
+			return muxOutFrame{frame: frame}, nil
+		}
+
+		var next *muxSendStream
+		for _, st := range s.send {
+			if st.window == 0 || (st.current == nil && len(st.queue) == 0) {
+				continue
+			}
+			if next == nil || st.priority > next.priority || (st.priority == next.priority && s.turnBefore(st.id, next.id)) {
+				next = st
+			}
+		}
+		if next != nil {
+			return s.dataFrame(next), nil
+		}
+		s.cond.Wait()
+	}
+}
+
+// turnBefore tells whether stream a comes before stream b, in the turn starting after the last stream sent
+func (s *MuxSession) turnBefore(a, b uint32) bool {
+	return a-s.lastSent-1 < b-s.lastSent-1
+}
+
+func (s *MuxSession) dataFrame(st *muxSendStream) muxOutFrame {
+	if st.current == nil {
+		st.current = &muxOutMessage{packet: st.queue[0], writeStart: time.Now()}
+		st.queue[0] = nil
+		st.queue = st.queue[1:]
+		s.cond.Broadcast() // room in the queue
+	}
+	out := st.current
+	data := out.packet.MSGData[out.offset:]
+	n := len(data)
+	if n > muxMaxFramePayload {
+		n = muxMaxFramePayload
+	}
+	if n > st.window {
+		n = st.window
+	}
+
+	frame := muxOutFrame{}
+	var flags uint8
+	if n == len(data) {
+		flags = muxFlagEnd
+		frame.sent = out
+		st.current = nil
+	}
+	frame.frame = encodeMuxFrame(muxFrameData, flags, st.priority, st.id, data[:n])
+	out.offset += n
+	st.window -= n
+	s.lastSent = st.id
+	s.dropIdle(st)
+	return frame
+}
+
+func (s *MuxSession) sendStream(stream *Stream) *muxSendStream {
+	st, ok := s.send[stream.id]
+	if !ok {
+		st = &muxSendStream{id: stream.id, priority: stream.priority, window: muxStreamWindow}
+		s.send[stream.id] = st
+	}
+	return st
+}
+
+// dropIdle forgets a stream once it has nothing to send and got all its credit back
+func (s *MuxSession) dropIdle(st *muxSendStream) {
+	if st.current == nil && len(st.queue) == 0 && st.window >= muxStreamWindow {
+		delete(s.send, st.id)
+	}
+}
+
+func (s *MuxSession) dropMessages(st *muxSendStream) {
+	for _, packet := range st.queue {
+		packet.ReleaseAlloc()
+	}
+	st.queue = nil
+	if st.current != nil {
+		st.current.packet.ReleaseAlloc()
+		st.current = nil
+	}
+}
+
+func (s *MuxSession) addCredit(id uint32, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.send[id]
+	if !ok {
+		return
+	}
+	st.window += n
+	if st.window > muxStreamWindow {
+		st.window = muxStreamWindow
+	}
+	s.dropIdle(st)
+	s.cond.Broadcast()
+}
+
+// giveCredit lets the peer send n more bytes on the stream
+func (s *MuxSession) giveCredit(stream *Stream, n int) {
+	credit := make([]byte, 4)
+	binary.BigEndian.PutUint32(credit, uint32(n))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctrl = append(s.ctrl, encodeMuxFrame(muxFrameWindow, 0, stream.priority, stream.id, credit))
+	s.cond.Broadcast()
+}
+
+func (s *MuxSession) resetStream(stream *Stream) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.send[stream.id]
+	if !ok || s.closed {
+		return
+	}
+	if st.current != nil && st.current.offset > 0 {
+		// the peer never gives back the credit of an aborted message
+		st.window += st.current.offset
+		s.ctrl = append(s.ctrl, encodeMuxFrame(muxFrameReset, 0, st.priority, st.id, nil))
+	}
+	s.dropMessages(st)
+	s.dropIdle(st)
+	s.cond.Broadcast()
+}
+
+func encodeMuxFrame(frameType, flags uint8, priority StreamPriority, id uint32, payload []byte) []byte {
+	frame := make([]byte, muxFrameHeaderLen+len(payload))
+	frame[0] = frameType
+	frame[1] = flags
+	frame[2] = uint8(priority)
+	binary.BigEndian.PutUint32(frame[3:muxFrameHeaderLen], id)
+	copy(frame[muxFrameHeaderLen:], payload)
+	return frame
+}
+
+func decodeMuxFrame(frame []byte) (muxFrame, error) {
+	if len(frame) < muxFrameHeaderLen {
+		return muxFrame{}, errors.Errorf("frame too small [%v]", len(frame))
+	}
+	return muxFrame{
+		frameType: frame[0],
+		flags:     frame[1],
+		priority:  StreamPriority(frame[2]),
+		id:        binary.BigEndian.Uint32(frame[3:muxFrameHeaderLen]),
+		payload:   frame[muxFrameHeaderLen:],
+	}, nil
+}
+
+// decodeMuxMessage splits a message received on a stream the same way the readLoop of a connection does, after
+// verifying its signature
+func decodeMuxMessage(buf []byte, remoteP2pAddress string) (*fwmsg.RelayMsgBuf, error) {
+	if len(buf) < header.MsgHeaderLen {
+		return nil, errors.Errorf("message too small [%v]", len(buf))
+	}
+	var msgH header.MessageHead
+	msgH.Decode(buf[:header.MsgHeaderLen])
+	posBody := header.MsgHeaderLen
+	posSign := posBody + int(msgH.Len)
+	posData := posSign + fwmsg.MsgSignLen
+	if posData > len(buf) {
+		return nil, errors.Errorf("msgH.Len doesn't match the size of the message [%v]", len(buf))
+	}
+
+	var msgS fwmsg.MessageSign
+	msgS.Decode(buf[posSign:posData])
+	if err := msgS.Verify(buf[:posSign], remoteP2pAddress); err != nil {
+		return nil, errors.Wrap(err, "failed signature verification")
+	}
+
+	message := &fwmsg.RelayMsgBuf{
+		MSGHead: msgH,
+		MSGBody: make([]byte, posSign-posBody),
+	}
+	copy(message.MSGBody, buf[posBody:posSign])
+	if len(buf) > posData {
+		message.MSGData = utils.RequestBuffer()[:len(buf)-posData]
+		copy(message.MSGData, buf[posData:])
+	}
+	return message, nil
+}
@@ -0,0 +1,192 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwmsg "github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+type muxReceived struct {
+	message *fwmsg.RelayMsgBuf
+	stream  *Stream
+	handled func()
+}
+
+type muxPeer struct {
+	session  *MuxSession
+	sign     fwmsg.MessageSign
+	received chan muxReceived
+	// bulkBusy, when set, holds the bulk messages until it is closed, as a busy handler would
+	bulkBusy chan struct{}
+	bulkHeld chan struct{}
+}
+
+func newMuxPeer(conn net.Conn, key []byte, dialer bool, privKey *fwed25519.PrivKey, remoteP2pAddress string) *muxPeer {
+	p := &muxPeer{
+		sign: fwmsg.MessageSign{
+			P2pPubKey:  privKey.PubKey().Bytes(),
+			P2pAddress: fwtypes.P2PAddress(privKey.PubKey().Address()).String(),
+			Signer:     privKey.Sign,
+		},
+		received: make(chan muxReceived, 10),
+	}
+	p.session = NewMuxSession(conn, key, dialer, remoteP2pAddress, 10, 10*time.Second, MuxHandlers{
+		OnRead:  func(int) {},
+		OnWrite: func(int) {},
+		OnMessage: func(message *fwmsg.RelayMsgBuf, stream *Stream, _ int64, handled func()) error {
+			if p.bulkBusy != nil && stream.Priority() == StreamPriorityBulk {
+				p.bulkHeld <- struct{}{}
+				<-p.bulkBusy
+			}
+			p.received <- muxReceived{message, stream, handled}
+			return nil
+		},
+		OnSent: func(*fwmsg.RelayMsgBuf, int64) {},
+	})
+	return p
+}
+
+func (p *muxPeer) start() {
+	go func() { _ = p.session.ReadLoop() }()
+	go func() { _ = p.session.WriteLoop() }()
+	go func() { _ = p.session.DispatchLoop() }()
+}
+
+func (p *muxPeer) write(t *testing.T, ctx context.Context, body string, data []byte) {
+	m := &fwmsg.RelayMsgBuf{
+		MSGHead: header.MakeMessageHeader(1, 1, uint32(len(body)), header.ReqGetSPList),
+		MSGSign: p.sign,
+		MSGBody: []byte(body),
+	}
+	if len(data) > 0 {
+		m.MSGData = utils.RequestBuffer()[:len(data)]
+		copy(m.MSGData, data)
+	}
+	if err := p.session.Write(m, ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// receive waits for a message. Its handler returns right away, unless the message is kept unhandled
+func (p *muxPeer) receive(t *testing.T, unhandled ...bool) muxReceived {
+	select {
+	case r := <-p.received:
+		if len(unhandled) == 0 {
+			r.handled()
+		}
+		return r
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for a message")
+		return muxReceived{}
+	}
+}
+
+func newMuxPeers(t *testing.T) (*muxPeer, *muxPeer) {
+	utils.InitBufferPool(utils.MessageBeatLen, 8)
+	key := bytes.Repeat([]byte{7}, 32)
+	dialerKey, listenerKey := fwed25519.GenPrivKey(), fwed25519.GenPrivKey()
+	dialerConn, listenerConn := net.Pipe()
+	t.Cleanup(func() {
+		_ = dialerConn.Close()
+		_ = listenerConn.Close()
+	})
+	dialer := newMuxPeer(dialerConn, key, true, dialerKey, fwtypes.P2PAddress(listenerKey.PubKey().Address()).String())
+	listener := newMuxPeer(listenerConn, key, false, listenerKey, fwtypes.P2PAddress(dialerKey.PubKey().Address()).String())
+	t.Cleanup(dialer.session.Close)
+	t.Cleanup(listener.session.Close)
+	return dialer, listener
+}
+
+// TestMuxControlOvertakesBulk checks that a message written after a large message with data is delivered first
+func TestMuxControlOvertakesBulk(t *testing.T) {
+	dialer, listener := newMuxPeers(t)
+	data := bytes.Repeat([]byte("slice"), 300000)
+	dialer.write(t, context.Background(), "bulk", data)
+	dialer.write(t, context.Background(), "control", nil)
+	dialer.start()
+	listener.start()
+
+	first := listener.receive(t)
+	if string(first.message.MSGBody) != "control" || first.stream.Id() != muxStreamControl {
+		t.Fatalf("expected the control message first, got [%v] on stream %v", string(first.message.MSGBody), first.stream.Id())
+	}
+	second := listener.receive(t)
+	if string(second.message.MSGBody) != "bulk" || second.stream.Id() != muxStreamBulk {
+		t.Fatalf("expected the bulk message, got [%v] on stream %v", string(second.message.MSGBody), second.stream.Id())
+	}
+	if !bytes.Equal(second.message.MSGData, data) {
+		t.Fatal("received different data")
+	}
+}
+
+// TestMuxStreamResponse checks that a response written with the stream of the request goes back on the same stream
+func TestMuxStreamResponse(t *testing.T) {
+	dialer, listener := newMuxPeers(t)
+	dialer.start()
+	listener.start()
+
+	stream := dialer.session.OpenStream(StreamPriorityNormal)
+	dialer.write(t, CreateContextWithStream(context.Background(), stream), "request", nil)
+	request := listener.receive(t)
+	if request.stream.Id() != stream.Id() || request.stream.Priority() != StreamPriorityNormal {
+		t.Fatalf("request received on stream %v with priority %v", request.stream.Id(), request.stream.Priority())
+	}
+
+	listener.write(t, CreateContextWithStream(context.Background(), request.stream), "response", []byte("data"))
+	response := dialer.receive(t)
+	if string(response.message.MSGBody) != "response" || response.stream.Id() != stream.Id() {
+		t.Fatalf("got [%v] on stream %v", string(response.message.MSGBody), response.stream.Id())
+	}
+}
+
+// TestMuxBusyBulkHandler checks that the control messages are handed over while the bulk messages wait for their
+// handler, and that the credit of a stream is only given back once its handler returns
+func TestMuxBusyBulkHandler(t *testing.T) {
+	dialer, listener := newMuxPeers(t)
+	listener.bulkBusy, listener.bulkHeld = make(chan struct{}), make(chan struct{}, 1)
+	dialer.start()
+	listener.start()
+
+	dialer.write(t, context.Background(), "bulk", []byte("data"))
+	select {
+	case <-listener.bulkHeld:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the bulk message")
+	}
+	dialer.write(t, context.Background(), "control", nil)
+	if control := listener.receive(t); string(control.message.MSGBody) != "control" {
+		t.Fatalf("expected the control message, got [%v]", string(control.message.MSGBody))
+	}
+
+	close(listener.bulkBusy)
+	bulk := listener.receive(t, true)
+	if string(bulk.message.MSGBody) != "bulk" {
+		t.Fatalf("expected the bulk message, got [%v]", string(bulk.message.MSGBody))
+	}
+	bulkWindow := func() int {
+		dialer.session.mu.Lock()
+		defer dialer.session.mu.Unlock()
+		if st, ok := dialer.session.send[muxStreamBulk]; ok {
+			return st.window
+		}
+		return muxStreamWindow
+	}
+	time.Sleep(100 * time.Millisecond)
+	if bulkWindow() == muxStreamWindow {
+		t.Fatal("credit given back before the message was handled")
+	}
+	bulk.handled()
+	for start := time.Now(); bulkWindow() != muxStreamWindow; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatal("credit not given back once the message was handled")
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"github.com/stratosnet/sds/framework/metrics"
+	fwmsg "github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+)
+
+// SendQueue the messages waiting to be written to a connection, in one queue for each priority class
+type SendQueue struct {
+	queues [header.NUMBER_PRIORITIES]chan *fwmsg.RelayMsgBuf
+	ready  chan struct{}
+}
+
+func NewSendQueue(size int) *SendQueue {
+	q := &SendQueue{ready: make(chan struct{}, 1)}
+	for i := range q.queues {
+		q.queues[i] = make(chan *fwmsg.RelayMsgBuf, size)
+	}
+	return q
+}
+
+// Push adds the message to the queue of its priority class, and blocks while this queue is full. Like a send on a
+// closed channel, it panics once the queue is closed
+func (q *SendQueue) Push(m *fwmsg.RelayMsgBuf) {
+	priority := header.GetPriorityFromId(m.MSGHead.Cmd)
+	metrics.SendQueued.WithLabelValues(priority.String()).Inc()
+	q.queues[priority] <- m
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Pop returns the oldest message of the highest priority class, or nil when there is no message
+func (q *SendQueue) Pop() *fwmsg.RelayMsgBuf {
+	for priority, queue := range q.queues {
+		select {
+		case m, ok := <-queue:
+			if ok {
+				metrics.SendQueued.WithLabelValues(header.Priority(priority).String()).Dec()
+				return m
+			}
+		default:
+		}
+	}
+	return nil
+}
+
+// Ready receives a value when messages were pushed since the last time
+func (q *SendQueue) Ready() <-chan struct{} {
+	return q.ready
+}
+
+// Close drops the messages still in the queue
+func (q *SendQueue) Close() {
+	for priority, queue := range q.queues {
+		close(queue)
+		for range queue {
+			metrics.SendQueued.WithLabelValues(header.Priority(priority).String()).Dec()
+		}
+	}
+}
@@ -51,6 +51,8 @@ type options struct {
 	p2pAddress     string
 	contextkv      []ContextKV
 	readTimeout    int64
+	mux            bool
+	auth           *HandshakeAuth
 }
 
 type ServerOption func(*options)
@@ -171,7 +173,11 @@ func (s *Server) Start(l net.Listener) error {
 		s.mu.Unlock()
 	}()
 
-	Mylog(s.opts.logOpen, LOG_MODULE_SERVER, fmt.Sprintf("server start, net %v addr %v ", l.Addr().Network(), l.Addr().String()))
+	transport := TCPTransport
+	if tl, ok := l.(Listener); ok {
+		transport = tl.Transport()
+	}
+	Mylog(s.opts.logOpen, LOG_MODULE_SERVER, fmt.Sprintf("server start, net %v addr %v transport %v", l.Addr().Network(), l.Addr().String(), transport.Name()))
 
 	onStartLog := s.volRecOpts.onStartLog
 	if onStartLog != nil {
@@ -222,6 +228,7 @@ func (s *Server) Start(l net.Listener) error {
 		//utils.DebugLog("MaxConnections", s.opts.maxConnections)
 		netid := netID.GetOldAndIncrement()
 		sc := CreateServerConn(netid, s, spbConn)
+		sc.transport = transport
 		sc.SetConnName(sc.spbConn.RemoteAddr().String())
 		metrics.ConnReconnection.WithLabelValues(strings.Split(sc.GetName(), ":")[0]).Inc()
 		metrics.ConnNumbers.WithLabelValues("server").Inc()
@@ -396,6 +403,21 @@ func ReadDeadlineOption(timeout int64) ServerOption {
 		o.readTimeout = timeout
 	}
 }
+
+// MuxOption lets the clients that support it multiplex their messages over streams of their connection
+func MuxOption(b bool) ServerOption {
+	return func(o *options) {
+		o.mux = b
+	}
+}
+
+// HandshakeAuthOption makes the handshake prove the p2p address of the server and check the one of the clients
+func HandshakeAuthOption(auth *HandshakeAuth) ServerOption {
+	return func(o *options) {
+		o.auth = auth
+	}
+}
+
 func (s *Server) Unicast(ctx context.Context, netid int64, msg *msg.RelayMsgBuf) error {
 	v, ok := s.conns.Load(netid)
 	if ok {
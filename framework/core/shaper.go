@@ -0,0 +1,262 @@
+package core
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	// ShapeServe the slices sent to the peers: served downloads, transfers and uploads
+	ShapeServe = iota
+	// ShapeFetch the slices received from the peers
+	ShapeFetch
+
+	peerBucketIdleTimeout = 10 * time.Minute
+	// fetchQueueSize the slice messages received on a connection that can wait for the Shaper. Once it is full, the
+	// connection stops reading until the Shaper lets a slice message through
+	fetchQueueSize = 4
+)
+
+// ShapingLimits the bandwidth the slices can use, in bytes per second. 0 means unlimited
+type ShapingLimits struct {
+	Serve     uint64
+	Fetch     uint64
+	PeerServe uint64
+	PeerFetch uint64
+	// Schedule when the limits apply. Empty means always
+	Schedule ShapingSchedule
+}
+
+// ShapingSchedule the ranges of the day, in minutes since midnight of the local time
+type ShapingSchedule []minuteRange
+
+type minuteRange struct {
+	start, end int
+}
+
+// ParseShapingSchedule parses ranges of the day separated by commas, eg "08:00-12:00,13:30-19:00". A range ending
+// before it starts goes over midnight, eg "22:00-06:00"
+func ParseShapingSchedule(s string) (ShapingSchedule, error) {
+	var schedule ShapingSchedule
+	for _, r := range strings.Split(s, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		start, end, found := strings.Cut(r, "-")
+		if !found {
+			return nil, errors.Errorf("invalid range [%v], expected HH:MM-HH:MM", r)
+		}
+		startMinute, err := parseTimeOfDay(start)
+		if err != nil {
+			return nil, err
+		}
+		endMinute, err := parseTimeOfDay(end)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, minuteRange{start: startMinute, end: endMinute})
+	}
+	return schedule, nil
+}
+
+func parseTimeOfDay(s string) (int, error) {
+	hours, minutes, found := strings.Cut(strings.TrimSpace(s), ":")
+	h, errH := strconv.Atoi(hours)
+	m, errM := strconv.Atoi(minutes)
+	if !found || errH != nil || errM != nil || h < 0 || h > 24 || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, errors.Errorf("invalid time of day [%v], expected HH:MM", s)
+	}
+	return h*60 + m, nil
+}
+
+// Contains tells whether the schedule covers the time. An empty schedule covers all the day
+func (s ShapingSchedule) Contains(t time.Time) bool {
+	if len(s) == 0 {
+		return true
+	}
+	minute := t.Hour()*60 + t.Minute()
+	for _, r := range s {
+		if r.start <= r.end && minute >= r.start && minute < r.end {
+			return true
+		}
+		if r.start > r.end && (minute >= r.start || minute < r.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// Shaper limits the bandwidth of the slices, for the whole node and for each peer, in both directions
+type Shaper struct {
+	mu        sync.Mutex
+	limits    ShapingLimits
+	global    [2]*utils.TokenBucket
+	peers     map[string]*peerBuckets
+	lastSweep time.Time
+}
+
+type peerBuckets struct {
+	buckets  [2]*utils.TokenBucket
+	lastUsed time.Time
+}
+
+// DefaultShaper shapes the connections of the node
+var DefaultShaper = NewShaper()
+
+func NewShaper() *Shaper {
+	return &Shaper{
+		global: [2]*utils.TokenBucket{utils.NewTokenBucket(0), utils.NewTokenBucket(0)},
+		peers:  make(map[string]*peerBuckets),
+	}
+}
+
+// SetShaping changes the limits of the DefaultShaper. The connections already open use the new limits right away
+func SetShaping(limits ShapingLimits) {
+	DefaultShaper.SetLimits(limits)
+}
+
+func (s *Shaper) SetLimits(limits ShapingLimits) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limits = limits
+	s.global[ShapeServe].SetRate(limits.Serve)
+	s.global[ShapeFetch].SetRate(limits.Fetch)
+	for _, peer := range s.peers {
+		peer.buckets[ShapeServe].SetRate(limits.PeerServe)
+		peer.buckets[ShapeFetch].SetRate(limits.PeerFetch)
+	}
+}
+
+func (s *Shaper) Limits() ShapingLimits {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.limits
+}
+
+// Wait blocks until n bytes of a slice can be transferred with the peer in the direction
+func (s *Shaper) Wait(p2pAddress string, direction, n int) {
+	global, peer := s.buckets(p2pAddress, direction)
+	if global == nil {
+		return
+	}
+	wait := global.Take(n)
+	if peer != nil {
+		if peerWait := peer.Take(n); peerWait > wait {
+			wait = peerWait
+		}
+	}
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// buckets returns the buckets limiting the transfer, or nil when the transfer is not limited at the moment
+func (s *Shaper) buckets(p2pAddress string, direction int) (*utils.TokenBucket, *utils.TokenBucket) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if !s.limits.Schedule.Contains(now) {
+		return nil, nil
+	}
+	peerRate := s.limits.PeerServe
+	if direction == ShapeFetch {
+		peerRate = s.limits.PeerFetch
+	}
+	if peerRate == 0 || p2pAddress == "" {
+		return s.global[direction], nil
+	}
+
+	if now.Sub(s.lastSweep) > peerBucketIdleTimeout {
+		for address, peer := range s.peers {
+			if now.Sub(peer.lastUsed) > peerBucketIdleTimeout {
+				delete(s.peers, address)
+			}
+		}
+		s.lastSweep = now
+	}
+	peer, ok := s.peers[p2pAddress]
+	if !ok {
+		peer = &peerBuckets{
+			buckets: [2]*utils.TokenBucket{utils.NewTokenBucket(s.limits.PeerServe), utils.NewTokenBucket(s.limits.PeerFetch)},
+		}
+		s.peers[p2pAddress] = peer
+	}
+	peer.lastUsed = now
+	return s.global[direction], peer.buckets[direction]
+}
+
+// ShapingPeer the key of the peer of a connection in the Shaper. A peer that didn't prove it owns its p2p address is
+// shaped by network address, so that it can't use the limits of the node it claims to be
+func ShapingPeer(p2pAddress string, authenticated bool, remoteAddr net.Addr) string {
+	if authenticated {
+		return p2pAddress
+	}
+	if remoteAddr == nil {
+		return ""
+	}
+	return remoteAddr.String()
+}
+
+// FetchQueue runs the handlers of the slice messages received on a connection once the Shaper lets them through. It
+// waits apart from the loops of the connection, so that the other messages are handled without delay
+type FetchQueue struct {
+	peer string
+	jobs chan fetchJob
+	done <-chan struct{}
+}
+
+type fetchJob struct {
+	size int
+	run  func()
+}
+
+// NewFetchQueue starts the queue of a connection, until done is closed
+func NewFetchQueue(peer string, done <-chan struct{}) *FetchQueue {
+	q := &FetchQueue{
+		peer: peer,
+		jobs: make(chan fetchJob, fetchQueueSize),
+		done: done,
+	}
+	go q.loop()
+	return q
+}
+
+// Add queues the handler of a slice message of size bytes. The handlers run in the order they are added
+func (q *FetchQueue) Add(size int, run func()) {
+	select {
+	case q.jobs <- fetchJob{size: size, run: run}:
+	case <-q.done:
+	}
+}
+
+func (q *FetchQueue) loop() {
+	for {
+		select {
+		case <-q.done:
+			return
+		case job := <-q.jobs:
+			DefaultShaper.Wait(q.peer, ShapeFetch, job.size)
+			job.run()
+		}
+	}
+}
+
+// IsShapedMsg tells whether the message type carries slices, the only traffic the Shaper limits
+func IsShapedMsg(cmd uint8) bool {
+	switch cmd {
+	case header.ReqUploadFileSlice.Id, header.ReqBackupFileSlice.Id, header.RspDownloadSlice.Id,
+		header.RspTransferDownload.Id, header.RspVerifyDownload.Id:
+		return true
+	default:
+		return false
+	}
+}
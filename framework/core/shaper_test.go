@@ -0,0 +1,92 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShapingSchedule(t *testing.T) {
+	schedule, err := ParseShapingSchedule("08:00-12:00, 22:30-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2023, 1, 1, 0, 0, 0, 0, time.Local)
+	for _, c := range []struct {
+		hour, minute int
+		contained    bool
+	}{
+		{7, 59, false}, {8, 0, true}, {11, 59, true}, {12, 0, false},
+		{22, 29, false}, {22, 30, true}, {0, 0, true}, {5, 59, true}, {6, 0, false},
+	} {
+		at := day.Add(time.Duration(c.hour)*time.Hour + time.Duration(c.minute)*time.Minute)
+		if schedule.Contains(at) != c.contained {
+			t.Errorf("%02d:%02d should be contained: %v", c.hour, c.minute, c.contained)
+		}
+	}
+
+	for _, invalid := range []string{"08:00", "8-12", "25:00-26:00", "08:60-09:00"} {
+		if _, err = ParseShapingSchedule(invalid); err == nil {
+			t.Errorf("schedule [%v] should be invalid", invalid)
+		}
+	}
+}
+
+func TestShaper(t *testing.T) {
+	shaper := NewShaper()
+	shaper.SetLimits(ShapingLimits{Serve: 4 * 1024 * 1024, PeerServe: 1024 * 1024})
+
+	// the burst of the peer bucket is sent right away, then the rest at 1MB/s
+	start := time.Now()
+	for i := 0; i < 300; i++ {
+		shaper.Wait("peer1", ShapeServe, 1024)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond || elapsed > time.Second {
+		t.Errorf("sending 300KB to a peer limited to 1MB/s took %v", elapsed)
+	}
+
+	// fetching is not limited
+	start = time.Now()
+	for i := 0; i < 3000; i++ {
+		shaper.Wait("peer1", ShapeFetch, 1024)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("fetching without limits took %v", elapsed)
+	}
+}
+
+func TestFetchQueue(t *testing.T) {
+	done := make(chan struct{})
+	queue := NewFetchQueue("peer1", done)
+
+	// the handlers run in order, apart from the goroutine adding them
+	ran := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		n := i
+		queue.Add(1024, func() { ran <- n })
+	}
+	for i := 0; i < 10; i++ {
+		select {
+		case n := <-ran:
+			if n != i {
+				t.Fatalf("handler %v ran in place of %v", n, i)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("the queued handlers didn't run")
+		}
+	}
+
+	// once the connection is closed, adding doesn't block
+	close(done)
+	added := make(chan struct{})
+	go func() {
+		for i := 0; i < 2*fetchQueueSize; i++ {
+			queue.Add(1024, func() {})
+		}
+		close(added)
+	}()
+	select {
+	case <-added:
+	case <-time.After(time.Second):
+		t.Fatal("adding to a closed queue blocked")
+	}
+}
@@ -3,8 +3,11 @@ package core
 import (
 	"hash/fnv"
 	"runtime/debug"
+	"time"
 	"unsafe"
 
+	"github.com/stratosnet/sds/framework/metrics"
+	"github.com/stratosnet/sds/framework/msg/header"
 	"github.com/stratosnet/sds/framework/utils"
 )
 
@@ -19,11 +22,12 @@ type TaskPool struct {
 // GlobalTaskPool
 var GlobalTaskPool *TaskPool
 
-// task
+// task runs the jobs of the priority class with the lowest value first
 type task struct {
-	index        int
-	callbackChan chan taskFunc
-	closeChan    chan struct{}
+	index         int
+	callbackChans [header.NUMBER_PRIORITIES]chan taskFunc
+	ready         chan struct{}
+	closeChan     chan struct{}
 }
 
 func makeTaskPool(count int) *TaskPool {
@@ -47,9 +51,12 @@ func makeTaskPool(count int) *TaskPool {
 // make a task and start a go routine
 func makeTask(index int, size int, close chan struct{}) *task {
 	t := &task{
-		index:        index,
-		callbackChan: make(chan taskFunc, size),
-		closeChan:    close,
+		index:     index,
+		ready:     make(chan struct{}, 1),
+		closeChan: close,
+	}
+	for i := range t.callbackChans {
+		t.callbackChans[i] = make(chan taskFunc, size)
 	}
 	go t.start()
 	return t
@@ -62,31 +69,65 @@ func (t *task) start() {
 		}
 	}()
 	for {
+		fc := t.next()
+		if fc == nil {
+			return
+		}
+		fc()
+		// todo: add time management
+	}
+}
+
+// next waits for a job, and returns the oldest job of the highest priority. It returns nil once the pool is closed
+func (t *task) next() taskFunc {
+	for {
+		for _, callbackChan := range t.callbackChans {
+			select {
+			case fc := <-callbackChan:
+				return fc
+			default:
+			}
+		}
 		select {
 		case <-t.closeChan:
-			return
-		case fc := <-t.callbackChan:
-			fc()
-			// todo: add time management
+			return nil
+		case <-t.ready:
 		}
 	}
 }
 
-// Job: add job to the pool
-func (tp *TaskPool) Job(id int64, fc func()) error {
+// Job: add job to the pool. The jobs of a higher priority class are run first
+func (tp *TaskPool) Job(id int64, priority header.Priority, fc func()) error {
 	var hashCode uint32
 	h := fnv.New32a()
 	h.Write((*((*[8]byte)(unsafe.Pointer(&id))))[:])
 	hashCode = h.Sum32()
-	// make sure that the msg from the same netId is allocate to the same routine to be processed in sequence
-	return tp.tasks[hashCode&uint32(len(tp.tasks)-1)].job(taskFunc(fc))
+	// make sure that the msg from the same netId is allocate to the same routine to be processed in sequence, within
+	// each priority class
+	return tp.tasks[hashCode&uint32(len(tp.tasks)-1)].job(priority, taskFunc(fc))
 }
 
-func (t *task) job(fc taskFunc) error {
+func (t *task) job(priority header.Priority, fc taskFunc) error {
+	if priority >= header.NUMBER_PRIORITIES {
+		priority = header.PriorityControl
+	}
+	label := priority.String()
+	queued := time.Now()
+	job := func() {
+		metrics.TaskQueued.WithLabelValues(label).Dec()
+		metrics.TaskWaitTime.WithLabelValues(label).Observe(time.Since(queued).Seconds())
+		fc()
+	}
+	metrics.TaskQueued.WithLabelValues(label).Inc()
 	select {
-	case t.callbackChan <- fc:
-		return nil
+	case t.callbackChans[priority] <- job:
 	default:
+		metrics.TaskQueued.WithLabelValues(label).Dec()
 		return utils.ErrNotFoundCallBack
 	}
+	select {
+	case t.ready <- struct{}{}:
+	default:
+	}
+	return nil
 }
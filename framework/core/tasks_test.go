@@ -0,0 +1,34 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stratosnet/sds/framework/msg/header"
+)
+
+func TestTaskPriority(t *testing.T) {
+	pool := makeTaskPool(1)
+	defer close(pool.closeChan)
+
+	// keep the task busy while the jobs are queued
+	release := make(chan struct{})
+	if err := pool.Job(1, header.PriorityBulk, func() { <-release }); err != nil {
+		t.Fatal(err)
+	}
+
+	order := make(chan header.Priority, 4)
+	for _, priority := range []header.Priority{header.PriorityBulk, header.PriorityVerification, header.PriorityBulk, header.PriorityControl} {
+		priority := priority
+		if err := pool.Job(1, priority, func() { order <- priority }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(release)
+
+	expected := []header.Priority{header.PriorityControl, header.PriorityVerification, header.PriorityBulk, header.PriorityBulk}
+	for i, priority := range expected {
+		if p := <-order; p != priority {
+			t.Fatalf("job %v has the priority %v instead of %v", i, p, priority)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	TransportTCP  = "tcp"
+	TransportQUIC = "quic"
+)
+
+// Transport establishes the connections between nodes. Every connection it creates is a reliable ordered stream of bytes,
+// so the messages, the handshake and the encryption are the same whatever the transport
+type Transport interface {
+	// Name identifies the transport in the config and in the logs
+	Name() string
+	// Listen accepts the connections made by the Dial of the same transport on other nodes
+	Listen(addr string) (Listener, error)
+	Dial(addr string) (net.Conn, error)
+}
+
+// Listener is a net.Listener created by a Transport. The server dials back to the peer with the transport of the
+// listener that accepted its connection
+type Listener interface {
+	net.Listener
+	Transport() Transport
+}
+
+var (
+	// TCPTransport is the default transport, every node supports it
+	TCPTransport Transport = tcpTransport{}
+	// QUICTransport keeps the TLS sessions of the nodes it connected to, to resume them with 0-RTT when reconnecting
+	QUICTransport Transport = newQuicTransport()
+)
+
+// GetTransport returns the transport with the given name. An empty name is the default TCP transport
+func GetTransport(name string) (Transport, error) {
+	switch name {
+	case "", TransportTCP:
+		return TCPTransport, nil
+	case TransportQUIC:
+		return QUICTransport, nil
+	default:
+		return nil, errors.Errorf("unknown transport [%v], expected %v or %v", name, TransportTCP, TransportQUIC)
+	}
+}
+
+type tcpTransport struct{}
+
+type tcpListener struct {
+	net.Listener
+}
+
+func (tcpTransport) Name() string {
+	return TransportTCP
+}
+
+func (tcpTransport) Listen(addr string) (Listener, error) {
+	l, err := net.Listen("tcp4", addr)
+	if err != nil {
+		return nil, err
+	}
+	return tcpListener{Listener: l}, nil
+}
+
+func (tcpTransport) Dial(addr string) (net.Conn, error) {
+	tcpAddr, err := net.ResolveTCPAddr("tcp4", addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "bad server address %v", addr)
+	}
+	conn, err := net.DialTCP("tcp", nil, tcpAddr)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (tcpListener) Transport() Transport {
+	return TCPTransport
+}
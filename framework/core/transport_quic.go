@@ -0,0 +1,415 @@
+package core
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	quicALPN = "sds"
+
+	quicHandshakeTimeout = 5 * time.Second
+	quicIdleTimeout      = 30 * time.Second
+	quicKeepAlivePeriod  = 10 * time.Second
+	// quicCloseLinger how long a closed connection stays open to deliver the data still in flight
+	quicCloseLinger = 2 * time.Second
+	// quicUnreachableTTL how long the dials to an address QUIC failed to reach fail right away, so that they fall back
+	// to TCP without waiting for the handshake timeout
+	quicUnreachableTTL = 10 * time.Minute
+
+	quicErrNoError  quic.ApplicationErrorCode = 0
+	quicErrNoStream quic.ApplicationErrorCode = 1
+)
+
+// quicTransport carries every connection of the nodes over its own QUIC connection, with a single bidirectional stream.
+// A node reconnecting to a node it already knows sends its first messages with 0-RTT, and the connections of a node
+// don't delay each other when a packet is lost.
+// It doesn't solve head-of-line blocking between the messages of a connection: they share the stream, so a lost packet
+// delays every message sent after it, as over TCP.
+// The TLS layer of QUIC only encrypts the packets: the server certificate is self-signed and not verified, the nodes
+// authenticate each other with their P2P keys during the handshake of the connection, as they do over TCP
+type quicTransport struct {
+	clientTLS *tls.Config
+	config    *quic.Config
+	sessions  tls.ClientSessionCache
+
+	mutex       sync.Mutex
+	unreachable map[string]time.Time // address -> time until which QUIC isn't dialed
+
+	certOnce sync.Once
+	cert     tls.Certificate
+	certErr  error
+}
+
+func newQuicTransport() *quicTransport {
+	return &quicTransport{
+		clientTLS: &tls.Config{
+			InsecureSkipVerify: true,
+			NextProtos:         []string{quicALPN},
+			MinVersion:         tls.VersionTLS13,
+		},
+		config: &quic.Config{
+			HandshakeIdleTimeout: quicHandshakeTimeout,
+			MaxIdleTimeout:       quicIdleTimeout,
+			KeepAlivePeriod:      quicKeepAlivePeriod,
+			Allow0RTT:            true,
+		},
+		sessions:    tls.NewLRUClientSessionCache(0),
+		unreachable: make(map[string]time.Time),
+	}
+}
+
+func (t *quicTransport) Name() string {
+	return TransportQUIC
+}
+
+func (t *quicTransport) Listen(addr string) (Listener, error) {
+	t.certOnce.Do(func() {
+		t.cert, t.certErr = generateQuicCertificate()
+	})
+	if t.certErr != nil {
+		return nil, t.certErr
+	}
+	serverTLS := &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		NextProtos:   []string{quicALPN},
+		MinVersion:   tls.VersionTLS13,
+	}
+	ln, err := quic.ListenAddrEarly(addr, serverTLS, t.config)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &quicListener{
+		ln:        ln,
+		transport: t,
+		conns:     make(chan net.Conn),
+	}
+	l.ctx, l.cancel = context.WithCancel(context.Background())
+	go l.acceptLoop()
+	return l, nil
+}
+
+// isUnreachable tells whether a dial to the address failed recently
+func (t *quicTransport) isUnreachable(addr string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	until, ok := t.unreachable[addr]
+	if ok && time.Now().After(until) {
+		delete(t.unreachable, addr)
+		return false
+	}
+	return ok
+}
+
+// setUnreachable makes the dials to the address fail right away for quicUnreachableTTL. The TLS session is forgotten,
+// the next dial waits for the handshake instead of sending 0-RTT data nobody answers
+func (t *quicTransport) setUnreachable(addr string) {
+	t.sessions.Put(addr, nil)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.unreachable[addr] = time.Now().Add(quicUnreachableTTL)
+}
+
+func (t *quicTransport) Dial(addr string) (net.Conn, error) {
+	if t.isUnreachable(addr) {
+		return nil, errors.Errorf("%v was not reachable over QUIC recently", addr)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.HandshakeIdleTimeout)
+	defer cancel()
+	tlsConf := t.clientTLS.Clone()
+	tlsConf.ClientSessionCache = addrSessionCache{ClientSessionCache: t.sessions, addr: addr}
+	conn, err := quic.DialAddrEarly(ctx, addr, tlsConf, t.config)
+	if err != nil {
+		t.setUnreachable(addr)
+		return nil, errors.Wrapf(err, "failed to dial %v over QUIC", addr)
+	}
+	// without a session to resume, the dial returns once the handshake is complete
+	early := true
+	select {
+	case <-conn.HandshakeComplete():
+		early = false
+	default:
+	}
+
+	stream, err := conn.OpenStreamSync(ctx)
+	if errors.Is(err, quic.Err0RTTRejected) {
+		early = false
+		stream, err = conn.NextConnection().OpenStreamSync(ctx)
+	}
+	if err != nil {
+		_ = conn.CloseWithError(quicErrNoStream, "")
+		return nil, errors.Wrap(err, "failed to open QUIC stream")
+	}
+	c := newQuicConn(conn, stream)
+	if early {
+		c.early = conn
+		go c.watchEarlyData(conn, stream, func() {
+			t.setUnreachable(addr)
+		})
+	}
+	return c, nil
+}
+
+// addrSessionCache keeps the TLS sessions by server address. By default, they are kept by host, but several nodes can
+// run on the same host
+type addrSessionCache struct {
+	tls.ClientSessionCache
+	addr string
+}
+
+func (c addrSessionCache) Get(string) (*tls.ClientSessionState, bool) {
+	return c.ClientSessionCache.Get(c.addr)
+}
+
+func (c addrSessionCache) Put(_ string, cs *tls.ClientSessionState) {
+	c.ClientSessionCache.Put(c.addr, cs)
+}
+
+// generateQuicCertificate creates the self-signed certificate of the QUIC server
+func generateQuicCertificate() (tls.Certificate, error) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "failed generating the QUIC certificate key")
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: quicALPN},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(10, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pubKey, privKey)
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "failed creating the QUIC certificate")
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: privKey}, nil
+}
+
+// quicListener accepts the QUIC connections and hands over their stream once the peer opened it
+type quicListener struct {
+	ln        *quic.EarlyListener
+	transport *quicTransport
+	conns     chan net.Conn
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+func (l *quicListener) acceptLoop() {
+	defer l.cancel()
+	for {
+		conn, err := l.ln.Accept(l.ctx)
+		if err != nil {
+			if l.ctx.Err() == nil {
+				utils.ErrorLog("QUIC listener stopped accepting connections", err)
+			}
+			return
+		}
+		go l.acceptStream(conn)
+	}
+}
+
+func (l *quicListener) acceptStream(conn quic.EarlyConnection) {
+	ctx, cancel := context.WithTimeout(l.ctx, quicHandshakeTimeout)
+	defer cancel()
+	stream, err := conn.AcceptStream(ctx)
+	if err != nil {
+		_ = conn.CloseWithError(quicErrNoStream, "")
+		return
+	}
+	select {
+	case l.conns <- newQuicConn(conn, stream):
+	case <-l.ctx.Done():
+		_ = conn.CloseWithError(quicErrNoError, "")
+	}
+}
+
+func (l *quicListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *quicListener) Close() error {
+	l.cancel()
+	return l.ln.Close()
+}
+
+func (l *quicListener) Addr() net.Addr {
+	return l.ln.Addr()
+}
+
+func (l *quicListener) Transport() Transport {
+	return l.transport
+}
+
+// quicConn is the stream of a QUIC connection seen as a net.Conn.
+// On the dialing side, the data is sent with 0-RTT when the server was connected to before. If the server rejects it,
+// for instance because it restarted in the meantime, the stream is opened again once the handshake is complete and the
+// data written so far is sent again. So the data is kept until the server answers
+type quicConn struct {
+	mu            sync.Mutex
+	conn          quic.Connection
+	stream        quic.Stream
+	early         quic.EarlyConnection // nil once the server answered
+	earlyData     []byte
+	readDeadline  time.Time
+	writeDeadline time.Time
+	closeOnce     sync.Once
+}
+
+func newQuicConn(conn quic.Connection, stream quic.Stream) *quicConn {
+	return &quicConn{conn: conn, stream: stream}
+}
+
+func (c *quicConn) current() quic.Stream {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stream
+}
+
+func (c *quicConn) Read(b []byte) (int, error) {
+	stream := c.current()
+	n, err := stream.Read(b)
+	if errors.Is(err, quic.Err0RTTRejected) {
+		if err = c.resume(stream); err != nil {
+			return 0, err
+		}
+		return c.Read(b)
+	}
+	if n > 0 {
+		c.mu.Lock()
+		c.early, c.earlyData = nil, nil
+		c.mu.Unlock()
+	}
+	return n, err
+}
+
+func (c *quicConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	stream := c.stream
+	if c.early != nil {
+		c.earlyData = append(c.earlyData, b...)
+	}
+	c.mu.Unlock()
+
+	n, err := stream.Write(b)
+	if errors.Is(err, quic.Err0RTTRejected) {
+		// the data of b is part of the early data written again on the new stream
+		if err = c.resume(stream); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return n, err
+}
+
+// watchEarlyData sends the data again as soon as the server rejects 0-RTT, the peer might not answer before receiving it.
+// When the handshake fails, the server is gone or stopped using QUIC: the address is marked unreachable, so that the
+// next dial falls back to TCP right away
+func (c *quicConn) watchEarlyData(early quic.EarlyConnection, stream quic.Stream, setUnreachable func()) {
+	<-early.HandshakeComplete()
+	if early.Context().Err() != nil {
+		setUnreachable()
+		_ = c.Close()
+		return
+	}
+	if early.ConnectionState().Used0RTT {
+		return
+	}
+	if err := c.resume(stream); err != nil {
+		utils.DebugLogf("QUIC 0-RTT was rejected by %v and the stream could not be opened again: %v", c.RemoteAddr(), err)
+		_ = c.Close()
+	}
+}
+
+// resume opens the stream again after the server rejected the 0-RTT data sent on the rejected stream
+func (c *quicConn) resume(rejected quic.Stream) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stream != rejected {
+		return nil // already resumed by a concurrent read or write
+	}
+	if c.early == nil {
+		return quic.Err0RTTRejected
+	}
+
+	conn := c.early.NextConnection()
+	ctx, cancel := context.WithTimeout(context.Background(), quicHandshakeTimeout)
+	defer cancel()
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to open QUIC stream after 0-RTT was rejected")
+	}
+	_ = stream.SetReadDeadline(c.readDeadline)
+	_ = stream.SetWriteDeadline(c.writeDeadline)
+	c.conn, c.stream, c.early = conn, stream, nil
+	earlyData := c.earlyData
+	c.earlyData = nil
+	_, err = stream.Write(earlyData)
+	return err
+}
+
+// Close closes both directions of the stream. The connection is closed a bit later, to let the stream deliver its
+// remaining data
+func (c *quicConn) Close() error {
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		conn, stream := c.conn, c.stream
+		c.mu.Unlock()
+		stream.CancelRead(0)
+		_ = stream.Close()
+		time.AfterFunc(quicCloseLinger, func() {
+			_ = conn.CloseWithError(quicErrNoError, "")
+		})
+	})
+	return nil
+}
+
+func (c *quicConn) LocalAddr() net.Addr {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.LocalAddr()
+}
+
+func (c *quicConn) RemoteAddr() net.Addr {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.RemoteAddr()
+}
+
+func (c *quicConn) SetDeadline(t time.Time) error {
+	_ = c.SetReadDeadline(t)
+	return c.SetWriteDeadline(t)
+}
+
+func (c *quicConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	return c.stream.SetReadDeadline(t)
+}
+
+func (c *quicConn) SetWriteDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writeDeadline = t
+	return c.stream.SetWriteDeadline(t)
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// echo accepts a single connection and sends back what it reads
+func echo(t *testing.T, l Listener) {
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = io.Copy(conn, conn)
+		_ = conn.Close()
+	}()
+}
+
+func dialAndEcho(t *testing.T, transport Transport, addr string, data []byte) {
+	conn, err := transport.Dial(addr)
+	if err != nil {
+		t.Fatalf("dial over %v failed: %v", transport.Name(), err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+	if err = WriteFull(conn, data); err != nil {
+		t.Fatalf("write over %v failed: %v", transport.Name(), err)
+	}
+	received := make([]byte, len(data))
+	if _, err = io.ReadFull(conn, received); err != nil {
+		t.Fatalf("read over %v failed: %v", transport.Name(), err)
+	}
+	if !bytes.Equal(data, received) {
+		t.Fatalf("received different data over %v", transport.Name())
+	}
+}
+
+func TestTransports(t *testing.T) {
+	data := bytes.Repeat([]byte("sds"), 100000)
+	for _, transport := range []Transport{TCPTransport, QUICTransport} {
+		l, err := transport.Listen("127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("listen over %v failed: %v", transport.Name(), err)
+		}
+		echo(t, l)
+		dialAndEcho(t, transport, l.Addr().String(), data)
+		_ = l.Close()
+	}
+}
+
+// TestQuicTransportRestartedServer checks that the data sent with 0-RTT is sent again when a restarted server rejects it
+func TestQuicTransportRestartedServer(t *testing.T) {
+	data := []byte("first message")
+	l, err := QUICTransport.Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	echo(t, l)
+	dialAndEcho(t, QUICTransport, addr, data)
+
+	// 0-RTT accepted
+	echo(t, l)
+	dialAndEcho(t, QUICTransport, addr, data)
+	_ = l.Close()
+
+	// new session ticket keys, 0-RTT rejected
+	if l, err = QUICTransport.Listen(addr); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = l.Close()
+	}()
+	echo(t, l)
+	dialAndEcho(t, QUICTransport, addr, data)
+}
+
+// TestQuicTransportUnreachable checks that the dials to a node without QUIC fail right away once a dial timed out
+func TestQuicTransportUnreachable(t *testing.T) {
+	transport := newQuicTransport()
+	transport.config.HandshakeIdleTimeout = 200 * time.Millisecond
+	// a UDP socket that never answers
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	addr := conn.LocalAddr().String()
+
+	if _, err = transport.Dial(addr); err == nil {
+		t.Fatal("dial to a node without QUIC succeeded")
+	}
+	start := time.Now()
+	if _, err = transport.Dial(addr); err == nil {
+		t.Fatal("dial to a node without QUIC succeeded")
+	}
+	if elapsed := time.Since(start); elapsed >= transport.config.HandshakeIdleTimeout {
+		t.Fatalf("the dial to an unreachable node waited %v", elapsed)
+	}
+}
@@ -10,6 +10,11 @@ const (
 	ConnTypeHandshake = "handshke"
 
 	HandshakeMessage = "sds_handshake"
+	// HandshakeFlagMux the node multiplexes the messages of the connection over streams. The server announces its flags in
+	// the port of the first message of the handshake connection, the client in the message carrying its p2p address
+	HandshakeFlagMux uint16 = 1 << 0
+	// HandshakeFlagAuth the node proves it owns its p2p address at the end of the handshake, see HandshakeAuth
+	HandshakeFlagAuth uint16 = 1 << 1
 
 	EncryptionHeaderSize = EncryptionNonceSize + EncryptionLengthSize // Nonce (8) + data length (4)
 	EncryptionNonceSize  = 8
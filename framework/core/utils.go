@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"encoding/binary"
 	"io"
 	"math/rand"
@@ -46,6 +47,21 @@ func ParseFirstMessage(data []byte) (string, net.IP, uint16, uint32, error) {
 	return connType, ip, serverPort, channelId, nil
 }
 
+// AppendHandshakeFlags adds the handshake flags after the p2p address sent during the handshake. Only a server that
+// announced flags expects them
+func AppendHandshakeFlags(p2pAddress []byte, flags uint16) []byte {
+	return binary.BigEndian.AppendUint16(append(p2pAddress, 0), flags)
+}
+
+// SplitHandshakeFlags separates the p2p address received during the handshake from the handshake flags of the client
+func SplitHandshakeFlags(data []byte) (string, uint16) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 || len(data) != i+3 {
+		return string(data), 0
+	}
+	return string(data[:i]), binary.BigEndian.Uint16(data[i+1:])
+}
+
 func Pack(privKey, plaintext []byte) ([]byte, error) {
 	// set nonce to 0 when message is non-encrypted packed
 	packHead := make([]byte, EncryptionHeaderSize)
@@ -162,6 +162,12 @@ func CalcSliceHash(data []byte, fileHash string, sliceNumber uint64) (string, er
 	return sliceCid.Encode(encoder), nil
 }
 
+// VerifySliceHash checks that the slice hash matches the data, the file hash and the slice number
+func VerifySliceHash(data []byte, fileHash string, sliceNumber uint64, sliceHash string) bool {
+	hash, err := CalcSliceHash(data, fileHash, sliceNumber)
+	return err == nil && hash == sliceHash
+}
+
 func uint64ToBytes(n uint64) []byte {
 	byteBuf := bytes.NewBuffer([]byte{})
 	_ = binary.Write(byteBuf, binary.BigEndian, n)
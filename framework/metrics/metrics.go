@@ -28,4 +28,29 @@ var (
 		},
 		[]string{"ip_address"},
 	)
+
+	TaskQueued = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sp_task_queued",
+			Help: ": number of messages waiting to be handled, by priority class",
+		},
+		[]string{"priority"},
+	)
+
+	TaskWaitTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "sp_task_wait_seconds",
+			Help:    ": time the messages waited before being handled, by priority class",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 8),
+		},
+		[]string{"priority"},
+	)
+
+	SendQueued = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sp_send_queued",
+			Help: ": number of messages waiting to be sent, by priority class",
+		},
+		[]string{"priority"},
+	)
 )
@@ -8,7 +8,8 @@ package header
       type has changed to a MsgType, instead of a string.
     * register the new message type by calling registerOneMessageType(). This function associates the variable and the
       message id, meanwhile this MsgType variable is assigned to a pointer array. The array provides the fastest search
-      from a message id to MsgType.
+      from a message id to MsgType. The priority class decides which messages are handled and sent first when a node is
+      busy: the slices go in PriorityBulk, so that they don't delay the heartbeats and the node status.
 */
 
 import (
@@ -21,8 +22,32 @@ const (
 )
 
 type MsgType struct {
-	Id   uint8
-	Name string
+	Id       uint8
+	Name     string
+	Priority Priority
+}
+
+// Priority the class of a message type. The messages of the classes with a lower value are handled and sent first
+type Priority uint8
+
+const (
+	PriorityControl      Priority = iota // node status, heartbeats and the exchanges with the meta nodes
+	PriorityVerification                 // verification of the stored slices
+	PriorityBulk                         // transfer of the slices
+	NUMBER_PRIORITIES
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityControl:
+		return "control"
+	case PriorityVerification:
+		return "verification"
+	case PriorityBulk:
+		return "bulk"
+	default:
+		return "unknown"
+	}
 }
 
 const (
@@ -149,6 +174,10 @@ const (
 	MSG_ID_REQ_VERIFY_RESULT
 	MSG_ID_RSP_VERIFY_RESULT
 	MSG_ID_RSP_VERIFY_DOWNLOAD_RESULT
+	MSG_ID_REQ_REPORT_LOST_SLICES
+	MSG_ID_RSP_REPORT_LOST_SLICES
+	MSG_ID_REQ_SLICE_INDEX
+	MSG_ID_RSP_SLICE_INDEX
 	NUMBER_MESSAGE_TYPES
 )
 
@@ -256,6 +285,12 @@ var (
 	RspReportVerifyResult   MsgType
 	RspVerifyDownloadResult MsgType
 
+	// stored slices
+	ReqReportLostSlices MsgType
+	RspReportLostSlices MsgType
+	ReqSliceIndex       MsgType
+	RspSliceIndex       MsgType
+
 	ReqReportBackupSliceResult MsgType
 	RspReportBackupSliceResult MsgType
 	ReqFileBackupStatus        MsgType
@@ -303,150 +338,157 @@ type MessageHead struct {
 	Version uint16
 }
 
-func registerOneMessageType(msgtype *MsgType, id uint8, name string) {
+func registerOneMessageType(msgtype *MsgType, id uint8, name string, priority Priority) {
 	msgtype.Id = id
 	msgtype.Name = name
+	msgtype.Priority = priority
 	registeredMessages[id] = msgtype
 }
 
 func init() {
-	registerOneMessageType(&ReqGetSPList, MSG_ID_REQ_GET_SPLIST, "ReqGSPL")                   // request to get sp list
-	registerOneMessageType(&RspGetSPList, MSG_ID_RSP_GET_SPLIST, "RspGSPL")                   // response to get sp list
-	registerOneMessageType(&ReqGetPPStatus, MSG_ID_REQ_GET_PPSTATUS, "ReqGPPS")               // request to get pp status
-	registerOneMessageType(&RspGetPPStatus, MSG_ID_RSP_GET_PPSTATUS, "RspGPPS")               // response to get pp status
-	registerOneMessageType(&ReqGetPPDowngradeInfo, MSG_ID_REQ_GET_PPDOWNGRADEINFO, "ReqGPPD") // request to get pp downgrade information
-	registerOneMessageType(&RspGetPPDowngradeInfo, MSG_ID_RSP_GET_PPDOWNGRADEINFO, "RspGPPD") // response to get pp downgrade information
-	registerOneMessageType(&ReqGetWalletOz, MSG_ID_REQ_GET_WALLETOZ, "ReqGOz")                // request to get wallet ozone
-	registerOneMessageType(&RspGetWalletOz, MSG_ID_RSP_GET_WALLETOZ, "RspGOz")                // response to get wallet ozone
-	registerOneMessageType(&ReqRegister, MSG_ID_REQ_REGISTER, "ReqReg")                       // request to register
-	registerOneMessageType(&RspRegister, MSG_ID_RSP_REGISTER, "RspReg")                       // response to register
-	registerOneMessageType(&ReqActivatePP, MSG_ID_REQ_ACTIVATE_PP, "ReqActvp")                // request to activate a PP node
-	registerOneMessageType(&RspActivatePP, MSG_ID_RSP_ACTIVATE_PP, "RspActvp")                // response to activate a PP node
+	registerOneMessageType(&ReqGetSPList, MSG_ID_REQ_GET_SPLIST, "ReqGSPL", PriorityControl)                   // request to get sp list
+	registerOneMessageType(&RspGetSPList, MSG_ID_RSP_GET_SPLIST, "RspGSPL", PriorityControl)                   // response to get sp list
+	registerOneMessageType(&ReqGetPPStatus, MSG_ID_REQ_GET_PPSTATUS, "ReqGPPS", PriorityControl)               // request to get pp status
+	registerOneMessageType(&RspGetPPStatus, MSG_ID_RSP_GET_PPSTATUS, "RspGPPS", PriorityControl)               // response to get pp status
+	registerOneMessageType(&ReqGetPPDowngradeInfo, MSG_ID_REQ_GET_PPDOWNGRADEINFO, "ReqGPPD", PriorityControl) // request to get pp downgrade information
+	registerOneMessageType(&RspGetPPDowngradeInfo, MSG_ID_RSP_GET_PPDOWNGRADEINFO, "RspGPPD", PriorityControl) // response to get pp downgrade information
+	registerOneMessageType(&ReqGetWalletOz, MSG_ID_REQ_GET_WALLETOZ, "ReqGOz", PriorityControl)                // request to get wallet ozone
+	registerOneMessageType(&RspGetWalletOz, MSG_ID_RSP_GET_WALLETOZ, "RspGOz", PriorityControl)                // response to get wallet ozone
+	registerOneMessageType(&ReqRegister, MSG_ID_REQ_REGISTER, "ReqReg", PriorityControl)                       // request to register
+	registerOneMessageType(&RspRegister, MSG_ID_RSP_REGISTER, "RspReg", PriorityControl)                       // response to register
+	registerOneMessageType(&ReqActivatePP, MSG_ID_REQ_ACTIVATE_PP, "ReqActvp", PriorityControl)                // request to activate a PP node
+	registerOneMessageType(&RspActivatePP, MSG_ID_RSP_ACTIVATE_PP, "RspActvp", PriorityControl)                // response to activate a PP node
 
-	registerOneMessageType(&NoticeActivatedPP, MSG_ID_NOTICE_ACTIVATED_SP, "NotActdp") // notice when a PP node was successfully activated
+	registerOneMessageType(&NoticeActivatedPP, MSG_ID_NOTICE_ACTIVATED_SP, "NotActdp", PriorityControl) // notice when a PP node was successfully activated
 
-	registerOneMessageType(&ReqUpdateDepositPP, MSG_ID_REQ_UPDATE_STAKE_PP, "ReqUpp") // request to update stake for a PP node
-	registerOneMessageType(&RspUpdateDepositPP, MSG_ID_RSP_UPDATE_STAKE_PP, "RspUpp") // response to update stake for a PP node
+	registerOneMessageType(&ReqUpdateDepositPP, MSG_ID_REQ_UPDATE_STAKE_PP, "ReqUpp", PriorityControl) // request to update stake for a PP node
+	registerOneMessageType(&RspUpdateDepositPP, MSG_ID_RSP_UPDATE_STAKE_PP, "RspUpp", PriorityControl) // response to update stake for a PP node
 
-	registerOneMessageType(&NoticeUpdatedDepositPP, MSG_ID_NOTICE_STATE_CHANGE_PP, "NotUptdp") // notice when a PP node's stake  was successfully updated
+	registerOneMessageType(&NoticeUpdatedDepositPP, MSG_ID_NOTICE_STATE_CHANGE_PP, "NotUptdp", PriorityControl) // notice when a PP node's stake  was successfully updated
 
-	registerOneMessageType(&ReqStateChangePP, MSG_ID_REQ_STATE_CHANGE_PP, "ReqSCpp")
-	registerOneMessageType(&RspStateChangePP, MSG_ID_RSP_STATE_CHANGE_PP, "RspSCpp")
+	registerOneMessageType(&ReqStateChangePP, MSG_ID_REQ_STATE_CHANGE_PP, "ReqSCpp", PriorityControl)
+	registerOneMessageType(&RspStateChangePP, MSG_ID_RSP_STATE_CHANGE_PP, "RspSCpp", PriorityControl)
 
-	registerOneMessageType(&ReqUpdatedStakeSP, MSG_ID_REQ_UPDATED_STAKE_SP, "ReqUptds") // request when a SP node's stake was successfully updated
+	registerOneMessageType(&ReqUpdatedStakeSP, MSG_ID_REQ_UPDATED_STAKE_SP, "ReqUptds", PriorityControl) // request when a SP node's stake was successfully updated
 
-	registerOneMessageType(&ReqDeactivatePP, MSG_ID_REQ_DEACTIVATE_PP, "ReqDctvp")      // request to deactivate a PP node
-	registerOneMessageType(&RspDeactivatePP, MSG_ID_RSP_DEACTIVATE_PP, "RspDctvp")      // response to deactivate a PP node
-	registerOneMessageType(&NoticeUnbondingPP, MSG_ID_NOTICE_UNBONDING_PP, "NotUbdp")   // notice to unbonding a PP node
-	registerOneMessageType(&NoticeDeactivatedPP, MSG_ID_NOTICE_ACTIVATE_PP, "NotDctdp") // notice when a PP node was successfully deactivated
+	registerOneMessageType(&ReqDeactivatePP, MSG_ID_REQ_DEACTIVATE_PP, "ReqDctvp", PriorityControl)      // request to deactivate a PP node
+	registerOneMessageType(&RspDeactivatePP, MSG_ID_RSP_DEACTIVATE_PP, "RspDctvp", PriorityControl)      // response to deactivate a PP node
+	registerOneMessageType(&NoticeUnbondingPP, MSG_ID_NOTICE_UNBONDING_PP, "NotUbdp", PriorityControl)   // notice to unbonding a PP node
+	registerOneMessageType(&NoticeDeactivatedPP, MSG_ID_NOTICE_ACTIVATE_PP, "NotDctdp", PriorityControl) // notice when a PP node was successfully deactivated
 
-	registerOneMessageType(&RspPPRegisteredToSP, MSG_ID_RSP_PPREGISTERED_TO_SP, "Rspbdsp") // response when a PP node was successfully registered to SP
+	registerOneMessageType(&RspPPRegisteredToSP, MSG_ID_RSP_PPREGISTERED_TO_SP, "Rspbdsp", PriorityControl) // response when a PP node was successfully registered to SP
 
-	registerOneMessageType(&ReqPrepay, MSG_ID_REQ_PREPAY, "ReqPrpay")   // request for a PP node sending a prepay transaction
-	registerOneMessageType(&RspPrepay, MSG_ID_RSP_PREPAY, "RspPrpay")   // response for a PP node sending a prepay transaction
-	registerOneMessageType(&ReqPrepaid, MSG_ID_REQ_PREPAID, "ReqPrpad") // request when a PP node prepay transaction was successful
-	registerOneMessageType(&RspPrepaid, MSG_ID_RSP_PREPAID, "RspPrpad") // response when a PP node prepay transaction was successful
+	registerOneMessageType(&ReqPrepay, MSG_ID_REQ_PREPAY, "ReqPrpay", PriorityControl)   // request for a PP node sending a prepay transaction
+	registerOneMessageType(&RspPrepay, MSG_ID_RSP_PREPAY, "RspPrpay", PriorityControl)   // response for a PP node sending a prepay transaction
+	registerOneMessageType(&ReqPrepaid, MSG_ID_REQ_PREPAID, "ReqPrpad", PriorityControl) // request when a PP node prepay transaction was successful
+	registerOneMessageType(&RspPrepaid, MSG_ID_RSP_PREPAID, "RspPrpad", PriorityControl) // response when a PP node prepay transaction was successful
 
-	registerOneMessageType(&ReqMining, MSG_ID_REQ_MINING, "ReqMin")                  // request to mining
-	registerOneMessageType(&RspMining, MSG_ID_RSP_MINING, "RspMin")                  //  response to mining
-	registerOneMessageType(&NoticeRelocateSp, MSG_ID_NOTICE_RELOCATE_SP, "NotRelSp") // notice to relocate to another SP
+	registerOneMessageType(&ReqMining, MSG_ID_REQ_MINING, "ReqMin", PriorityControl)                  // request to mining
+	registerOneMessageType(&RspMining, MSG_ID_RSP_MINING, "RspMin", PriorityControl)                  //  response to mining
+	registerOneMessageType(&NoticeRelocateSp, MSG_ID_NOTICE_RELOCATE_SP, "NotRelSp", PriorityControl) // notice to relocate to another SP
 
-	registerOneMessageType(&ReqStartMaintenance, MSG_ID_REQ_START_MAINTENANCE, "ReqStMtn")
-	registerOneMessageType(&RspStartMaintenance, MSG_ID_RSP_START_MAINTENANCE, "RspStMtn")
-	registerOneMessageType(&ReqStopMaintenance, MSG_ID_REQ_STOP_MAINTENANCE, "ReqSpMtn")
-	registerOneMessageType(&RspStopMaintenance, MSG_ID_RSP_STOP_MAINTENANCE, "RspSpMtn")
+	registerOneMessageType(&ReqStartMaintenance, MSG_ID_REQ_START_MAINTENANCE, "ReqStMtn", PriorityControl)
+	registerOneMessageType(&RspStartMaintenance, MSG_ID_RSP_START_MAINTENANCE, "RspStMtn", PriorityControl)
+	registerOneMessageType(&ReqStopMaintenance, MSG_ID_REQ_STOP_MAINTENANCE, "ReqSpMtn", PriorityControl)
+	registerOneMessageType(&RspStopMaintenance, MSG_ID_RSP_STOP_MAINTENANCE, "RspSpMtn", PriorityControl)
 
 	// upload
-	registerOneMessageType(&ReqUploadFile, MSG_ID_REQ_UPLOAD_FILE, "ReqUpl")
-	registerOneMessageType(&RspUploadFile, MSG_ID_RSP_UPLOAD_FILE, "RspUpl")
-	registerOneMessageType(&ReqUploadFileSlice, MSG_ID_REQ_UPLOAD_FILESLICE, "ReqUpLFS")
-	registerOneMessageType(&RspUploadFileSlice, MSG_ID_RSP_UPLOAD_FILESLICE, "RspUpLFS")
-	registerOneMessageType(&ReqBackupFileSlice, MSG_ID_REQ_BACKUP_FILESLICE, "ReqBULFS")
-	registerOneMessageType(&RspBackupFileSlice, MSG_ID_RSP_BACKUP_FILESLICE, "RspBULFS")
-	registerOneMessageType(&ReqUploadSlicesWrong, MSG_ID_REQ_UPLOAD_SLICES_WRONG, "ReqUSW")
-	registerOneMessageType(&RspUploadSlicesWrong, MSG_ID_RSP_UPLOAD_SLICES_WRONG, "RspUSW")
-	registerOneMessageType(&ReqReportUploadSliceResult, MSG_ID_REQ_REPORT_UPLOAD_SLICE_RESULT, "ReqUFR")
-	registerOneMessageType(&RspReportUploadSliceResult, MSG_ID_RSP_REPORT_UPLOAD_SLICE_RESULT, "RspUFR")
-
-	registerOneMessageType(&UploadSpeedOfProgress, MSG_ID_UPLOAD_SPEED_OF_PROGRESS, "USOP")
-
-	registerOneMessageType(&ReqFindMyFileList, MSG_ID_REQ_FIND_MY_FILELIST, "ReqFFL")
-	registerOneMessageType(&RspFindMyFileList, MSG_ID_RSP_FIND_MY_FILELIST, "RspFFL")
-	registerOneMessageType(&ReqDeleteFile, MSG_ID_REQ_DELETE_FILE, "ReqDF")
-	registerOneMessageType(&RspDeleteFile, MSG_ID_RSP_DELETE_FILE, "RspDF")
-	registerOneMessageType(&ReqGetHDInfo, MSG_ID_REQ_GET_HDINFO, "ReqHDI")
-	registerOneMessageType(&RspGetHDInfo, MSG_ID_RSP_GET_HDINFO, "RspHDI")
+	registerOneMessageType(&ReqUploadFile, MSG_ID_REQ_UPLOAD_FILE, "ReqUpl", PriorityControl)
+	registerOneMessageType(&RspUploadFile, MSG_ID_RSP_UPLOAD_FILE, "RspUpl", PriorityControl)
+	registerOneMessageType(&ReqUploadFileSlice, MSG_ID_REQ_UPLOAD_FILESLICE, "ReqUpLFS", PriorityBulk)
+	registerOneMessageType(&RspUploadFileSlice, MSG_ID_RSP_UPLOAD_FILESLICE, "RspUpLFS", PriorityBulk)
+	registerOneMessageType(&ReqBackupFileSlice, MSG_ID_REQ_BACKUP_FILESLICE, "ReqBULFS", PriorityBulk)
+	registerOneMessageType(&RspBackupFileSlice, MSG_ID_RSP_BACKUP_FILESLICE, "RspBULFS", PriorityBulk)
+	registerOneMessageType(&ReqUploadSlicesWrong, MSG_ID_REQ_UPLOAD_SLICES_WRONG, "ReqUSW", PriorityControl)
+	registerOneMessageType(&RspUploadSlicesWrong, MSG_ID_RSP_UPLOAD_SLICES_WRONG, "RspUSW", PriorityControl)
+	registerOneMessageType(&ReqReportUploadSliceResult, MSG_ID_REQ_REPORT_UPLOAD_SLICE_RESULT, "ReqUFR", PriorityControl)
+	registerOneMessageType(&RspReportUploadSliceResult, MSG_ID_RSP_REPORT_UPLOAD_SLICE_RESULT, "RspUFR", PriorityControl)
+
+	registerOneMessageType(&UploadSpeedOfProgress, MSG_ID_UPLOAD_SPEED_OF_PROGRESS, "USOP", PriorityControl)
+
+	registerOneMessageType(&ReqFindMyFileList, MSG_ID_REQ_FIND_MY_FILELIST, "ReqFFL", PriorityControl)
+	registerOneMessageType(&RspFindMyFileList, MSG_ID_RSP_FIND_MY_FILELIST, "RspFFL", PriorityControl)
+	registerOneMessageType(&ReqDeleteFile, MSG_ID_REQ_DELETE_FILE, "ReqDF", PriorityControl)
+	registerOneMessageType(&RspDeleteFile, MSG_ID_RSP_DELETE_FILE, "RspDF", PriorityControl)
+	registerOneMessageType(&ReqGetHDInfo, MSG_ID_REQ_GET_HDINFO, "ReqHDI", PriorityControl)
+	registerOneMessageType(&RspGetHDInfo, MSG_ID_RSP_GET_HDINFO, "RspHDI", PriorityControl)
 
 	//  download
-	registerOneMessageType(&ReqFileStorageInfo, MSG_ID_REQ_FILE_STORAGEINFO, "ReqQDLF")
-	registerOneMessageType(&RspFileStorageInfo, MSG_ID_RSP_FILE_STORAGEINFO, "RspQDLF")
-	registerOneMessageType(&ReqDownloadSlice, MSG_ID_REQ_DOWNLOAD_SLICE, "ReqDLFS")
-	registerOneMessageType(&RspDownloadSlice, MSG_ID_RSP_DOWNLOAD_SLICE, "RspDLFS")
-	registerOneMessageType(&ReqReportDownloadResult, MSG_ID_REQ_REPORT_DOWNLOAD_RESULT, "ReqDLRep") // request to download result report
-	registerOneMessageType(&RspReportDownloadResult, MSG_ID_RSP_REPORT_DOWNLOAD_RESULT, "RspDLRep") // response to download result report
-	registerOneMessageType(&ReqDownloadTaskInfo, MSG_ID_REQ_DOWNLOAD_TASKINFO, "ReqDLTI")
-	registerOneMessageType(&RspDownloadTaskInfo, MSG_ID_RSP_DOWNLOAD_TASKINFO, "RspDLTI")
-	registerOneMessageType(&ReqDownloadFileWrong, MSG_ID_REQ_DOWNLOAD_FILE_WRONG, "ReqDFW")
-	registerOneMessageType(&RspDownloadFileWrong, MSG_ID_RSP_DOWNLOAD_FILE_WRONG, "RspDFW")
-
-	registerOneMessageType(&ReqClearDownloadTask, MSG_ID_REQ_CLEAR_DOWNLOAD_TASK, "ReqCDT")
+	registerOneMessageType(&ReqFileStorageInfo, MSG_ID_REQ_FILE_STORAGEINFO, "ReqQDLF", PriorityControl)
+	registerOneMessageType(&RspFileStorageInfo, MSG_ID_RSP_FILE_STORAGEINFO, "RspQDLF", PriorityControl)
+	registerOneMessageType(&ReqDownloadSlice, MSG_ID_REQ_DOWNLOAD_SLICE, "ReqDLFS", PriorityBulk)
+	registerOneMessageType(&RspDownloadSlice, MSG_ID_RSP_DOWNLOAD_SLICE, "RspDLFS", PriorityBulk)
+	registerOneMessageType(&ReqReportDownloadResult, MSG_ID_REQ_REPORT_DOWNLOAD_RESULT, "ReqDLRep", PriorityControl) // request to download result report
+	registerOneMessageType(&RspReportDownloadResult, MSG_ID_RSP_REPORT_DOWNLOAD_RESULT, "RspDLRep", PriorityControl) // response to download result report
+	registerOneMessageType(&ReqDownloadTaskInfo, MSG_ID_REQ_DOWNLOAD_TASKINFO, "ReqDLTI", PriorityControl)
+	registerOneMessageType(&RspDownloadTaskInfo, MSG_ID_RSP_DOWNLOAD_TASKINFO, "RspDLTI", PriorityControl)
+	registerOneMessageType(&ReqDownloadFileWrong, MSG_ID_REQ_DOWNLOAD_FILE_WRONG, "ReqDFW", PriorityControl)
+	registerOneMessageType(&RspDownloadFileWrong, MSG_ID_RSP_DOWNLOAD_FILE_WRONG, "RspDFW", PriorityControl)
+
+	registerOneMessageType(&ReqClearDownloadTask, MSG_ID_REQ_CLEAR_DOWNLOAD_TASK, "ReqCDT", PriorityControl)
 
 	// register new pp
-	registerOneMessageType(&ReqRegisterNewPP, MSG_ID_REQ_REGISTER_NEWPP, "ReqRgNPP")
-	registerOneMessageType(&RspRegisterNewPP, MSG_ID_RSP_REGISTER_NEWPP, "RspRgNPP")
+	registerOneMessageType(&ReqRegisterNewPP, MSG_ID_REQ_REGISTER_NEWPP, "ReqRgNPP", PriorityControl)
+	registerOneMessageType(&RspRegisterNewPP, MSG_ID_RSP_REGISTER_NEWPP, "RspRgNPP", PriorityControl)
 
 	// backup and transfer
-	registerOneMessageType(&NoticeFileSliceBackup, MSG_ID_NOTICE_FILESLICE_BACKUP, "NotFSB")
-
-	registerOneMessageType(&ReqTransferDownload, MSG_ID_REQ_TRANSFER_DOWNLOAD, "ReqTdl")
-	registerOneMessageType(&RspTransferDownload, MSG_ID_RSP_TRANSFER_DOWNLOAD, "RspTdl")
-	registerOneMessageType(&ReqTransferDownloadWrong, MSG_ID_REQ_TRANSFER_DOWNLOAD_WRONG, "ReqTDW")
-	registerOneMessageType(&RspTransferDownloadWrong, MSG_ID_RSP_TRANSFER_DOWNLOAD_WRONG, "RspTDW")
-
-	registerOneMessageType(&NoticeFileSliceVerify, MSG_ID_NOTICE_FILESLICE_VERIFY, "NotFSV")
-	registerOneMessageType(&ReqVerifyDownload, MSG_ID_REQ_VERIFY_DOWNLOAD, "ReqVdl")
-	registerOneMessageType(&RspVerifyDownload, MSG_ID_RSP_VERIFY_DOWNLOAD, "RspVdl")
-	registerOneMessageType(&ReqReportVerifyResult, MSG_ID_REQ_VERIFY_RESULT, "ReqVR")
-	registerOneMessageType(&RspReportVerifyResult, MSG_ID_RSP_VERIFY_RESULT, "RspVR")
-	registerOneMessageType(&RspVerifyDownloadResult, MSG_ID_RSP_VERIFY_DOWNLOAD_RESULT, "RspVdlR")
-
-	registerOneMessageType(&RspTransferDownloadResult, MSG_ID_RSP_TRANSFER_DOWNLOAD_RESULT, "RspTdlR")
-
-	registerOneMessageType(&ReqReportBackupSliceResult, MSG_ID_REQ_REPORT_BACKUP_SLICE_RESULT, "ReqRBSR")
-	registerOneMessageType(&RspReportBackupSliceResult, MSG_ID_RSP_REPORT_BACKUP_SLICE_RESULT, "RspRBSR")
-	registerOneMessageType(&ReqFileBackupStatus, MSG_ID_REQ_FILE_BACKUP_STATUS, "ReqFBSt")
-	registerOneMessageType(&RspFileBackupStatus, MSG_ID_RSP_FILE_BACKUP_STATUS, "RspFBSt")
-	registerOneMessageType(&ReqFileReplicaInfo, MSG_ID_REQ_FILE_REPLICA_INFO, "ReqFRpIn")
-	registerOneMessageType(&RspFileReplicaInfo, MSG_ID_RSP_FILE_REPLICA_INFO, "RspFRpIn")
-	registerOneMessageType(&ReqFileStatus, MSG_ID_REQ_FILE_STATUS, "ReqFStat")
-	registerOneMessageType(&RspFileStatus, MSG_ID_RSP_FILE_STATUS, "RspFStat")
-	registerOneMessageType(&ReqShareLink, MSG_ID_REQ_SHARELINK, "ReqSL")
-	registerOneMessageType(&RspShareLink, MSG_ID_RSP_SHARELINK, "RspSL")
-	registerOneMessageType(&ReqShareFile, MSG_ID_REQ_SHARE_FILE, "ReqSF")
-	registerOneMessageType(&RspShareFile, MSG_ID_RSP_SHARE_FILE, "RspSF")
-	registerOneMessageType(&ReqDeleteShare, MSG_ID_REQ_DELETE_SHARE, "ReqDSF")
-	registerOneMessageType(&RspDeleteShare, MSG_ID_RSP_DELETE_SHARE, "RspDSF")
-	registerOneMessageType(&ReqGetShareFile, MSG_ID_REQ_GET_SHAREFILE, "ReqGSF")
-	registerOneMessageType(&RspGetShareFile, MSG_ID_RSP_GET_SHAREFILE, "RspGSF")
+	registerOneMessageType(&NoticeFileSliceBackup, MSG_ID_NOTICE_FILESLICE_BACKUP, "NotFSB", PriorityControl)
+
+	registerOneMessageType(&ReqTransferDownload, MSG_ID_REQ_TRANSFER_DOWNLOAD, "ReqTdl", PriorityBulk)
+	registerOneMessageType(&RspTransferDownload, MSG_ID_RSP_TRANSFER_DOWNLOAD, "RspTdl", PriorityBulk)
+	registerOneMessageType(&ReqTransferDownloadWrong, MSG_ID_REQ_TRANSFER_DOWNLOAD_WRONG, "ReqTDW", PriorityControl)
+	registerOneMessageType(&RspTransferDownloadWrong, MSG_ID_RSP_TRANSFER_DOWNLOAD_WRONG, "RspTDW", PriorityControl)
+
+	registerOneMessageType(&NoticeFileSliceVerify, MSG_ID_NOTICE_FILESLICE_VERIFY, "NotFSV", PriorityVerification)
+	registerOneMessageType(&ReqVerifyDownload, MSG_ID_REQ_VERIFY_DOWNLOAD, "ReqVdl", PriorityVerification)
+	registerOneMessageType(&RspVerifyDownload, MSG_ID_RSP_VERIFY_DOWNLOAD, "RspVdl", PriorityVerification)
+	registerOneMessageType(&ReqReportVerifyResult, MSG_ID_REQ_VERIFY_RESULT, "ReqVR", PriorityVerification)
+	registerOneMessageType(&RspReportVerifyResult, MSG_ID_RSP_VERIFY_RESULT, "RspVR", PriorityVerification)
+
+	// stored slices
+	registerOneMessageType(&ReqReportLostSlices, MSG_ID_REQ_REPORT_LOST_SLICES, "ReqRLS", PriorityControl)
+	registerOneMessageType(&RspReportLostSlices, MSG_ID_RSP_REPORT_LOST_SLICES, "RspRLS", PriorityControl)
+	registerOneMessageType(&ReqSliceIndex, MSG_ID_REQ_SLICE_INDEX, "ReqSlIdx", PriorityControl)
+	registerOneMessageType(&RspSliceIndex, MSG_ID_RSP_SLICE_INDEX, "RspSlIdx", PriorityControl)
+	registerOneMessageType(&RspVerifyDownloadResult, MSG_ID_RSP_VERIFY_DOWNLOAD_RESULT, "RspVdlR", PriorityVerification)
+
+	registerOneMessageType(&RspTransferDownloadResult, MSG_ID_RSP_TRANSFER_DOWNLOAD_RESULT, "RspTdlR", PriorityControl)
+
+	registerOneMessageType(&ReqReportBackupSliceResult, MSG_ID_REQ_REPORT_BACKUP_SLICE_RESULT, "ReqRBSR", PriorityControl)
+	registerOneMessageType(&RspReportBackupSliceResult, MSG_ID_RSP_REPORT_BACKUP_SLICE_RESULT, "RspRBSR", PriorityControl)
+	registerOneMessageType(&ReqFileBackupStatus, MSG_ID_REQ_FILE_BACKUP_STATUS, "ReqFBSt", PriorityControl)
+	registerOneMessageType(&RspFileBackupStatus, MSG_ID_RSP_FILE_BACKUP_STATUS, "RspFBSt", PriorityControl)
+	registerOneMessageType(&ReqFileReplicaInfo, MSG_ID_REQ_FILE_REPLICA_INFO, "ReqFRpIn", PriorityControl)
+	registerOneMessageType(&RspFileReplicaInfo, MSG_ID_RSP_FILE_REPLICA_INFO, "RspFRpIn", PriorityControl)
+	registerOneMessageType(&ReqFileStatus, MSG_ID_REQ_FILE_STATUS, "ReqFStat", PriorityControl)
+	registerOneMessageType(&RspFileStatus, MSG_ID_RSP_FILE_STATUS, "RspFStat", PriorityControl)
+	registerOneMessageType(&ReqShareLink, MSG_ID_REQ_SHARELINK, "ReqSL", PriorityControl)
+	registerOneMessageType(&RspShareLink, MSG_ID_RSP_SHARELINK, "RspSL", PriorityControl)
+	registerOneMessageType(&ReqShareFile, MSG_ID_REQ_SHARE_FILE, "ReqSF", PriorityControl)
+	registerOneMessageType(&RspShareFile, MSG_ID_RSP_SHARE_FILE, "RspSF", PriorityControl)
+	registerOneMessageType(&ReqDeleteShare, MSG_ID_REQ_DELETE_SHARE, "ReqDSF", PriorityControl)
+	registerOneMessageType(&RspDeleteShare, MSG_ID_RSP_DELETE_SHARE, "RspDSF", PriorityControl)
+	registerOneMessageType(&ReqGetShareFile, MSG_ID_REQ_GET_SHAREFILE, "ReqGSF", PriorityControl)
+	registerOneMessageType(&RspGetShareFile, MSG_ID_RSP_GET_SHAREFILE, "RspGSF", PriorityControl)
 
 	// heartbeat
-	registerOneMessageType(&ReqSpLatencyCheck, MSG_ID_REQ_SP_LATENCY_CHECK, "ReqSpLat")
-	registerOneMessageType(&RspSpLatencyCheck, MSG_ID_RSP_SP_LATENCY_CHECK, "RspSpLat")
+	registerOneMessageType(&ReqSpLatencyCheck, MSG_ID_REQ_SP_LATENCY_CHECK, "ReqSpLat", PriorityControl)
+	registerOneMessageType(&RspSpLatencyCheck, MSG_ID_RSP_SP_LATENCY_CHECK, "RspSpLat", PriorityControl)
 
 	// report node status
-	registerOneMessageType(&ReqReportNodeStatus, MSG_ID_REQ_REPORT_NODESTATUS, "ReqRNS")
-	registerOneMessageType(&RspReportNodeStatus, MSG_ID_RSP_REPORT_NODESTATUS, "RspRNS")
+	registerOneMessageType(&ReqReportNodeStatus, MSG_ID_REQ_REPORT_NODESTATUS, "ReqRNS", PriorityControl)
+	registerOneMessageType(&RspReportNodeStatus, MSG_ID_RSP_REPORT_NODESTATUS, "RspRNS", PriorityControl)
 	// Check status of SP node
-	registerOneMessageType(&ReqSpStatus, MSG_ID_REQ_SP_STATUS, "ReqSpSta")
-	registerOneMessageType(&RspSpStatus, MSG_ID_RSP_SP_STATUS, "RspSpSta")
-	registerOneMessageType(&ReqBLSSignature, MSG_ID_REQ_BLS_SIGNATURE, "ReqBLS")
-	registerOneMessageType(&RspBLSSignature, MSG_ID_RSP_BLS_SIGNATURE, "RspBLS")
+	registerOneMessageType(&ReqSpStatus, MSG_ID_REQ_SP_STATUS, "ReqSpSta", PriorityControl)
+	registerOneMessageType(&RspSpStatus, MSG_ID_RSP_SP_STATUS, "RspSpSta", PriorityControl)
+	registerOneMessageType(&ReqBLSSignature, MSG_ID_REQ_BLS_SIGNATURE, "ReqBLS", PriorityControl)
+	registerOneMessageType(&RspBLSSignature, MSG_ID_RSP_BLS_SIGNATURE, "RspBLS", PriorityControl)
 
-	registerOneMessageType(&RspBadVersion, MSG_ID_RSP_BADVERSION, "RspBdVer")
-	registerOneMessageType(&NoticeSpUnderMaintenance, MSG_ID_NOTICE_SP_UNDERMAINTENANCE, "NotMtnc")
+	registerOneMessageType(&RspBadVersion, MSG_ID_RSP_BADVERSION, "RspBdVer", PriorityControl)
+	registerOneMessageType(&NoticeSpUnderMaintenance, MSG_ID_NOTICE_SP_UNDERMAINTENANCE, "NotMtnc", PriorityControl)
 
-	registerOneMessageType(&ReqClearExpiredShareLinks, MSG_ID_REQ_CLEAR_EXPIRED_SHARE_LINKS, "ReqCESL")
-	registerOneMessageType(&RspClearExpiredShareLinks, MSG_ID_RSP_CLEAR_EXPIRED_SHARE_LINKS, "RspCESL")
+	registerOneMessageType(&ReqClearExpiredShareLinks, MSG_ID_REQ_CLEAR_EXPIRED_SHARE_LINKS, "ReqCESL", PriorityControl)
+	registerOneMessageType(&RspClearExpiredShareLinks, MSG_ID_RSP_CLEAR_EXPIRED_SHARE_LINKS, "RspCESL", PriorityControl)
 }
 
 func GetMsgTypeFromId(id uint8) *MsgType {
@@ -456,6 +498,14 @@ func GetMsgTypeFromId(id uint8) *MsgType {
 	return registeredMessages[id]
 }
 
+// GetPriorityFromId returns the priority class of the message type. Unknown message types are handled as control messages
+func GetPriorityFromId(id uint8) Priority {
+	if msgType := GetMsgTypeFromId(id); msgType != nil {
+		return msgType.Priority
+	}
+	return PriorityControl
+}
+
 func GetReqIdFromRspId(reqId uint8) uint8 {
 	switch reqId {
 	case MSG_ID_RSP_GET_SPLIST:
@@ -546,6 +596,10 @@ func GetReqIdFromRspId(reqId uint8) uint8 {
 		return MSG_ID_REQ_BLS_SIGNATURE
 	case MSG_ID_RSP_CLEAR_EXPIRED_SHARE_LINKS:
 		return MSG_ID_REQ_CLEAR_EXPIRED_SHARE_LINKS
+	case MSG_ID_RSP_REPORT_LOST_SLICES:
+		return MSG_ID_REQ_REPORT_LOST_SLICES
+	case MSG_ID_RSP_SLICE_INDEX:
+		return MSG_ID_REQ_SLICE_INDEX
 	default:
 		return MSG_ID_INVALID
 	}
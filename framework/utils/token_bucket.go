@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// minBurst lets a bucket with a low rate still take a whole packet at once
+const minBurst = 64 * 1024
+
+// TokenBucket limits a flow of bytes to a rate in bytes per second. A rate of 0 means unlimited
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewTokenBucket(rate uint64) *TokenBucket {
+	b := &TokenBucket{}
+	b.SetRate(rate)
+	return b
+}
+
+// SetRate changes the rate of the bucket. The bucket holds up to a tenth of a second of traffic
+func (b *TokenBucket) SetRate(rate uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rate = float64(rate)
+	b.burst = b.rate / 10
+	if b.burst < minBurst {
+		b.burst = minBurst
+	}
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *TokenBucket) GetRate() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return uint64(b.rate)
+}
+
+// Take removes n tokens from the bucket, and returns how long to wait before the n bytes can be transferred.
+// The bucket goes into debt when there are not enough tokens, so that the bytes taken later wait longer
+func (b *TokenBucket) Take(n int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rate == 0 {
+		return 0
+	}
+	now := time.Now()
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	} else {
+		b.tokens = b.burst
+	}
+	b.last = now
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// Wait blocks until n bytes can be transferred
+func (b *TokenBucket) Wait(n int) {
+	if d := b.Take(n); d > 0 {
+		time.Sleep(d)
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/framework/utils/httpserv"
+
+	"github.com/stratosnet/sds/pp/namespace"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+// downloadRangeHttp serves a file of the node's wallet, or the byte range of it given by the Range header
+func downloadRangeHttp(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ownerWalletAddress, fileHash, err := parseFilePath(req.RequestURI)
+	if err != nil {
+		w.WriteHeader(setting.FAILCode)
+		_, _ = w.Write(httpserv.NewErrorJson(setting.FAILCode, err.Error()).ToBytes())
+		return
+	}
+
+	var offset int64
+	var length uint64
+	rangeHeader := req.Header.Get("Range")
+	if rangeHeader != "" {
+		offset, length, err = ParseByteRange(rangeHeader)
+		if err != nil {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			_, _ = w.Write(httpserv.NewErrorJson(http.StatusRequestedRangeNotSatisfiable, err.Error()).ToBytes())
+			return
+		}
+	}
+
+	walletSign, reqTime, err := getWalletSignFromLocal(req, fileHash)
+	if err != nil {
+		w.WriteHeader(setting.FAILCode)
+		_, _ = w.Write(httpserv.NewErrorJson(setting.FAILCode, err.Error()).ToBytes())
+		return
+	}
+
+	sdmPath := fwtypes.DataMeshId{
+		Owner: ownerWalletAddress,
+		Hash:  fileHash,
+	}.String()
+
+	r, res := namespace.OpenRangeDownload(ctx, sdmPath, *walletSign, reqTime)
+	if r == nil {
+		w.WriteHeader(setting.FAILCode)
+		_, _ = w.Write(httpserv.NewErrorJson(setting.FAILCode, "failed to get file storage info, "+res.Return).ToBytes())
+		return
+	}
+	defer r.Close(ctx)
+
+	fileSize := r.FileInfo.FileSize
+	start, end, ok := r.Bounds(offset, length, 0)
+	if !ok || (rangeHeader != "" && start == end) {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", fileSize))
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatUint(end-start, 10))
+	if rangeHeader != "" {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, fileSize))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	if req.Method == http.MethodHead {
+		return
+	}
+
+	err = r.Read(ctx, start, end, func(data []byte) error {
+		_, err := w.Write(data)
+		return err
+	})
+	if err != nil {
+		// the status has been sent already, the client sees a response shorter than its Content-Length
+		utils.ErrorLog("failed sending the range of file "+fileHash, err)
+	}
+}
+
+// ParseByteRange parses a single range "bytes=first-last", "bytes=first-" or "bytes=-suffix" into the offset and
+// the length of the read. A negative offset is counted from the end of the file, a zero length reads up to the end
+func ParseByteRange(rangeHeader string) (offset int64, length uint64, err error) {
+	if !strings.HasPrefix(rangeHeader, "bytes=") {
+		return 0, 0, errors.New("only byte ranges are supported")
+	}
+	spec := strings.TrimPrefix(rangeHeader, "bytes=")
+	if strings.Contains(spec, ",") {
+		return 0, 0, errors.New("multiple ranges are not supported")
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, errors.New("invalid range " + spec)
+	}
+
+	if first == "" {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, errors.New("invalid suffix range " + spec)
+		}
+		return -suffix, 0, nil
+	}
+
+	offset, err = strconv.ParseInt(first, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, 0, errors.New("invalid range start " + spec)
+	}
+	if last == "" {
+		return offset, 0, nil
+	}
+	end, err := strconv.ParseInt(last, 10, 64)
+	if err != nil || end < offset {
+		return 0, 0, errors.New("invalid range end " + spec)
+	}
+	return offset, uint64(end-offset) + 1, nil
+}
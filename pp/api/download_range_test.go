@@ -0,0 +1,41 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestParseByteRange(t *testing.T) {
+	tests := []struct {
+		header string
+		offset int64
+		length uint64
+		valid  bool
+	}{
+		{"bytes=0-99", 0, 100, true},
+		{"bytes=100-", 100, 0, true},
+		{"bytes=-8", -8, 0, true},
+		{"bytes=5-5", 5, 1, true},
+		{"bytes=10-5", 0, 0, false},
+		{"bytes=-0", 0, 0, false},
+		{"bytes=0-1,5-6", 0, 0, false},
+		{"items=0-1", 0, 0, false},
+		{"bytes=abc", 0, 0, false},
+	}
+
+	for _, test := range tests {
+		offset, length, err := ParseByteRange(test.header)
+		if !test.valid {
+			if err == nil {
+				t.Errorf("%v: expected an error", test.header)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", test.header, err)
+			continue
+		}
+		if offset != test.offset || length != test.length {
+			t.Errorf("%v: got offset %v length %v, expected offset %v length %v", test.header, offset, length, test.offset, test.length)
+		}
+	}
+}
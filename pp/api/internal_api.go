@@ -16,6 +16,7 @@ func StartHTTPServ(ctx context.Context) {
 	httpServ.MyRoute("/streamVideoStorageInfoHttp/", streamVideoInfoHttp)
 	httpServ.MyRoute("/streamVideoHttp/", streamVideoHttp)
 	httpServ.MyRoute("/clearStreamTask/", clearStreamTask)
+	httpServ.MyRoute("/downloadRange/", downloadRangeHttp)
 	httpServ.MyStart(ctx)
 }
 
@@ -36,6 +36,11 @@ type ParamReqUploadFile struct {
 	AllowHigherTier bool      `json:"allow_higher_tier"`
 	ReqTime         int64     `json:"req_time"`
 	SequenceNumber  string    `json:"sequencenumber"`
+	// ContentDefinedChunking cuts the file into slices at content defined boundaries instead of fixed sizes
+	ContentDefinedChunking bool `json:"content_defined_chunking"`
+	// Compression compresses every slice with the codec (zstd or none) before it is uploaded. The codec is part of the
+	// file hash of a compressed file, which is computed with the tag "~"+codec
+	Compression string `json:"compression,omitempty"`
 }
 
 // upload: upload file data
@@ -70,6 +75,15 @@ type ParamReqDownloadFile struct {
 	ReqTime    int64     `json:"req_time"`
 }
 
+// download: read a byte range of a file. A negative offset is counted from the end of the file, a zero length reads up to the end
+type ParamReqDownloadRange struct {
+	FileHandle string    `json:"filehandle"`
+	Signature  Signature `json:"signature"`
+	ReqTime    int64     `json:"req_time"`
+	Offset     int64     `json:"offset"`
+	Length     uint64    `json:"length"`
+}
+
 // download: download file data
 type ParamReqDownloadData struct {
 	FileHash       string `json:"filehash"`
@@ -160,6 +174,36 @@ type ParamReqDownloadShared struct {
 	ReqTime   int64     `json:"req_time"`
 }
 
+// download: list the journaled downloads of the node
+type ParamReqListDownloads struct {
+	Signature Signature `json:"signature"`
+	ReqTime   int64     `json:"req_time"`
+}
+
+// download: resume a journaled download
+type ParamReqResumeDownload struct {
+	FileHash  string    `json:"filehash"`
+	ReqId     string    `json:"reqid,omitempty"`
+	Signature Signature `json:"signature"`
+	ReqTime   int64     `json:"req_time"`
+}
+
+type DownloadInfo struct {
+	FileHash       string `json:"filehash"`
+	ReqId          string `json:"reqid,omitempty"`
+	FileName       string `json:"filename"`
+	SavePath       string `json:"savepath,omitempty"`
+	TotalSlices    int    `json:"totalslices"`
+	FinishedSlices int    `json:"finishedslices"`
+	UpdateTime     int64  `json:"updatetime"`
+	Ongoing        bool   `json:"ongoing"`
+}
+
+type DownloadListResult struct {
+	Return    string         `json:"return"`
+	Downloads []DownloadInfo `json:"downloads,omitempty"`
+}
+
 // ozone: get ozone
 type ParamReqGetOzone struct {
 	WalletAddr string `json:"walletaddr"`
@@ -329,6 +373,60 @@ type ServiceStatusResult struct {
 	Message string `json:"message"`
 }
 
+// reputation: show or clear the reputation of the nodes slices were transferred with
+type ParamReqPeerReputation struct {
+	Clear      bool   `json:"clear"`
+	P2PAddress string `json:"p2paddr,omitempty"` // only this node is cleared when set
+}
+
+type PeerReputationInfo struct {
+	P2PAddress       string  `json:"p2paddr"`
+	Score            float64 `json:"score"`
+	Successes        float64 `json:"successes"`
+	Failures         float64 `json:"failures"`
+	LatencyMs        int64   `json:"latency_ms"`
+	BlacklistedUntil int64   `json:"blacklisted_until,omitempty"`
+}
+
+type PeerReputationResult struct {
+	Return  string               `json:"return"`
+	Cleared int                  `json:"cleared,omitempty"`
+	Peers   []PeerReputationInfo `json:"peers,omitempty"`
+}
+
+type ParamReqStorageUsage struct {
+	By    string `json:"by"`              // "file", "owner" or "day"
+	Count int    `json:"count,omitempty"` // top files or days to return
+}
+
+type FileUsageInfo struct {
+	FileHash string `json:"filehash"`
+	Owner    string `json:"owner,omitempty"`
+	Slices   int    `json:"slices"`
+	Bytes    int64  `json:"bytes"`
+}
+
+type OwnerUsageInfo struct {
+	Owner  string `json:"owner"`
+	Files  int    `json:"files"`
+	Slices int    `json:"slices"`
+	Bytes  int64  `json:"bytes"`
+}
+
+type DayUsageInfo struct {
+	Day    string `json:"day"`
+	Slices int    `json:"slices"`
+	Bytes  int64  `json:"bytes"`
+}
+
+type StorageUsageResult struct {
+	Return  string           `json:"return"`
+	Message string           `json:"message,omitempty"`
+	Files   []FileUsageInfo  `json:"files,omitempty"`
+	Owners  []OwnerUsageInfo `json:"owners,omitempty"`
+	Days    []DayUsageInfo   `json:"days,omitempty"`
+}
+
 type ParamReqUpdatePPInfo struct {
 	Moniker         string `json:"moniker"`
 	Identity        string `json:"identity"`
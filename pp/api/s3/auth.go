@@ -0,0 +1,336 @@
+package s3
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	signV4Algorithm = "AWS4-HMAC-SHA256"
+	amzDateFormat   = "20060102T150405Z"
+
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// the payload of the requests using this prefix is sent in aws-chunked encoding
+	streamingPayloadPrefix = "STREAMING-"
+	// the only streaming payload with signed chunks which is supported, the chunks are signed with HMAC-SHA256 and
+	// there are no trailing headers
+	signedStreamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	// the streaming payloads whose chunks aren't signed
+	unsignedStreamingPayload = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+	signedChunkAlgorithm     = "AWS4-HMAC-SHA256-PAYLOAD"
+	emptySha256              = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	maxSignedChunkSize       = 16 * 1024 * 1024
+
+	// requests signed longer ago, or further in the future, are rejected
+	maxRequestTimeSkew = 15 * time.Minute
+)
+
+var (
+	errAccessDenied         = errors.New("access denied")
+	errSignatureMismatch    = errors.New("the request signature we calculated does not match the signature you provided")
+	errRequestTimeTooSkewed = errors.New("the difference between the request time and the current time is too large")
+)
+
+// Credentials the keys the S3 clients sign their requests with
+type Credentials struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+}
+
+// verifyRequest checks the AWS signature V4 in the Authorization header of the request. It returns the signer checking
+// the chunks of a signed streaming payload. Without credentials, every request is accepted
+func (c Credentials) verifyRequest(req *http.Request, now time.Time) (*chunkSigner, error) {
+	if c.AccessKey == "" {
+		return nil, nil
+	}
+
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, signV4Algorithm+" ") {
+		return nil, errAccessDenied
+	}
+	var credential, signedHeaders, signature string
+	for _, field := range strings.Split(strings.TrimPrefix(auth, signV4Algorithm+" "), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch name {
+		case "Credential":
+			credential = value
+		case "SignedHeaders":
+			signedHeaders = value
+		case "Signature":
+			signature = value
+		}
+	}
+
+	// access_key/date/region/service/aws4_request
+	scope := strings.Split(credential, "/")
+	if len(scope) != 5 || scope[0] != c.AccessKey || scope[2] != c.Region || scope[3] != "s3" || scope[4] != "aws4_request" {
+		return nil, errAccessDenied
+	}
+	amzDate := req.Header.Get("X-Amz-Date")
+	if amzDate == "" {
+		amzDate = req.Header.Get("Date")
+	}
+	requestTime, err := time.Parse(amzDateFormat, amzDate)
+	if err != nil || !strings.HasPrefix(amzDate, scope[1]) {
+		return nil, errAccessDenied
+	}
+	if requestTime.Sub(now) > maxRequestTimeSkew || now.Sub(requestTime) > maxRequestTimeSkew {
+		return nil, errRequestTimeTooSkewed
+	}
+
+	payloadHash := req.Header.Get("X-Amz-Content-Sha256")
+	if payloadHash == "" {
+		payloadHash = unsignedPayload
+	}
+	// the chunks of the other streaming payloads are signed with ECDSA, or followed by signed trailing headers
+	if strings.HasPrefix(payloadHash, streamingPayloadPrefix) && payloadHash != signedStreamingPayload && payloadHash != unsignedStreamingPayload {
+		return nil, errNotImplemented
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders(req, signedHeaders),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		signV4Algorithm,
+		amzDate,
+		strings.Join(scope[1:], "/"),
+		hex.EncodeToString(canonicalHash[:]),
+	}, "\n")
+
+	key := hmacSha256([]byte("AWS4"+c.SecretKey), scope[1])
+	key = hmacSha256(key, scope[2])
+	key = hmacSha256(key, scope[3])
+	key = hmacSha256(key, scope[4])
+	expected := hex.EncodeToString(hmacSha256(key, stringToSign))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, errSignatureMismatch
+	}
+	if payloadHash != signedStreamingPayload {
+		return nil, nil
+	}
+	return &chunkSigner{key: key, amzDate: amzDate, scope: strings.Join(scope[1:], "/"), previous: signature}, nil
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// uriEncode percent-encodes every byte except the unreserved characters. Slashes are kept in paths
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func canonicalQuery(query url.Values) string {
+	var pairs []string
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(name, true)+"="+uriEncode(value, true))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func canonicalHeaders(req *http.Request, signedHeaders string) string {
+	var b strings.Builder
+	for _, name := range strings.Split(signedHeaders, ";") {
+		var values []string
+		switch name {
+		case "host":
+			values = []string{req.Host}
+		case "content-length":
+			values = []string{strconv.FormatInt(req.ContentLength, 10)}
+		default:
+			values = req.Header.Values(name)
+		}
+		for n, value := range values {
+			values[n] = strings.Join(strings.Fields(value), " ")
+		}
+		b.WriteString(name + ":" + strings.Join(values, ",") + "\n")
+	}
+	return b.String()
+}
+
+// chunkSigner checks the chain of chunk signatures of a STREAMING-AWS4-HMAC-SHA256-PAYLOAD request. Each chunk is
+// signed over the signature of the previous one, starting from the signature of the request
+type chunkSigner struct {
+	key      []byte
+	amzDate  string
+	scope    string
+	previous string
+}
+
+// verify checks the signature of the next chunk
+func (s *chunkSigner) verify(data []byte, signature string) error {
+	dataHash := sha256.Sum256(data)
+	stringToSign := strings.Join([]string{
+		signedChunkAlgorithm,
+		s.amzDate,
+		s.scope,
+		s.previous,
+		emptySha256,
+		hex.EncodeToString(dataHash[:]),
+	}, "\n")
+	expected := hex.EncodeToString(hmacSha256(s.key, stringToSign))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errSignatureMismatch
+	}
+	s.previous = signature
+	return nil
+}
+
+type chunkSignerKey struct{}
+
+// withChunkSigner returns a copy of the request whose payload is checked by the signer
+func withChunkSigner(req *http.Request, signer *chunkSigner) *http.Request {
+	if signer == nil {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), chunkSignerKey{}, signer))
+}
+
+// chunkedReader decodes a payload in aws-chunked encoding. With a signer, each chunk is only returned once its
+// signature is checked. The trailing headers are not checked, the content of the object is verified through its MD5
+// or SHA-256 instead
+type chunkedReader struct {
+	r         *bufio.Reader
+	signer    *chunkSigner
+	chunk     []byte // the signed chunk not read yet
+	remaining int64
+	done      bool
+}
+
+func newChunkedReader(r io.Reader, signer *chunkSigner) *chunkedReader {
+	return &chunkedReader{r: bufio.NewReader(r), signer: signer}
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	if c.done {
+		return 0, io.EOF
+	}
+	if c.signer != nil {
+		return c.readSigned(p)
+	}
+	if c.remaining == 0 {
+		size, _, err := c.readChunkHeader()
+		if err != nil {
+			return 0, err
+		}
+		if size == 0 {
+			c.done = true
+			return 0, io.EOF
+		}
+		c.remaining = size
+	}
+
+	if int64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+	n, err := c.r.Read(p)
+	c.remaining -= int64(n)
+	if err != nil {
+		return n, err
+	}
+	if c.remaining == 0 {
+		// the data of the chunk is followed by \r\n
+		if _, err = c.r.Discard(2); err != nil {
+			return n, errors.Wrap(err, "failed reading chunk end")
+		}
+	}
+	return n, nil
+}
+
+// readSigned reads whole chunks, and checks their signature before returning their data
+func (c *chunkedReader) readSigned(p []byte) (int, error) {
+	for len(c.chunk) == 0 {
+		size, signature, err := c.readChunkHeader()
+		if err != nil {
+			return 0, err
+		}
+		if size > maxSignedChunkSize {
+			return 0, errors.Errorf("chunk size %v is larger than %v", size, maxSignedChunkSize)
+		}
+		chunk := make([]byte, size+2)
+		if _, err = io.ReadFull(c.r, chunk); err != nil {
+			return 0, errors.Wrap(err, "failed reading chunk")
+		}
+		chunk = chunk[:size]
+		// the final chunk is empty, and signed as well
+		if err = c.signer.verify(chunk, signature); err != nil {
+			return 0, err
+		}
+		if size == 0 {
+			c.done = true
+			return 0, io.EOF
+		}
+		c.chunk = chunk
+	}
+	n := copy(p, c.chunk)
+	c.chunk = c.chunk[n:]
+	return n, nil
+}
+
+// readChunkHeader reads hex-size[;chunk-signature=...]\r\n
+func (c *chunkedReader) readChunkHeader() (int64, string, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed reading chunk header")
+	}
+	sizeField, extension, _ := strings.Cut(strings.TrimSpace(line), ";")
+	size, err := strconv.ParseInt(sizeField, 16, 64)
+	if err != nil || size < 0 {
+		return 0, "", errors.New("invalid chunk size " + sizeField)
+	}
+	_, signature, _ := strings.Cut(extension, "chunk-signature=")
+	return size, signature, nil
+}
+
+// payloadReader returns the content of the object sent in the body of the request, and its size. The size is -1 when unknown
+func payloadReader(req *http.Request) (io.Reader, int64, error) {
+	contentSha := req.Header.Get("X-Amz-Content-Sha256")
+	if !strings.HasPrefix(contentSha, streamingPayloadPrefix) && !strings.Contains(req.Header.Get("Content-Encoding"), "aws-chunked") {
+		return req.Body, req.ContentLength, nil
+	}
+	size := int64(-1)
+	if decoded := req.Header.Get("X-Amz-Decoded-Content-Length"); decoded != "" {
+		var err error
+		if size, err = strconv.ParseInt(decoded, 10, 64); err != nil {
+			return nil, 0, errors.New("invalid X-Amz-Decoded-Content-Length")
+		}
+	}
+	signer, _ := req.Context().Value(chunkSignerKey{}).(*chunkSigner)
+	return newChunkedReader(req.Body, signer), size, nil
+}
@@ -0,0 +1,325 @@
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	tmpFolder     = "tmp"
+	uploadsFolder = "uploads"
+)
+
+var (
+	errNotImplemented   = errors.New("a header or query you provided implies functionality that is not implemented")
+	errMethodNotAllowed = errors.New("the specified method is not allowed against this resource")
+	errMalformedXML     = errors.New("the XML you provided was not well-formed or did not validate against our published schema")
+	errInvalidArgument  = errors.New("invalid argument")
+)
+
+// apiErrors the S3 error code and HTTP status of the errors of the gateway
+var apiErrors = map[error]struct {
+	code   string
+	status int
+}{
+	errNoSuchBucket:         {"NoSuchBucket", http.StatusNotFound},
+	errNoSuchKey:            {"NoSuchKey", http.StatusNotFound},
+	errBucketExists:         {"BucketAlreadyOwnedByYou", http.StatusConflict},
+	errBucketNotEmpty:       {"BucketNotEmpty", http.StatusConflict},
+	errInvalidBucketName:    {"InvalidBucketName", http.StatusBadRequest},
+	errAccessDenied:         {"AccessDenied", http.StatusForbidden},
+	errSignatureMismatch:    {"SignatureDoesNotMatch", http.StatusForbidden},
+	errRequestTimeTooSkewed: {"RequestTimeTooSkewed", http.StatusForbidden},
+	errNoSuchUpload:         {"NoSuchUpload", http.StatusNotFound},
+	errInvalidPart:          {"InvalidPart", http.StatusBadRequest},
+	errInvalidPartOrder:     {"InvalidPartOrder", http.StatusBadRequest},
+	errInvalidRange:         {"InvalidRange", http.StatusRequestedRangeNotSatisfiable},
+	errBadDigest:            {"BadDigest", http.StatusBadRequest},
+	errContentSha256:        {"XAmzContentSHA256Mismatch", http.StatusBadRequest},
+	errIncompleteBody:       {"IncompleteBody", http.StatusBadRequest},
+	errNotImplemented:       {"NotImplemented", http.StatusNotImplemented},
+	errMethodNotAllowed:     {"MethodNotAllowed", http.StatusMethodNotAllowed},
+	errMalformedXML:         {"MalformedXML", http.StatusBadRequest},
+	errInvalidArgument:      {"InvalidArgument", http.StatusBadRequest},
+}
+
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	Resource  string   `xml:"Resource"`
+	RequestId string   `xml:"RequestId"`
+}
+
+// Gateway serves the S3 API. The buckets and keys are kept in an index, the content of the objects in the storage
+type Gateway struct {
+	folder      string
+	index       *Index
+	storage     Storage
+	credentials Credentials
+	files       fileLocks
+}
+
+// NewGateway creates a gateway keeping its index and the parts of the multipart uploads in the folder
+func NewGateway(folder string, credentials Credentials, storage Storage) (*Gateway, error) {
+	index, err := LoadIndex(folder)
+	if err != nil {
+		return nil, err
+	}
+	// the objects being uploaded when the node stopped are lost
+	if err = os.RemoveAll(filepath.Join(folder, tmpFolder)); err != nil {
+		return nil, errors.Wrap(err, "failed clearing the tmp folder")
+	}
+	return &Gateway{
+		folder:      folder,
+		index:       index,
+		storage:     storage,
+		credentials: credentials,
+		files:       fileLocks{locks: make(map[string]*fileLock)},
+	}, nil
+}
+
+// StartHTTPServ starts the S3 gateway storing the objects as files of the node wallet
+func StartHTTPServ(ctx context.Context) {
+	config := setting.Config.S3Gateway
+	gateway, err := NewGateway(config.IndexPath, Credentials{
+		AccessKey: config.AccessKey,
+		SecretKey: config.SecretKey,
+		Region:    config.Region,
+	}, sdsStorage{})
+	if err != nil {
+		utils.ErrorLog("failed starting the S3 gateway", err)
+		return
+	}
+	addr, err := listenAddress(config)
+	if err != nil {
+		utils.ErrorLog("failed starting the S3 gateway", err)
+		return
+	}
+
+	utils.Log("Start S3 gateway on " + addr + "...")
+	// no timeout handler: uploading or downloading a large object takes a while
+	server := &http.Server{Addr: addr, Handler: gateway}
+	server.BaseContext = func(listener net.Listener) context.Context {
+		return ctx
+	}
+	if err = server.ListenAndServe(); err != nil {
+		utils.ErrorLog("S3 gateway stopped", err)
+	}
+}
+
+// listenAddress the gateway listens on every interface when it authenticates the requests, and only on the loopback
+// interface otherwise
+func listenAddress(config setting.S3GatewayConfig) (string, error) {
+	if config.AccessKey == "" {
+		utils.Log("The S3 gateway doesn't authenticate the requests, access_key is empty. It only accepts local connections")
+		return "127.0.0.1:" + config.Port, nil
+	}
+	if config.SecretKey == "" {
+		return "", errors.New("secret_key is empty")
+	}
+	return ":" + config.Port, nil
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	signer, err := g.credentials.verifyRequest(req, time.Now())
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	req = withChunkSigner(req, signer)
+
+	// path-style requests: /bucket/key
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+	query := req.URL.Query()
+	switch {
+	case bucket == "":
+		if req.Method != http.MethodGet {
+			writeError(w, req, errMethodNotAllowed)
+			return
+		}
+		g.listBuckets(w, req)
+	case key == "":
+		g.serveBucket(w, req, bucket, query)
+	default:
+		g.serveObject(w, req, bucket, key, query)
+	}
+}
+
+func (g *Gateway) serveBucket(w http.ResponseWriter, req *http.Request, bucket string, query map[string][]string) {
+	has := func(name string) bool {
+		_, ok := query[name]
+		return ok
+	}
+	switch req.Method {
+	case http.MethodPut:
+		if err := g.index.CreateBucket(bucket); err != nil {
+			writeError(w, req, err)
+			return
+		}
+		w.Header().Set("Location", "/"+bucket)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		if err := g.index.DeleteBucket(bucket); err != nil {
+			writeError(w, req, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodHead:
+		if !g.index.HasBucket(bucket) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		switch {
+		case has("location"):
+			if !g.index.HasBucket(bucket) {
+				writeError(w, req, errNoSuchBucket)
+				return
+			}
+			writeXML(w, http.StatusOK, struct {
+				XMLName xml.Name `xml:"LocationConstraint"`
+				Xmlns   string   `xml:"xmlns,attr"`
+				Region  string   `xml:",chardata"`
+			}{Xmlns: s3Namespace, Region: g.credentials.Region})
+		case has("uploads"), has("versioning"), has("policy"), has("acl"), has("tagging"):
+			writeError(w, req, errNotImplemented)
+		default:
+			g.listObjects(w, req, bucket, query)
+		}
+	case http.MethodPost:
+		if !has("delete") {
+			writeError(w, req, errNotImplemented)
+			return
+		}
+		g.deleteObjects(w, req, bucket)
+	default:
+		writeError(w, req, errMethodNotAllowed)
+	}
+}
+
+func (g *Gateway) serveObject(w http.ResponseWriter, req *http.Request, bucket, key string, query map[string][]string) {
+	_, isMultipart := query["uploadId"]
+	switch req.Method {
+	case http.MethodPut:
+		if req.Header.Get("X-Amz-Copy-Source") != "" {
+			writeError(w, req, errNotImplemented)
+			return
+		}
+		if isMultipart {
+			g.uploadPart(w, req, bucket, key)
+			return
+		}
+		g.putObject(w, req, bucket, key)
+	case http.MethodPost:
+		if _, ok := query["uploads"]; ok {
+			g.createMultipartUpload(w, req, bucket, key)
+			return
+		}
+		if isMultipart {
+			g.completeMultipartUpload(w, req, bucket, key)
+			return
+		}
+		writeError(w, req, errNotImplemented)
+	case http.MethodDelete:
+		if isMultipart {
+			g.abortMultipartUpload(w, req, bucket, key)
+			return
+		}
+		g.deleteObject(w, req, bucket, key)
+	case http.MethodGet, http.MethodHead:
+		if isMultipart {
+			writeError(w, req, errNotImplemented)
+			return
+		}
+		g.getObject(w, req, bucket, key)
+	default:
+		writeError(w, req, errMethodNotAllowed)
+	}
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Owner   struct {
+		ID          string `xml:"ID"`
+		DisplayName string `xml:"DisplayName"`
+	} `xml:"Owner"`
+	Buckets []bucketEntry `xml:"Buckets>Bucket"`
+}
+
+type bucketEntry struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+func (g *Gateway) listBuckets(w http.ResponseWriter, _ *http.Request) {
+	result := listAllMyBucketsResult{Xmlns: s3Namespace}
+	result.Owner.ID = setting.WalletAddress
+	result.Owner.DisplayName = setting.WalletAddress
+	names, created := g.index.ListBuckets()
+	for n, name := range names {
+		result.Buckets = append(result.Buckets, bucketEntry{Name: name, CreationDate: formatTime(created[n])})
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+// newTmpFolder creates a folder for a file received by the gateway. It must be removed once the file is stored
+func (g *Gateway) newTmpFolder() (string, error) {
+	folder := filepath.Join(g.folder, tmpFolder, uuid.NewString())
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		return "", errors.Wrap(err, "failed creating tmp folder")
+	}
+	return folder, nil
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		utils.ErrorLog("failed marshalling S3 response", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, req *http.Request, err error) {
+	apiError, ok := apiErrors[errors.Cause(err)]
+	if !ok {
+		utils.ErrorLog("S3 gateway failed serving "+req.Method+" "+req.URL.Path, err)
+		apiError.code = "InternalError"
+		apiError.status = http.StatusInternalServerError
+	}
+	if req.Method == http.MethodHead {
+		w.WriteHeader(apiError.status)
+		return
+	}
+	writeXML(w, apiError.status, errorResponse{
+		Code:      apiError.code,
+		Message:   err.Error(),
+		Resource:  req.URL.Path,
+		RequestId: uuid.NewString(),
+	})
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
@@ -0,0 +1,171 @@
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+// memStorage keeps the files of the objects in memory
+type memStorage struct {
+	mutex   sync.Mutex
+	files   map[string][]byte
+	uploads int
+}
+
+func (s *memStorage) Upload(_ context.Context, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	fileHash, err := crypto.CalcFileHash(path, "", crypto.SDS_CODEC)
+	if err != nil {
+		return "", err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.files[fileHash] = data
+	s.uploads++
+	return fileHash, nil
+}
+
+func (s *memStorage) Download(_ context.Context, fileHash string, start, end uint64, w io.Writer) error {
+	s.mutex.Lock()
+	data := s.files[fileHash]
+	s.mutex.Unlock()
+	_, err := w.Write(data[start:end])
+	return err
+}
+
+func (s *memStorage) Delete(_ context.Context, fileHash string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.files, fileHash)
+	return nil
+}
+
+func newTestGateway(t *testing.T) (*Gateway, *memStorage) {
+	storage := &memStorage{files: make(map[string][]byte)}
+	gateway, err := NewGateway(t.TempDir(), Credentials{}, storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gateway, storage
+}
+
+func serve(g *Gateway, method, target, body string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for name, value := range header {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, req)
+	return w
+}
+
+func TestGatewayObjects(t *testing.T) {
+	g, storage := newTestGateway(t)
+	if w := serve(g, http.MethodPut, "/bucket", "", nil); w.Code != http.StatusOK {
+		t.Fatalf("failed creating the bucket: %v", w.Code)
+	}
+
+	// the same content under two keys is uploaded once
+	for _, key := range []string{"a/1", "b"} {
+		if w := serve(g, http.MethodPut, "/bucket/"+key, "0123456789", nil); w.Code != http.StatusOK {
+			t.Fatalf("failed putting %v: %v", key, w.Code)
+		}
+	}
+	if storage.uploads != 1 || len(storage.files) != 1 {
+		t.Fatalf("expected 1 upload and 1 file, got %v and %v", storage.uploads, len(storage.files))
+	}
+
+	w := serve(g, http.MethodGet, "/bucket/b", "", map[string]string{"Range": "bytes=2-4"})
+	if w.Code != http.StatusPartialContent || w.Body.String() != "234" {
+		t.Fatalf("wrong range response: %v %q", w.Code, w.Body.String())
+	}
+
+	w = serve(g, http.MethodGet, "/bucket?delimiter=/", "", nil)
+	var result struct {
+		Contents []struct {
+			Key string `xml:"Key"`
+		} `xml:"Contents"`
+		CommonPrefixes []struct {
+			Prefix string `xml:"Prefix"`
+		} `xml:"CommonPrefixes"`
+	}
+	if err := xml.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Contents) != 1 || result.Contents[0].Key != "b" || len(result.CommonPrefixes) != 1 || result.CommonPrefixes[0].Prefix != "a/" {
+		t.Fatalf("wrong listing: %+v", result)
+	}
+
+	// the file is deleted with the last key stored in it
+	serve(g, http.MethodDelete, "/bucket/a/1", "", nil)
+	if len(storage.files) != 1 {
+		t.Fatal("file deleted while a key still uses it")
+	}
+	serve(g, http.MethodPut, "/bucket/b", "replaced", nil)
+	if storage.uploads != 2 || len(storage.files) != 1 {
+		t.Fatalf("the file of the replaced object should be deleted, %v files left", len(storage.files))
+	}
+	if w = serve(g, http.MethodGet, "/bucket/b", "", nil); w.Body.String() != "replaced" {
+		t.Fatalf("wrong object content %q", w.Body.String())
+	}
+	serve(g, http.MethodDelete, "/bucket/b", "", nil)
+	if len(storage.files) != 0 {
+		t.Fatal("file not deleted with its last key")
+	}
+	if w = serve(g, http.MethodGet, "/bucket/b", "", nil); w.Code != http.StatusNotFound {
+		t.Fatalf("deleted object still served: %v", w.Code)
+	}
+}
+
+// TestGatewayConcurrentObjects puts and deletes keys of the same content concurrently. The file must remain stored as
+// long as a key uses it
+func TestGatewayConcurrentObjects(t *testing.T) {
+	g, storage := newTestGateway(t)
+	serve(g, http.MethodPut, "/bucket", "", nil)
+
+	wg := sync.WaitGroup{}
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				serve(g, http.MethodPut, "/bucket/"+key, "content", nil)
+				serve(g, http.MethodDelete, "/bucket/"+key, "", nil)
+			}
+			serve(g, http.MethodPut, "/bucket/"+key, "content", nil)
+		}(string(rune('a' + n)))
+	}
+	wg.Wait()
+
+	if len(storage.files) != 1 {
+		t.Fatalf("expected the file to be stored, %v files", len(storage.files))
+	}
+	if w := serve(g, http.MethodGet, "/bucket/a", "", nil); w.Body.String() != "content" {
+		t.Fatalf("wrong object content %q", w.Body.String())
+	}
+}
+
+func TestListenAddress(t *testing.T) {
+	if addr, _ := listenAddress(setting.S3GatewayConfig{Port: "9000"}); addr != "127.0.0.1:9000" {
+		t.Fatalf("gateway without authentication listens on %v", addr)
+	}
+	if addr, _ := listenAddress(setting.S3GatewayConfig{Port: "9000", AccessKey: "key", SecretKey: "secret"}); addr != ":9000" {
+		t.Fatalf("gateway with authentication listens on %v", addr)
+	}
+	if _, err := listenAddress(setting.S3GatewayConfig{Port: "9000", AccessKey: "key"}); err == nil {
+		t.Fatal("gateway started without a secret key")
+	}
+}
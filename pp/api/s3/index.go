@@ -0,0 +1,286 @@
+package s3
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const indexFileName = "index.json"
+
+var (
+	errNoSuchBucket      = errors.New("the specified bucket does not exist")
+	errBucketExists      = errors.New("the requested bucket name is not available")
+	errBucketNotEmpty    = errors.New("the bucket you tried to delete is not empty")
+	errNoSuchKey         = errors.New("the specified key does not exist")
+	errInvalidBucketName = errors.New("the specified bucket is not valid")
+)
+
+// Object an object of the gateway, stored as a file of the node wallet
+type Object struct {
+	FileHash     string    `json:"file_hash"`
+	Size         uint64    `json:"size"`
+	ETag         string    `json:"etag"`
+	ContentType  string    `json:"content_type,omitempty"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+type Bucket struct {
+	Created time.Time          `json:"created"`
+	Objects map[string]*Object `json:"objects"`
+}
+
+// ObjectEntry an object listed with its key
+type ObjectEntry struct {
+	Key string
+	*Object
+}
+
+// Index maps the buckets and keys of the gateway to the hashes of the files. It is saved to disk after each change
+type Index struct {
+	path    string
+	mutex   sync.RWMutex
+	Buckets map[string]*Bucket `json:"buckets"`
+	// refs the number of keys stored in each file
+	refs map[string]int
+}
+
+// LoadIndex loads the index saved in the folder, or creates an empty one
+func LoadIndex(folder string) (*Index, error) {
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		return nil, errors.Wrap(err, "failed creating the index folder")
+	}
+	index := &Index{
+		path:    filepath.Join(folder, indexFileName),
+		Buckets: make(map[string]*Bucket),
+		refs:    make(map[string]int),
+	}
+	data, err := os.ReadFile(index.path)
+	if os.IsNotExist(err) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading the index")
+	}
+	if err = json.Unmarshal(data, index); err != nil {
+		return nil, errors.Wrap(err, "failed parsing the index")
+	}
+	for _, bucket := range index.Buckets {
+		if bucket.Objects == nil {
+			bucket.Objects = make(map[string]*Object)
+		}
+		for _, object := range bucket.Objects {
+			index.addRef(object, 1)
+		}
+	}
+	return index, nil
+}
+
+// addRef counts the key of the object in the references of its file
+func (i *Index) addRef(object *Object, delta int) {
+	if object == nil || object.FileHash == "" {
+		return
+	}
+	if i.refs[object.FileHash] += delta; i.refs[object.FileHash] <= 0 {
+		delete(i.refs, object.FileHash)
+	}
+}
+
+// save writes the index to a temporary file first, so a crash never leaves a truncated index behind
+func (i *Index) save() error {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+	tmpPath := i.path + ".tmp"
+	if err = os.WriteFile(tmpPath, data, 0600); err != nil {
+		return errors.Wrap(err, "failed writing the index")
+	}
+	return os.Rename(tmpPath, i.path)
+}
+
+func (i *Index) CreateBucket(name string) error {
+	if !validBucketName(name) {
+		return errInvalidBucketName
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if _, ok := i.Buckets[name]; ok {
+		return errBucketExists
+	}
+	i.Buckets[name] = &Bucket{Created: time.Now().UTC(), Objects: make(map[string]*Object)}
+	return i.save()
+}
+
+func (i *Index) DeleteBucket(name string) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	bucket, ok := i.Buckets[name]
+	if !ok {
+		return errNoSuchBucket
+	}
+	if len(bucket.Objects) > 0 {
+		return errBucketNotEmpty
+	}
+	delete(i.Buckets, name)
+	return i.save()
+}
+
+func (i *Index) HasBucket(name string) bool {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	_, ok := i.Buckets[name]
+	return ok
+}
+
+// ListBuckets returns the names of the buckets in order, with their creation time
+func (i *Index) ListBuckets() ([]string, []time.Time) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	names := make([]string, 0, len(i.Buckets))
+	for name := range i.Buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	created := make([]time.Time, len(names))
+	for n, name := range names {
+		created[n] = i.Buckets[name].Created
+	}
+	return names, created
+}
+
+func (i *Index) GetObject(bucketName, key string) (*Object, error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	bucket, ok := i.Buckets[bucketName]
+	if !ok {
+		return nil, errNoSuchBucket
+	}
+	object, ok := bucket.Objects[key]
+	if !ok {
+		return nil, errNoSuchKey
+	}
+	return object, nil
+}
+
+// PutObject stores the object under the key. It returns the object it replaces, if any
+func (i *Index) PutObject(bucketName, key string, object *Object) (*Object, error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	bucket, ok := i.Buckets[bucketName]
+	if !ok {
+		return nil, errNoSuchBucket
+	}
+	replaced := bucket.Objects[key]
+	bucket.Objects[key] = object
+	if err := i.save(); err != nil {
+		if replaced != nil {
+			bucket.Objects[key] = replaced
+		} else {
+			delete(bucket.Objects, key)
+		}
+		return nil, err
+	}
+	i.addRef(replaced, -1)
+	i.addRef(object, 1)
+	return replaced, nil
+}
+
+// DeleteObject removes the key from the bucket. It returns the deleted object, nil when the key doesn't exist
+func (i *Index) DeleteObject(bucketName, key string) (*Object, error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	bucket, ok := i.Buckets[bucketName]
+	if !ok {
+		return nil, errNoSuchBucket
+	}
+	object, ok := bucket.Objects[key]
+	if !ok {
+		return nil, nil
+	}
+	delete(bucket.Objects, key)
+	if err := i.save(); err != nil {
+		bucket.Objects[key] = object
+		return nil, err
+	}
+	i.addRef(object, -1)
+	return object, nil
+}
+
+// IsFileReferenced tells whether a key of any bucket is stored in the file
+func (i *Index) IsFileReferenced(fileHash string) bool {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return i.refs[fileHash] > 0
+}
+
+// ListObjects returns in order the objects of the bucket whose key has the prefix and comes after startAfter, at most
+// maxKeys entries. With a delimiter, the keys having the delimiter after the prefix are rolled up into common prefixes.
+// When the result is truncated, the listing continues after the returned marker
+func (i *Index) ListObjects(bucketName, prefix, delimiter, startAfter string, maxKeys int) (objects []ObjectEntry,
+	commonPrefixes []string, truncated bool, marker string, err error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	bucket, ok := i.Buckets[bucketName]
+	if !ok {
+		return nil, nil, false, "", errNoSuchBucket
+	}
+
+	keys := make([]string, 0, len(bucket.Objects))
+	for key := range bucket.Objects {
+		if strings.HasPrefix(key, prefix) && key > startAfter {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	count := 0
+	for _, key := range keys {
+		commonPrefix := ""
+		if delimiter != "" {
+			if n := strings.Index(key[len(prefix):], delimiter); n >= 0 {
+				commonPrefix = key[:len(prefix)+n+len(delimiter)]
+			}
+		}
+		// the keys rolled up into the last common prefix are skipped
+		if commonPrefix != "" && len(commonPrefixes) > 0 && commonPrefixes[len(commonPrefixes)-1] == commonPrefix {
+			continue
+		}
+		if count == maxKeys {
+			return objects, commonPrefixes, true, marker, nil
+		}
+		count++
+		if commonPrefix != "" {
+			commonPrefixes = append(commonPrefixes, commonPrefix)
+			// a marker past every key of the common prefix
+			marker = commonPrefix + string(rune(0x10FFFF))
+		} else {
+			objects = append(objects, ObjectEntry{Key: key, Object: bucket.Objects[key]})
+			marker = key
+		}
+	}
+	return objects, commonPrefixes, false, marker, nil
+}
+
+// validBucketName checks the S3 rules for bucket names: 3 to 63 lowercase letters, digits, dots and hyphens,
+// starting and ending with a letter or a digit
+func validBucketName(name string) bool {
+	if len(name) < 3 || len(name) > 63 {
+		return false
+	}
+	for n, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		case (c == '.' || c == '-') && n > 0 && n < len(name)-1:
+		default:
+			return false
+		}
+	}
+	return !strings.Contains(name, "..")
+}
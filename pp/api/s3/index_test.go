@@ -0,0 +1,64 @@
+package s3
+
+import (
+	"testing"
+)
+
+func TestIndexFileReferences(t *testing.T) {
+	folder := t.TempDir()
+	index, err := LoadIndex(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = index.CreateBucket("bucket"); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = index.PutObject("bucket", "a", &Object{FileHash: "file1"})
+	_, _ = index.PutObject("bucket", "b", &Object{FileHash: "file1"})
+	_, _ = index.PutObject("bucket", "c", &Object{FileHash: "file2"})
+	if replaced, _ := index.PutObject("bucket", "c", &Object{FileHash: "file3"}); replaced == nil || replaced.FileHash != "file2" {
+		t.Fatalf("wrong replaced object %v", replaced)
+	}
+	if index.IsFileReferenced("file2") {
+		t.Fatal("the file of a replaced object is still referenced")
+	}
+	_, _ = index.DeleteObject("bucket", "a")
+	if !index.IsFileReferenced("file1") {
+		t.Fatal("file1 is still stored under b")
+	}
+
+	// the references are counted again when the index is loaded
+	reloaded, err := LoadIndex(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded.IsFileReferenced("file1") || !reloaded.IsFileReferenced("file3") || reloaded.IsFileReferenced("file2") {
+		t.Fatalf("wrong references after reload: %v", reloaded.refs)
+	}
+	_, _ = reloaded.DeleteObject("bucket", "b")
+	if reloaded.IsFileReferenced("file1") {
+		t.Fatal("file1 is referenced by no key")
+	}
+}
+
+func TestIndexListObjects(t *testing.T) {
+	index, _ := LoadIndex(t.TempDir())
+	_ = index.CreateBucket("bucket")
+	for _, key := range []string{"a", "dir/1", "dir/2", "dir/sub/3", "e"} {
+		_, _ = index.PutObject("bucket", key, &Object{})
+	}
+
+	objects, prefixes, truncated, marker, err := index.ListObjects("bucket", "", "/", "", 2)
+	if err != nil || len(objects) != 1 || objects[0].Key != "a" || len(prefixes) != 1 || prefixes[0] != "dir/" || !truncated {
+		t.Fatalf("wrong first page: %v %v %v %v", objects, prefixes, truncated, err)
+	}
+	objects, prefixes, truncated, _, _ = index.ListObjects("bucket", "", "/", marker, 2)
+	if len(objects) != 1 || objects[0].Key != "e" || len(prefixes) != 0 || truncated {
+		t.Fatalf("wrong second page: %v %v %v", objects, prefixes, truncated)
+	}
+
+	objects, prefixes, _, _, _ = index.ListObjects("bucket", "dir/", "/", "", 10)
+	if len(objects) != 2 || len(prefixes) != 1 || prefixes[0] != "dir/sub/" {
+		t.Fatalf("wrong listing of dir/: %v %v", objects, prefixes)
+	}
+}
@@ -0,0 +1,255 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	uploadInfoFileName = "upload.json"
+	maxPartNumber      = 10000
+)
+
+var (
+	errNoSuchUpload     = errors.New("the specified multipart upload does not exist")
+	errInvalidPart      = errors.New("one or more of the specified parts could not be found, or its entity tag did not match")
+	errInvalidPartOrder = errors.New("the list of parts was not in ascending order")
+)
+
+// multipartUpload an upload in progress. Its parts are saved next to it, until the upload is completed or aborted
+type multipartUpload struct {
+	Bucket      string    `json:"bucket"`
+	Key         string    `json:"key"`
+	ContentType string    `json:"content_type,omitempty"`
+	Initiated   time.Time `json:"initiated"`
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadId string   `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+func (g *Gateway) uploadFolder(uploadId string) string {
+	return filepath.Join(g.folder, uploadsFolder, uploadId)
+}
+
+func partFileName(partNumber int) string {
+	return "part." + strconv.Itoa(partNumber)
+}
+
+// loadUpload returns the folder of the multipart upload of the key
+func (g *Gateway) loadUpload(req *http.Request, bucket, key string) (string, *multipartUpload, error) {
+	uploadId := req.URL.Query().Get("uploadId")
+	if _, err := uuid.Parse(uploadId); err != nil {
+		return "", nil, errNoSuchUpload
+	}
+	folder := g.uploadFolder(uploadId)
+	data, err := os.ReadFile(filepath.Join(folder, uploadInfoFileName))
+	if os.IsNotExist(err) {
+		return "", nil, errNoSuchUpload
+	}
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed reading the multipart upload")
+	}
+	upload := &multipartUpload{}
+	if err = json.Unmarshal(data, upload); err != nil {
+		return "", nil, errors.Wrap(err, "failed parsing the multipart upload")
+	}
+	if upload.Bucket != bucket || upload.Key != key {
+		return "", nil, errNoSuchUpload
+	}
+	return folder, upload, nil
+}
+
+func (g *Gateway) createMultipartUpload(w http.ResponseWriter, req *http.Request, bucket, key string) {
+	if !g.index.HasBucket(bucket) {
+		writeError(w, req, errNoSuchBucket)
+		return
+	}
+	uploadId := uuid.NewString()
+	folder := g.uploadFolder(uploadId)
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		writeError(w, req, errors.Wrap(err, "failed creating the folder of the multipart upload"))
+		return
+	}
+	data, err := json.Marshal(multipartUpload{
+		Bucket:      bucket,
+		Key:         key,
+		ContentType: req.Header.Get("Content-Type"),
+		Initiated:   time.Now().UTC(),
+	})
+	if err == nil {
+		err = os.WriteFile(filepath.Join(folder, uploadInfoFileName), data, 0600)
+	}
+	if err != nil {
+		_ = os.RemoveAll(folder)
+		writeError(w, req, errors.Wrap(err, "failed saving the multipart upload"))
+		return
+	}
+
+	writeXML(w, http.StatusOK, initiateMultipartUploadResult{
+		Xmlns:    s3Namespace,
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadId,
+	})
+}
+
+func (g *Gateway) uploadPart(w http.ResponseWriter, req *http.Request, bucket, key string) {
+	partNumber, err := strconv.Atoi(req.URL.Query().Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > maxPartNumber {
+		writeError(w, req, errors.Wrap(errInvalidArgument, "part number must be an integer between 1 and 10000"))
+		return
+	}
+	folder, _, err := g.loadUpload(req, bucket, key)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	// the part is received aside, so a part uploaded again replaces the previous one only once complete
+	tmpFolder, err := g.newTmpFolder()
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpFolder)
+	}()
+	partPath, sum, _, err := receiveFile(req, tmpFolder, partFileName(partNumber))
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	if err = os.Rename(partPath, filepath.Join(folder, partFileName(partNumber))); err != nil {
+		writeError(w, req, errors.Wrap(err, "failed saving the part"))
+		return
+	}
+
+	w.Header().Set("ETag", strconv.Quote(hex.EncodeToString(sum)))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (g *Gateway) completeMultipartUpload(w http.ResponseWriter, req *http.Request, bucket, key string) {
+	folder, upload, err := g.loadUpload(req, bucket, key)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	var request completeMultipartUpload
+	if err = xml.NewDecoder(io.LimitReader(req.Body, maxXMLBodySize)).Decode(&request); err != nil || len(request.Parts) == 0 {
+		writeError(w, req, errMalformedXML)
+		return
+	}
+
+	tmpFolder, err := g.newTmpFolder()
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpFolder)
+	}()
+	filePath := filepath.Join(tmpFolder, fileNameOfKey(key))
+	size, etag, err := concatenateParts(folder, filePath, request)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	if _, err = g.storeFile(req.Context(), bucket, key, filePath, size, etag, upload.ContentType); err != nil {
+		writeError(w, req, err)
+		return
+	}
+	_ = os.RemoveAll(folder)
+
+	writeXML(w, http.StatusOK, completeMultipartUploadResult{
+		Xmlns:    s3Namespace,
+		Location: "/" + bucket + "/" + key,
+		Bucket:   bucket,
+		Key:      key,
+		ETag:     strconv.Quote(etag),
+	})
+}
+
+// concatenateParts writes the listed parts one after the other into the file. The entity tag of the object is the MD5 of
+// the MD5s of the parts, followed by the number of parts
+func concatenateParts(folder, filePath string, request completeMultipartUpload) (uint64, string, error) {
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed creating file")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	etagHash := md5.New()
+	size := uint64(0)
+	for n, part := range request.Parts {
+		if n > 0 && part.PartNumber <= request.Parts[n-1].PartNumber {
+			return 0, "", errInvalidPartOrder
+		}
+		partFile, err := os.Open(filepath.Join(folder, partFileName(part.PartNumber)))
+		if err != nil {
+			return 0, "", errInvalidPart
+		}
+		partHash := md5.New()
+		written, err := io.Copy(io.MultiWriter(f, partHash), partFile)
+		_ = partFile.Close()
+		if err != nil {
+			return 0, "", errors.Wrap(err, "failed copying part")
+		}
+		sum := partHash.Sum(nil)
+		if strings.Trim(part.ETag, "\"") != hex.EncodeToString(sum) {
+			return 0, "", errInvalidPart
+		}
+		etagHash.Write(sum)
+		size += uint64(written)
+	}
+	return size, fmt.Sprintf("%x-%d", etagHash.Sum(nil), len(request.Parts)), nil
+}
+
+func (g *Gateway) abortMultipartUpload(w http.ResponseWriter, req *http.Request, bucket, key string) {
+	folder, _, err := g.loadUpload(req, bucket, key)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	if err = os.RemoveAll(folder); err != nil {
+		writeError(w, req, errors.Wrap(err, "failed deleting the parts"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMultipartUpload(t *testing.T) {
+	g, storage := newTestGateway(t)
+	serve(g, http.MethodPut, "/bucket", "", nil)
+
+	w := serve(g, http.MethodPost, "/bucket/object?uploads", "", map[string]string{"Content-Type": "text/plain"})
+	var initiated initiateMultipartUploadResult
+	if err := xml.Unmarshal(w.Body.Bytes(), &initiated); err != nil || initiated.UploadId == "" {
+		t.Fatalf("failed creating the multipart upload: %v %v", w.Code, err)
+	}
+	uploadQuery := "uploadId=" + initiated.UploadId
+
+	parts := []string{"first part ", "second part"}
+	etags := make([]string, len(parts))
+	for n, part := range parts {
+		// the second part is uploaded twice, the last upload replaces the first
+		if n == 1 {
+			serve(g, http.MethodPut, "/bucket/object?partNumber=2&"+uploadQuery, "discarded", nil)
+		}
+		w = serve(g, http.MethodPut, "/bucket/object?partNumber="+strconv.Itoa(n+1)+"&"+uploadQuery, part, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("failed uploading part %v: %v", n+1, w.Code)
+		}
+		etags[n] = w.Header().Get("ETag")
+	}
+
+	complete := "<CompleteMultipartUpload>"
+	for n, etag := range etags {
+		complete += fmt.Sprintf("<Part><PartNumber>%d</PartNumber><ETag>%s</ETag></Part>", n+1, etag)
+	}
+	complete += "</CompleteMultipartUpload>"
+	w = serve(g, http.MethodPost, "/bucket/object?"+uploadQuery, complete, nil)
+	var completed completeMultipartUploadResult
+	if err := xml.Unmarshal(w.Body.Bytes(), &completed); err != nil {
+		t.Fatalf("failed completing the multipart upload: %v %v", w.Code, w.Body.String())
+	}
+
+	etagHash := md5.New()
+	for _, part := range parts {
+		sum := md5.Sum([]byte(part))
+		etagHash.Write(sum[:])
+	}
+	if expected := strconv.Quote(hex.EncodeToString(etagHash.Sum(nil)) + "-2"); completed.ETag != expected {
+		t.Fatalf("wrong entity tag %v, expected %v", completed.ETag, expected)
+	}
+	if len(storage.files) != 1 {
+		t.Fatalf("expected 1 stored file, got %v", len(storage.files))
+	}
+	w = serve(g, http.MethodGet, "/bucket/object", "", nil)
+	if w.Body.String() != strings.Join(parts, "") || w.Header().Get("Content-Type") != "text/plain" {
+		t.Fatalf("wrong object %q %v", w.Body.String(), w.Header().Get("Content-Type"))
+	}
+
+	// the upload is gone once completed
+	if w = serve(g, http.MethodPut, "/bucket/object?partNumber=3&"+uploadQuery, "late", nil); w.Code != http.StatusNotFound {
+		t.Fatalf("part uploaded to a completed upload: %v", w.Code)
+	}
+}
+
+func TestMultipartUploadInvalidParts(t *testing.T) {
+	g, _ := newTestGateway(t)
+	serve(g, http.MethodPut, "/bucket", "", nil)
+	w := serve(g, http.MethodPost, "/bucket/object?uploads", "", nil)
+	var initiated initiateMultipartUploadResult
+	_ = xml.Unmarshal(w.Body.Bytes(), &initiated)
+	uploadQuery := "uploadId=" + initiated.UploadId
+	w = serve(g, http.MethodPut, "/bucket/object?partNumber=1&"+uploadQuery, "part", nil)
+	etag := w.Header().Get("ETag")
+
+	wrongETag := "<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>\"00\"</ETag></Part></CompleteMultipartUpload>"
+	if w = serve(g, http.MethodPost, "/bucket/object?"+uploadQuery, wrongETag, nil); w.Code != http.StatusBadRequest {
+		t.Fatalf("completed with a wrong entity tag: %v", w.Code)
+	}
+	wrongOrder := fmt.Sprintf("<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>%s</ETag></Part>"+
+		"<Part><PartNumber>1</PartNumber><ETag>%s</ETag></Part></CompleteMultipartUpload>", etag, etag)
+	if w = serve(g, http.MethodPost, "/bucket/object?"+uploadQuery, wrongOrder, nil); w.Code != http.StatusBadRequest {
+		t.Fatalf("completed with parts out of order: %v", w.Code)
+	}
+	if w = serve(g, http.MethodDelete, "/bucket/object?"+uploadQuery, "", nil); w.Code != http.StatusNoContent {
+		t.Fatalf("failed aborting the upload: %v", w.Code)
+	}
+}
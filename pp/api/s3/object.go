@@ -0,0 +1,453 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/api"
+)
+
+const (
+	defaultMaxKeys = 1000
+
+	// the longest body of the XML requests
+	maxXMLBodySize = 1024 * 1024
+)
+
+var (
+	errInvalidRange   = errors.New("the requested range is not satisfiable")
+	errBadDigest      = errors.New("the Content-MD5 you specified did not match what we received")
+	errContentSha256  = errors.New("the provided 'x-amz-content-sha256' header does not match what was computed")
+	errIncompleteBody = errors.New("you did not provide the number of bytes specified by the Content-Length HTTP header")
+)
+
+// receiveFile writes the payload of the request to a file of the folder, named after the key. It returns the path and the MD5 of the file
+func receiveFile(req *http.Request, folder, key string) (string, []byte, uint64, error) {
+	body, size, err := payloadReader(req)
+	if err != nil {
+		return "", nil, 0, errors.Wrap(errInvalidArgument, err.Error())
+	}
+
+	filePath := filepath.Join(folder, fileNameOfKey(key))
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", nil, 0, errors.Wrap(err, "failed creating file")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	md5Hash := md5.New()
+	sha256Hash := sha256.New()
+	written, err := io.Copy(io.MultiWriter(f, md5Hash, sha256Hash), body)
+	if err != nil {
+		return "", nil, 0, errors.Wrap(err, "failed receiving the object")
+	}
+	if size >= 0 && written != size {
+		return "", nil, 0, errIncompleteBody
+	}
+
+	sum := md5Hash.Sum(nil)
+	if contentMd5 := req.Header.Get("Content-MD5"); contentMd5 != "" {
+		expected, err := base64.StdEncoding.DecodeString(contentMd5)
+		if err != nil || !bytes.Equal(expected, sum) {
+			return "", nil, 0, errBadDigest
+		}
+	}
+	contentSha := req.Header.Get("X-Amz-Content-Sha256")
+	if contentSha != "" && contentSha != unsignedPayload && !strings.HasPrefix(contentSha, streamingPayloadPrefix) &&
+		contentSha != hex.EncodeToString(sha256Hash.Sum(nil)) {
+		return "", nil, 0, errContentSha256
+	}
+	return filePath, sum, uint64(written), nil
+}
+
+// fileNameOfKey the name of the file storing the object. The files of the wallet are listed under these names
+func fileNameOfKey(key string) string {
+	name := path.Base(key)
+	if name == "." || name == "/" {
+		return "object"
+	}
+	return name
+}
+
+// fileLocks serializes the uploads and deletions of every file, so that a file is never deleted from the wallet while
+// a new key is being indexed to it
+type fileLocks struct {
+	mutex sync.Mutex
+	locks map[string]*fileLock
+}
+
+type fileLock struct {
+	sync.Mutex
+	users int
+}
+
+// lock locks the file and returns the function unlocking it
+func (l *fileLocks) lock(fileHash string) func() {
+	l.mutex.Lock()
+	lock, ok := l.locks[fileHash]
+	if !ok {
+		lock = &fileLock{}
+		l.locks[fileHash] = lock
+	}
+	lock.users++
+	l.mutex.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		l.mutex.Lock()
+		if lock.users--; lock.users == 0 {
+			delete(l.locks, fileHash)
+		}
+		l.mutex.Unlock()
+	}
+}
+
+// storeFile uploads the file of the object, unless a file with the same content is stored already, then indexes
+// the object under the key. The file of the replaced object is deleted when no other key uses it
+func (g *Gateway) storeFile(ctx context.Context, bucket, key, filePath string, size uint64, etag, contentType string) (*Object, error) {
+	object := &Object{
+		Size:         size,
+		ETag:         etag,
+		ContentType:  contentType,
+		LastModified: time.Now().UTC(),
+	}
+	// empty objects have no file
+	if size > 0 {
+		fileHash, err := crypto.CalcFileHash(filePath, "", crypto.SDS_CODEC)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed calculating the file hash")
+		}
+		object.FileHash = fileHash
+	}
+
+	replaced, err := g.indexFile(ctx, bucket, key, filePath, object)
+	if err != nil {
+		return nil, err
+	}
+	if replaced != nil {
+		g.releaseFile(ctx, replaced.FileHash)
+	}
+	return object, nil
+}
+
+// indexFile uploads the file of the object when no key uses it yet, and indexes the object, with the file locked
+func (g *Gateway) indexFile(ctx context.Context, bucket, key, filePath string, object *Object) (*Object, error) {
+	if object.FileHash != "" {
+		defer g.files.lock(object.FileHash)()
+		if !g.index.IsFileReferenced(object.FileHash) {
+			fileHash, err := g.storage.Upload(ctx, filePath)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed uploading the object")
+			}
+			object.FileHash = fileHash
+		}
+	}
+	return g.index.PutObject(bucket, key, object)
+}
+
+// releaseFile deletes the file from the wallet when no key uses it anymore
+func (g *Gateway) releaseFile(ctx context.Context, fileHash string) {
+	if fileHash == "" {
+		return
+	}
+	defer g.files.lock(fileHash)()
+	if g.index.IsFileReferenced(fileHash) {
+		return
+	}
+	if err := g.storage.Delete(ctx, fileHash); err != nil {
+		utils.ErrorLog("failed deleting the file of a replaced or deleted object", err)
+	}
+}
+
+func (g *Gateway) putObject(w http.ResponseWriter, req *http.Request, bucket, key string) {
+	if !g.index.HasBucket(bucket) {
+		writeError(w, req, errNoSuchBucket)
+		return
+	}
+	folder, err := g.newTmpFolder()
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+
+	filePath, sum, size, err := receiveFile(req, folder, key)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	object, err := g.storeFile(req.Context(), bucket, key, filePath, size, hex.EncodeToString(sum), req.Header.Get("Content-Type"))
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	w.Header().Set("ETag", strconv.Quote(object.ETag))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (g *Gateway) getObject(w http.ResponseWriter, req *http.Request, bucket, key string) {
+	object, err := g.index.GetObject(bucket, key)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	start, end := uint64(0), object.Size
+	rangeHeader := req.Header.Get("Range")
+	if rangeHeader != "" {
+		var ok bool
+		if start, end, ok = objectRange(rangeHeader, object.Size); !ok {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", object.Size))
+			writeError(w, req, errInvalidRange)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, object.Size))
+	}
+
+	contentType := object.ContentType
+	if contentType == "" {
+		contentType = "binary/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatUint(end-start, 10))
+	w.Header().Set("ETag", strconv.Quote(object.ETag))
+	w.Header().Set("Last-Modified", object.LastModified.Format(http.TimeFormat))
+	w.Header().Set("Accept-Ranges", "bytes")
+	if rangeHeader != "" {
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	if req.Method == http.MethodHead || start == end {
+		return
+	}
+
+	if err = g.storage.Download(req.Context(), object.FileHash, start, end, w); err != nil {
+		// the status has been sent already, the client sees a response shorter than its Content-Length
+		utils.ErrorLog("failed sending object "+bucket+"/"+key, err)
+	}
+}
+
+// objectRange the bytes [start, end) of an object of the given size requested by the Range header
+func objectRange(rangeHeader string, size uint64) (start, end uint64, ok bool) {
+	offset, length, err := api.ParseByteRange(rangeHeader)
+	if err != nil {
+		return 0, 0, false
+	}
+	if offset < 0 {
+		if uint64(-offset) < size {
+			start = size - uint64(-offset)
+		}
+	} else {
+		start = uint64(offset)
+	}
+	if start >= size {
+		return 0, 0, false
+	}
+	end = size
+	if length > 0 && length < end-start {
+		end = start + length
+	}
+	return start, end, true
+}
+
+func (g *Gateway) deleteObject(w http.ResponseWriter, req *http.Request, bucket, key string) {
+	object, err := g.index.DeleteObject(bucket, key)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	if object != nil {
+		g.releaseFile(req.Context(), object.FileHash)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type deleteRequest struct {
+	Quiet   bool `xml:"Quiet"`
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name       `xml:"DeleteResult"`
+	Xmlns   string         `xml:"xmlns,attr"`
+	Deleted []deletedEntry `xml:"Deleted"`
+	Errors  []deleteError  `xml:"Error"`
+}
+
+type deletedEntry struct {
+	Key string `xml:"Key"`
+}
+
+type deleteError struct {
+	Key     string `xml:"Key"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func (g *Gateway) deleteObjects(w http.ResponseWriter, req *http.Request, bucket string) {
+	var request deleteRequest
+	if err := xml.NewDecoder(io.LimitReader(req.Body, maxXMLBodySize)).Decode(&request); err != nil {
+		writeError(w, req, errMalformedXML)
+		return
+	}
+
+	result := deleteResult{Xmlns: s3Namespace}
+	for _, o := range request.Objects {
+		object, err := g.index.DeleteObject(bucket, o.Key)
+		if err != nil {
+			code := "InternalError"
+			if e, ok := apiErrors[errors.Cause(err)]; ok {
+				code = e.code
+			}
+			result.Errors = append(result.Errors, deleteError{Key: o.Key, Code: code, Message: err.Error()})
+			continue
+		}
+		if object != nil {
+			g.releaseFile(req.Context(), object.FileHash)
+		}
+		if !request.Quiet {
+			result.Deleted = append(result.Deleted, deletedEntry{Key: o.Key})
+		}
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name      `xml:"ListBucketResult"`
+	Xmlns                 string        `xml:"xmlns,attr"`
+	Name                  string        `xml:"Name"`
+	Prefix                string        `xml:"Prefix"`
+	Delimiter             string        `xml:"Delimiter,omitempty"`
+	EncodingType          string        `xml:"EncodingType,omitempty"`
+	MaxKeys               int           `xml:"MaxKeys"`
+	IsTruncated           bool          `xml:"IsTruncated"`
+	Marker                *string       `xml:"Marker,omitempty"`
+	NextMarker            string        `xml:"NextMarker,omitempty"`
+	KeyCount              *int          `xml:"KeyCount,omitempty"`
+	ContinuationToken     string        `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string        `xml:"NextContinuationToken,omitempty"`
+	StartAfter            string        `xml:"StartAfter,omitempty"`
+	Contents              []objectEntry `xml:"Contents"`
+	CommonPrefixes        []prefixEntry `xml:"CommonPrefixes"`
+}
+
+type objectEntry struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         uint64 `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type prefixEntry struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// listObjects serves ListObjectsV2, and ListObjects when list-type isn't 2
+func (g *Gateway) listObjects(w http.ResponseWriter, req *http.Request, bucket string, query url.Values) {
+	maxKeys := defaultMaxKeys
+	if value := query.Get("max-keys"); value != "" {
+		var err error
+		if maxKeys, err = strconv.Atoi(value); err != nil || maxKeys < 0 {
+			writeError(w, req, errInvalidArgument)
+			return
+		}
+		if maxKeys > defaultMaxKeys {
+			maxKeys = defaultMaxKeys
+		}
+	}
+	encode := func(s string) string {
+		if query.Get("encoding-type") == "url" {
+			return url.QueryEscape(s)
+		}
+		return s
+	}
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	v2 := query.Get("list-type") == "2"
+
+	startAfter := query.Get("marker")
+	if v2 {
+		startAfter = query.Get("start-after")
+		if token := query.Get("continuation-token"); token != "" {
+			decoded, err := base64.RawURLEncoding.DecodeString(token)
+			if err != nil {
+				writeError(w, req, errors.Wrap(errInvalidArgument, "invalid continuation token"))
+				return
+			}
+			startAfter = string(decoded)
+		}
+	}
+
+	objects, commonPrefixes, truncated, marker, err := g.index.ListObjects(bucket, prefix, delimiter, startAfter, maxKeys)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	result := listBucketResult{
+		Xmlns:        s3Namespace,
+		Name:         bucket,
+		Prefix:       encode(prefix),
+		Delimiter:    encode(delimiter),
+		EncodingType: query.Get("encoding-type"),
+		MaxKeys:      maxKeys,
+		IsTruncated:  truncated,
+	}
+	if v2 {
+		keyCount := len(objects) + len(commonPrefixes)
+		result.KeyCount = &keyCount
+		result.ContinuationToken = query.Get("continuation-token")
+		result.StartAfter = encode(query.Get("start-after"))
+		if truncated {
+			result.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(marker))
+		}
+	} else {
+		listMarker := encode(query.Get("marker"))
+		result.Marker = &listMarker
+		if truncated {
+			result.NextMarker = encode(marker)
+		}
+	}
+	for _, object := range objects {
+		result.Contents = append(result.Contents, objectEntry{
+			Key:          encode(object.Key),
+			LastModified: formatTime(object.LastModified),
+			ETag:         strconv.Quote(object.ETag),
+			Size:         object.Size,
+			StorageClass: "STANDARD",
+		})
+	}
+	for _, commonPrefix := range commonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, prefixEntry{Prefix: encode(commonPrefix)})
+	}
+	writeXML(w, http.StatusOK, result)
+}
@@ -0,0 +1,107 @@
+package s3
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	msgutils "github.com/stratosnet/sds/sds-msg/utils"
+
+	rpc_api "github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/pp/event"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/namespace"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	// the storage tier requested for the files of the objects
+	objectDesiredTier = 2
+
+	// deleteFileTimeout the longest wait for SP to confirm the deletion of a file
+	deleteFileTimeout = 10 * time.Second
+)
+
+// Storage keeps the content of the objects in files of the node wallet
+type Storage interface {
+	// Upload uploads the local file and returns its file hash once its slices have been sent to the destinations
+	Upload(ctx context.Context, path string) (string, error)
+	// Download writes the bytes [start, end) of the file to w
+	Download(ctx context.Context, fileHash string, start, end uint64, w io.Writer) error
+	// Delete deletes the file from the wallet
+	Delete(ctx context.Context, fileHash string) error
+}
+
+// sdsStorage stores the objects in the SDS network, signing the requests with the wallet of the node
+type sdsStorage struct{}
+
+func (sdsStorage) Upload(ctx context.Context, path string) (string, error) {
+	fileHash, _, err := event.UploadFileAndWait(ctx, path, false, false, objectDesiredTier, true,
+		setting.WalletAddress, setting.WalletPublicKey.Bytes())
+	return fileHash, err
+}
+
+func (sdsStorage) Download(ctx context.Context, fileHash string, start, end uint64, w io.Writer) error {
+	signature, reqTime, err := signDownload(ctx, fileHash)
+	if err != nil {
+		return err
+	}
+	sdmPath := fwtypes.DataMeshId{Owner: setting.WalletAddress, Hash: fileHash}.String()
+	r, res := namespace.OpenRangeDownload(ctx, sdmPath, *signature, reqTime)
+	if r == nil {
+		return errors.Errorf("failed getting the storage info of file %v, %v %v", fileHash, res.Return, res.Detail)
+	}
+	defer r.Close(ctx)
+	return r.Read(ctx, start, end, func(data []byte) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+func (sdsStorage) Delete(ctx context.Context, fileHash string) error {
+	reqTime := time.Now().Unix()
+	wsign, err := setting.WalletPrivateKey.Sign([]byte(msgutils.DeleteFileWalletSignMessage(fileHash, setting.WalletAddress, reqTime)))
+	if err != nil {
+		return errors.Wrap(err, "wallet failed to sign message")
+	}
+
+	result := file.SubscribeFileDeleteResult(fileHash)
+	defer file.UnsubscribeFileDeleteResult(fileHash)
+	event.DeleteFile(ctx, fileHash, setting.WalletAddress, setting.WalletPublicKey.Bytes(), wsign, reqTime)
+
+	select {
+	case <-time.After(deleteFileTimeout):
+		return errors.New("timed out waiting for the deletion of file " + fileHash)
+	case res := <-result:
+		if res == nil || res.Return != rpc_api.SUCCESS {
+			return errors.New("failed deleting file " + fileHash)
+		}
+		return nil
+	}
+}
+
+// signDownload signs the download of the file with the wallet of the node
+func signDownload(ctx context.Context, fileHash string) (*rpc_api.Signature, int64, error) {
+	ozone := namespace.RpcPubApi().RequestGetOzone(ctx, rpc_api.ParamReqGetOzone{WalletAddr: setting.WalletAddress})
+	if ozone.Return != rpc_api.SUCCESS {
+		return nil, 0, errors.New("failed getting the sequence number of the wallet")
+	}
+	reqTime := time.Now().Unix()
+	sign, err := setting.WalletPrivateKey.Sign([]byte(msgutils.GetFileDownloadWalletSignMessage(fileHash, setting.WalletAddress, ozone.SequenceNumber, reqTime)))
+	if err != nil {
+		return nil, 0, err
+	}
+	walletPublicKey, err := fwtypes.WalletPubKeyToBech32(setting.WalletPublicKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &rpc_api.Signature{
+		Address:   setting.WalletAddress,
+		Pubkey:    walletPublicKey,
+		Signature: hex.EncodeToString(sign),
+	}, reqTime, nil
+}
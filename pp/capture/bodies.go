@@ -0,0 +1,113 @@
+package capture
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+// bodyTypes the protobuf message in the body of each message type, as decoded by the event handlers
+var bodyTypes = map[uint8]func() proto.Message{
+	header.ReqGetSPList.Id:               func() proto.Message { return &protos.ReqGetSPList{} },
+	header.RspGetSPList.Id:               func() proto.Message { return &protos.RspGetSPList{} },
+	header.ReqGetPPStatus.Id:             func() proto.Message { return &protos.ReqGetPPStatus{} },
+	header.RspGetPPStatus.Id:             func() proto.Message { return &protos.RspGetPPStatus{} },
+	header.ReqGetPPDowngradeInfo.Id:      func() proto.Message { return &protos.ReqGetPPDowngradeInfo{} },
+	header.RspGetPPDowngradeInfo.Id:      func() proto.Message { return &protos.RspGetPPDowngradeInfo{} },
+	header.ReqGetWalletOz.Id:             func() proto.Message { return &protos.ReqGetWalletOz{} },
+	header.RspGetWalletOz.Id:             func() proto.Message { return &protos.RspGetWalletOz{} },
+	header.ReqRegister.Id:                func() proto.Message { return &protos.ReqRegister{} },
+	header.RspRegister.Id:                func() proto.Message { return &protos.RspRegister{} },
+	header.ReqActivatePP.Id:              func() proto.Message { return &protos.ReqActivatePP{} },
+	header.RspActivatePP.Id:              func() proto.Message { return &protos.RspActivatePP{} },
+	header.NoticeActivatedPP.Id:          func() proto.Message { return &protos.RspActivatePP{} },
+	header.ReqUpdateDepositPP.Id:         func() proto.Message { return &protos.ReqUpdateDepositPP{} },
+	header.RspUpdateDepositPP.Id:         func() proto.Message { return &protos.RspUpdateDepositPP{} },
+	header.NoticeUpdatedDepositPP.Id:     func() proto.Message { return &protos.NoticeUpdatedDepositPP{} },
+	header.ReqStateChangePP.Id:           func() proto.Message { return &protos.ReqStateChangePP{} },
+	header.RspStateChangePP.Id:           func() proto.Message { return &protos.RspStateChangePP{} },
+	header.ReqDeactivatePP.Id:            func() proto.Message { return &protos.ReqDeactivatePP{} },
+	header.RspDeactivatePP.Id:            func() proto.Message { return &protos.RspDeactivatePP{} },
+	header.NoticeUnbondingPP.Id:          func() proto.Message { return &protos.NoticeUnbondingPP{} },
+	header.NoticeDeactivatedPP.Id:        func() proto.Message { return &protos.NoticeDeactivatedPP{} },
+	header.ReqPrepay.Id:                  func() proto.Message { return &protos.ReqPrepay{} },
+	header.RspPrepay.Id:                  func() proto.Message { return &protos.RspPrepay{} },
+	header.ReqPrepaid.Id:                 func() proto.Message { return &protos.ReqPrepaid{} },
+	header.ReqMining.Id:                  func() proto.Message { return &protos.ReqMining{} },
+	header.RspMining.Id:                  func() proto.Message { return &protos.RspMining{} },
+	header.NoticeRelocateSp.Id:           func() proto.Message { return &protos.NoticeRelocateSp{} },
+	header.ReqStartMaintenance.Id:        func() proto.Message { return &protos.ReqStartMaintenance{} },
+	header.RspStartMaintenance.Id:        func() proto.Message { return &protos.RspStartMaintenance{} },
+	header.ReqStopMaintenance.Id:         func() proto.Message { return &protos.ReqStopMaintenance{} },
+	header.RspStopMaintenance.Id:         func() proto.Message { return &protos.RspStopMaintenance{} },
+	header.ReqUploadFile.Id:              func() proto.Message { return &protos.ReqUploadFile{} },
+	header.RspUploadFile.Id:              func() proto.Message { return &protos.RspUploadFile{} },
+	header.ReqUploadFileSlice.Id:         func() proto.Message { return &protos.ReqUploadFileSlice{} },
+	header.RspUploadFileSlice.Id:         func() proto.Message { return &protos.RspUploadFileSlice{} },
+	header.ReqBackupFileSlice.Id:         func() proto.Message { return &protos.ReqBackupFileSlice{} },
+	header.RspBackupFileSlice.Id:         func() proto.Message { return &protos.RspBackupFileSlice{} },
+	header.ReqUploadSlicesWrong.Id:       func() proto.Message { return &protos.ReqUploadSlicesWrong{} },
+	header.RspUploadSlicesWrong.Id:       func() proto.Message { return &protos.RspUploadSlicesWrong{} },
+	header.ReqReportUploadSliceResult.Id: func() proto.Message { return &protos.ReportUploadSliceResult{} },
+	header.RspReportUploadSliceResult.Id: func() proto.Message { return &protos.RspReportUploadSliceResult{} },
+	header.UploadSpeedOfProgress.Id:      func() proto.Message { return &protos.UploadSpeedOfProgress{} },
+	header.ReqFindMyFileList.Id:          func() proto.Message { return &protos.ReqFindMyFileList{} },
+	header.RspFindMyFileList.Id:          func() proto.Message { return &protos.RspFindMyFileList{} },
+	header.ReqDeleteFile.Id:              func() proto.Message { return &protos.ReqDeleteFile{} },
+	header.RspDeleteFile.Id:              func() proto.Message { return &protos.RspDeleteFile{} },
+	header.ReqGetHDInfo.Id:               func() proto.Message { return &protos.ReqGetHDInfo{} },
+	header.RspGetHDInfo.Id:               func() proto.Message { return &protos.RspGetHDInfo{} },
+	header.ReqFileStorageInfo.Id:         func() proto.Message { return &protos.ReqFileStorageInfo{} },
+	header.RspFileStorageInfo.Id:         func() proto.Message { return &protos.RspFileStorageInfo{} },
+	header.ReqDownloadSlice.Id:           func() proto.Message { return &protos.ReqDownloadSlice{} },
+	header.RspDownloadSlice.Id:           func() proto.Message { return &protos.RspDownloadSlice{} },
+	header.ReqReportDownloadResult.Id:    func() proto.Message { return &protos.ReqReportDownloadResult{} },
+	header.RspReportDownloadResult.Id:    func() proto.Message { return &protos.RspReportDownloadResult{} },
+	header.ReqDownloadTaskInfo.Id:        func() proto.Message { return &protos.ReqDownloadTaskInfo{} },
+	header.RspDownloadTaskInfo.Id:        func() proto.Message { return &protos.RspDownloadTaskInfo{} },
+	header.ReqDownloadFileWrong.Id:       func() proto.Message { return &protos.ReqDownloadFileWrong{} },
+	header.RspDownloadFileWrong.Id:       func() proto.Message { return &protos.RspFileStorageInfo{} },
+	header.ReqClearDownloadTask.Id:       func() proto.Message { return &protos.ReqClearDownloadTask{} },
+	header.ReqRegisterNewPP.Id:           func() proto.Message { return &protos.ReqRegisterNewPP{} },
+	header.RspRegisterNewPP.Id:           func() proto.Message { return &protos.RspRegisterNewPP{} },
+	header.NoticeFileSliceBackup.Id:      func() proto.Message { return &protos.NoticeFileSliceBackup{} },
+	header.ReqTransferDownload.Id:        func() proto.Message { return &protos.ReqTransferDownload{} },
+	header.RspTransferDownload.Id:        func() proto.Message { return &protos.RspTransferDownload{} },
+	header.ReqTransferDownloadWrong.Id:   func() proto.Message { return &protos.ReqTransferDownloadWrong{} },
+	header.NoticeFileSliceVerify.Id:      func() proto.Message { return &protos.NoticeFileSliceVerify{} },
+	header.ReqVerifyDownload.Id:          func() proto.Message { return &protos.ReqVerifyDownload{} },
+	header.RspVerifyDownload.Id:          func() proto.Message { return &protos.RspVerifyDownload{} },
+	header.ReqReportVerifyResult.Id:      func() proto.Message { return &protos.ReqReportVerifyResult{} },
+	header.RspReportVerifyResult.Id:      func() proto.Message { return &protos.RspVerifyDownloadResult{} },
+	header.RspVerifyDownloadResult.Id:    func() proto.Message { return &protos.RspVerifyDownloadResult{} },
+	header.ReqReportLostSlices.Id:        func() proto.Message { return &protos.ReqReportLostSlices{} },
+	header.RspReportLostSlices.Id:        func() proto.Message { return &protos.RspReportLostSlices{} },
+	header.ReqSliceIndex.Id:              func() proto.Message { return &protos.ReqSliceIndex{} },
+	header.RspSliceIndex.Id:              func() proto.Message { return &protos.RspSliceIndex{} },
+	header.RspTransferDownloadResult.Id:  func() proto.Message { return &protos.RspTransferDownloadResult{} },
+	header.ReqReportBackupSliceResult.Id: func() proto.Message { return &protos.ReqReportBackupSliceResult{} },
+	header.RspReportBackupSliceResult.Id: func() proto.Message { return &protos.RspReportBackupSliceResult{} },
+	header.ReqFileBackupStatus.Id:        func() proto.Message { return &protos.ReqBackupStatus{} },
+	header.RspFileBackupStatus.Id:        func() proto.Message { return &protos.RspBackupStatus{} },
+	header.ReqFileReplicaInfo.Id:         func() proto.Message { return &protos.ReqFileReplicaInfo{} },
+	header.RspFileReplicaInfo.Id:         func() proto.Message { return &protos.RspFileReplicaInfo{} },
+	header.ReqFileStatus.Id:              func() proto.Message { return &protos.ReqFileStatus{} },
+	header.RspFileStatus.Id:              func() proto.Message { return &protos.RspFileStatus{} },
+	header.ReqShareLink.Id:               func() proto.Message { return &protos.ReqShareLink{} },
+	header.RspShareLink.Id:               func() proto.Message { return &protos.RspShareLink{} },
+	header.ReqShareFile.Id:               func() proto.Message { return &protos.ReqShareFile{} },
+	header.RspShareFile.Id:               func() proto.Message { return &protos.RspShareFile{} },
+	header.ReqDeleteShare.Id:             func() proto.Message { return &protos.ReqDeleteShare{} },
+	header.RspDeleteShare.Id:             func() proto.Message { return &protos.RspDeleteShare{} },
+	header.ReqGetShareFile.Id:            func() proto.Message { return &protos.ReqGetShareFile{} },
+	header.RspGetShareFile.Id:            func() proto.Message { return &protos.RspFileStorageInfo{} },
+	header.ReqSpLatencyCheck.Id:          func() proto.Message { return &protos.ReqSpLatencyCheck{} },
+	header.RspSpLatencyCheck.Id:          func() proto.Message { return &protos.RspSpLatencyCheck{} },
+	header.ReqReportNodeStatus.Id:        func() proto.Message { return &protos.ReqReportNodeStatus{} },
+	header.RspReportNodeStatus.Id:        func() proto.Message { return &protos.RspReportNodeStatus{} },
+	header.RspBadVersion.Id:              func() proto.Message { return &protos.RspBadVersion{} },
+	header.NoticeSpUnderMaintenance.Id:   func() proto.Message { return &protos.NoticeSpUnderMaintenance{} },
+	header.ReqClearExpiredShareLinks.Id:  func() proto.Message { return &protos.ReqClearExpiredShareLinks{} },
+	header.RspClearExpiredShareLinks.Id:  func() proto.Message { return &protos.RspClearExpiredShareLinks{} },
+}
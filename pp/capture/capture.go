@@ -0,0 +1,216 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
+
+// Record a message received or sent by the node. Body is the encoded protobuf message replayed, Message the same
+// message decoded for the readers of the capture
+type Record struct {
+	Time       time.Time          `json:"time"`
+	Direction  string             `json:"direction"`
+	Name       string             `json:"name"`
+	Head       header.MessageHead `json:"head"`
+	P2pAddress string             `json:"p2p_address"`
+	P2pPubKey  []byte             `json:"p2p_pub_key,omitempty"`
+	Signature  []byte             `json:"signature,omitempty"`
+	Message    json.RawMessage    `json:"message,omitempty"`
+	Body       []byte             `json:"body"`
+	DataLen    int                `json:"data_len"`
+	Data       []byte             `json:"data,omitempty"`
+}
+
+// NewRecord copies the message into a record. The slice data is only copied when withData is true
+func NewRecord(direction string, m *msg.RelayMsgBuf, withData bool) *Record {
+	r := &Record{
+		Time:       time.Now(),
+		Direction:  direction,
+		Head:       m.MSGHead,
+		P2pAddress: m.MSGSign.P2pAddress,
+		P2pPubKey:  m.MSGSign.P2pPubKey,
+		Signature:  m.MSGSign.Signature,
+		Body:       append([]byte(nil), m.MSGBody...),
+		DataLen:    len(m.MSGData),
+	}
+	if msgType := header.GetMsgTypeFromId(m.MSGHead.Cmd); msgType != nil {
+		r.Name = msgType.Name
+	}
+	if withData && len(m.MSGData) > 0 {
+		r.Data = append([]byte(nil), m.MSGData...)
+	}
+	r.Message = DecodeBody(m.MSGHead.Cmd, m.MSGBody)
+	return r
+}
+
+// DecodeBody returns the body of the message type as json, or nil when the body can't be decoded
+func DecodeBody(cmd uint8, body []byte) json.RawMessage {
+	newBody, ok := bodyTypes[cmd]
+	if !ok {
+		return nil
+	}
+	message := newBody()
+	if err := proto.Unmarshal(body, message); err != nil {
+		return nil
+	}
+	decoded, err := protojson.Marshal(message)
+	if err != nil {
+		return nil
+	}
+	return decoded
+}
+
+// RelayMsgBuf rebuilds the message of the record
+func (r *Record) RelayMsgBuf() *msg.RelayMsgBuf {
+	m := &msg.RelayMsgBuf{
+		MSGHead: r.Head,
+		MSGSign: msg.MessageSign{
+			Signature:  r.Signature,
+			P2pAddress: r.P2pAddress,
+			P2pPubKey:  r.P2pPubKey,
+		},
+		MSGBody: r.Body,
+	}
+	if len(r.Data) > 0 {
+		m.MSGData = utils.RequestBuffer()[:len(r.Data)]
+		copy(m.MSGData, r.Data)
+	}
+	return m
+}
+
+// Writer writes the records to a file, one json object per line. Once the file reaches maxSize, it is renamed with the
+// suffix .1, the previous .1 becomes .2 and so on, keeping maxFiles old files
+type Writer struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	withData bool
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+func NewWriter(path string, maxSize int64, maxFiles int, withData bool) (*Writer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Wrap(err, "failed creating the capture folder")
+	}
+	w := &Writer{path: path, maxSize: maxSize, maxFiles: maxFiles, withData: withData}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *Writer) open() error {
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening the capture file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	w.file, w.size = file, info.Size()
+	return nil
+}
+
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	for i := w.maxFiles - 1; i > 0; i-- {
+		_ = os.Rename(fmt.Sprintf("%v.%d", w.path, i), fmt.Sprintf("%v.%d", w.path, i+1))
+	}
+	if w.maxFiles > 0 {
+		if err := os.Rename(w.path, w.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(w.path); err != nil {
+		return err
+	}
+	return w.open()
+}
+
+// Capture records a message received (DirectionIn) or sent (DirectionOut) by the node
+func (w *Writer) Capture(direction string, m *msg.RelayMsgBuf) {
+	line, err := json.Marshal(NewRecord(direction, m, w.withData))
+	if err != nil {
+		utils.ErrorLog("failed encoding a captured message", err)
+		return
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil {
+		return
+	}
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(line)) > w.maxSize {
+		if err = w.rotate(); err != nil {
+			utils.ErrorLog("failed rotating the capture file, capture stopped", err)
+			w.file = nil
+			return
+		}
+	}
+	n, err := w.file.Write(line)
+	w.size += int64(n)
+	if err != nil {
+		utils.ErrorLog("failed writing the capture file", err)
+	}
+}
+
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// Read calls fn with the records of the capture file in order, until fn returns an error
+func Read(path string, fn func(*Record) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	for {
+		record := &Record{}
+		if err = decoder.Decode(record); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "invalid capture file")
+		}
+		if err = fn(record); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package capture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestCaptureReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "messages.capture")
+	writer, err := NewWriter(path, 1024, 2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := proto.Marshal(&protos.ReqClearDownloadTask{WalletAddress: "wallet", FileHash: "hash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		writer.Capture(DirectionIn, &msg.RelayMsgBuf{
+			MSGHead: header.MessageHead{Cmd: header.ReqClearDownloadTask.Id, ReqId: int64(i)},
+			MSGSign: msg.MessageSign{P2pAddress: "peer"},
+			MSGBody: body,
+		})
+		writer.Capture(DirectionOut, &msg.RelayMsgBuf{MSGHead: header.MessageHead{Cmd: header.RspGetPPStatus.Id}})
+	}
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(path + ".2"); err != nil {
+		t.Fatal("the capture should have been rotated twice", err)
+	}
+	if _, err = os.Stat(path + ".3"); err == nil {
+		t.Fatal("only 2 rotated files should be kept")
+	}
+
+	var replayed []int64
+	core.Register(header.ReqClearDownloadTask, func(ctx context.Context, _ core.WriteCloser) {
+		var target protos.ReqClearDownloadTask
+		message := core.MessageFromContext(ctx)
+		if err := proto.Unmarshal(message.MSGBody, &target); err != nil || target.FileHash != "hash" {
+			t.Errorf("replayed the body [%v] (err %v)", target.String(), err)
+		}
+		if core.GetSrcP2pAddrFromContext(ctx) != "peer" {
+			t.Error("the replayed message should come from the peer")
+		}
+		replayed = append(replayed, message.MSGHead.ReqId)
+	})
+	handled, err := Replay(context.Background(), path, &ReplayConn{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handled != len(replayed) || handled == 0 || replayed[handled-1] != 19 {
+		t.Fatalf("replayed %v messages: %v", handled, replayed)
+	}
+}
@@ -0,0 +1,51 @@
+package capture
+
+import (
+	"context"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+// Replay hands the messages received in the capture over to the registered handlers, one after the other, as if they
+// were received from conn. It returns the number of messages handled
+func Replay(ctx context.Context, path string, conn core.WriteCloser) (int, error) {
+	handled := 0
+	err := Read(path, func(record *Record) error {
+		if record.Direction != DirectionIn {
+			return nil
+		}
+		handler := core.GetHandlerFunc(record.Head.Cmd)
+		if handler == nil {
+			utils.Logf("skipping [%v] (reqId %v): no handler", record.Name, record.Head.ReqId)
+			return nil
+		}
+		utils.Logf("replaying [%v] (reqId %v) received at %v from %v", record.Name, record.Head.ReqId,
+			record.Time.Format("2006-01-02 15:04:05.000"), record.P2pAddress)
+
+		message := record.RelayMsgBuf()
+		handlerCtx := core.CreateContextWithReqId(ctx, message.MSGHead.ReqId)
+		handlerCtx = core.CreateContextWithRecvStartTime(handlerCtx, record.Time.UnixMilli())
+		handlerCtx = core.CreateContextWithMessage(handlerCtx, message)
+		handlerCtx = core.CreateContextWithSrcP2pAddr(handlerCtx, record.P2pAddress)
+		handler(handlerCtx, conn)
+		handled++
+		return nil
+	})
+	return handled, err
+}
+
+// ReplayConn stands for the connection of the replayed messages. The handlers reply to it
+type ReplayConn struct {
+	OnWrite func(*msg.RelayMsgBuf)
+}
+
+func (c *ReplayConn) Write(m *msg.RelayMsgBuf, _ context.Context) error {
+	if c.OnWrite != nil {
+		c.OnWrite(m)
+	}
+	return nil
+}
+
+func (c *ReplayConn) Close() {}
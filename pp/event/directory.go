@@ -0,0 +1,352 @@
+package event
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/task"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	// DIRECTORY_MAX_CONCURRENT_TRANSFERS the number of files of a directory uploaded or downloaded at the same time
+	DIRECTORY_MAX_CONCURRENT_TRANSFERS = 4
+
+	// DIRECTORY_TRANSFER_TIMEOUT the longest wait for the upload or the download of a single file of a directory
+	DIRECTORY_TRANSFER_TIMEOUT = 2 * time.Hour
+)
+
+// directoryUpload the upload of one file content, shared by the files of the directory having the same content
+type directoryUpload struct {
+	done     chan struct{}
+	fileSize uint64
+	err      error
+}
+
+// createAndRegisterFileReqId gives each file of a directory its own reqId, logging to the same terminal as the command
+func createAndRegisterFileReqId(ctx context.Context, remoteReqId string) context.Context {
+	newReqId, _ := utils.NextSnowFlakeId()
+	utils.RegisterReqToParentReq(newReqId, core.GetReqIdFromContext(ctx))
+	if remoteReqId != "" {
+		core.StoreRemoteReqId(newReqId, remoteReqId)
+	}
+	return core.CreateContextWithReqId(ctx, newReqId)
+}
+
+func waitTransferResult(result chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(DIRECTORY_TRANSFER_TIMEOUT):
+		return errors.New("timed out")
+	}
+}
+
+// RequestUploadDirectory uploads every file of the directory tree, then a manifest which maps the relative path of each
+// file to its file hash. The file hash of the manifest identifies the directory
+func RequestUploadDirectory(ctx context.Context, dirPath string, isEncrypted bool, sliceOptions SliceOptions, desiredTier uint32,
+	allowHigherTier bool, walletAddr string, walletPubkey []byte) {
+	if !setting.CheckLogin() {
+		return
+	}
+
+	dirPath = filepath.Clean(dirPath)
+	var paths, dirs []string
+	err := filepath.WalkDir(dirPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// links and special files are skipped
+		if d.Type().IsRegular() {
+			paths = append(paths, path)
+		} else if d.IsDir() && path != dirPath {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	if err != nil {
+		pp.ErrorLog(ctx, "failed reading the directory, ", err)
+		return
+	}
+	// folders holding a file are implied by its path, only the others are recorded
+	filled := make(map[string]bool)
+	for _, path := range paths {
+		for dir := filepath.Dir(path); dir != dirPath && !filled[dir]; dir = filepath.Dir(dir) {
+			filled[dir] = true
+		}
+	}
+	var emptyDirs []string
+	for _, dir := range dirs {
+		if !filled[dir] {
+			emptyDirs = append(emptyDirs, dir)
+		}
+	}
+	if len(paths)+len(emptyDirs) == 0 {
+		pp.ErrorLog(ctx, "no file to upload in the directory ", dirPath)
+		return
+	}
+	pp.Logf(ctx, "uploading %d files of the directory %v", len(paths), dirPath)
+
+	manifest := &file.DirectoryManifest{
+		Version: file.DIRECTORY_MANIFEST_VERSION,
+		Name:    filepath.Base(dirPath),
+	}
+	for _, dir := range emptyDirs {
+		relPath, err := filepath.Rel(dirPath, dir)
+		if err != nil {
+			pp.ErrorLog(ctx, "failed resolving the relative path of ", dir)
+			return
+		}
+		manifest.Files = append(manifest.Files, &file.DirectoryManifestEntry{Path: filepath.ToSlash(relPath), IsDir: true})
+	}
+	uploads := make(map[string]*directoryUpload)
+	failed := 0
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, DIRECTORY_MAX_CONCURRENT_TRANSFERS)
+	for _, path := range paths {
+		relPath, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			pp.ErrorLog(ctx, "failed resolving the relative path of ", path)
+			return
+		}
+		entry := &file.DirectoryManifestEntry{Path: filepath.ToSlash(relPath)}
+		manifest.Files = append(manifest.Files, entry)
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(path string, entry *file.DirectoryManifestEntry) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := uploadDirectoryFile(createAndRegisterFileReqId(ctx, ""), path, entry, uploads, &mutex, isEncrypted,
+				sliceOptions, desiredTier, allowHigherTier, walletAddr, walletPubkey)
+			if err != nil {
+				pp.ErrorLogf(ctx, "failed uploading %v: %v", path, err)
+				mutex.Lock()
+				failed++
+				mutex.Unlock()
+			}
+		}(path, entry)
+	}
+	wg.Wait()
+	if failed > 0 {
+		pp.ErrorLogf(ctx, "%d files of the directory failed to upload, the manifest is not uploaded. Run 'put -r' again to retry", failed)
+		return
+	}
+
+	uploadId, _ := utils.NextSnowFlakeId()
+	manifestPath, err := file.SaveDirectoryManifest(manifest, strconv.FormatInt(uploadId, 10))
+	if err != nil {
+		pp.ErrorLog(ctx, err)
+		return
+	}
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(manifestPath))
+	}()
+	manifestHash, _, err := UploadFileAndWait(createAndRegisterFileReqId(ctx, ""), manifestPath, true, isEncrypted,
+		desiredTier, allowHigherTier, walletAddr, walletPubkey)
+	if err != nil {
+		pp.ErrorLog(ctx, "failed uploading the manifest of the directory, ", err)
+		return
+	}
+
+	pp.Log(ctx, "******************************************************")
+	pp.Log(ctx, "* Directory ", dirPath)
+	pp.Logf(ctx, "* has been uploaded, %d files and %d empty folders", len(paths), len(emptyDirs))
+	pp.Log(ctx, "* Download it with:")
+	pp.Logf(ctx, "* get -r %v <destination>", fwtypes.DataMeshId{Owner: walletAddr, Hash: manifestHash}.String())
+	pp.Log(ctx, "******************************************************")
+}
+
+// uploadDirectoryFile uploads one file of a directory and fills its manifest entry. Files with the same content are
+// uploaded once
+func uploadDirectoryFile(ctx context.Context, path string, entry *file.DirectoryManifestEntry, uploads map[string]*directoryUpload,
+	mutex *sync.Mutex, isEncrypted bool, sliceOptions SliceOptions, desiredTier uint32, allowHigherTier bool, walletAddr string,
+	walletPubkey []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	// empty files have no slice to store, they are recreated from the manifest only
+	if info.Size() == 0 {
+		return nil
+	}
+
+	p, err := prepareUploadFile(ctx, path, isEncrypted, false, sliceOptions, desiredTier, allowHigherTier,
+		walletAddr, walletPubkey, nil)
+	if err != nil {
+		return err
+	}
+	fileHash := p.FileInfo.FileHash
+
+	mutex.Lock()
+	upload, ok := uploads[fileHash]
+	if !ok {
+		upload = &directoryUpload{done: make(chan struct{}), fileSize: p.FileInfo.FileSize}
+		uploads[fileHash] = upload
+	}
+	mutex.Unlock()
+
+	if ok {
+		<-upload.done
+	} else {
+		upload.err = sendUploadAndWait(ctx, p)
+		close(upload.done)
+	}
+	if upload.err != nil {
+		return upload.err
+	}
+
+	entry.FileHash = fileHash
+	entry.FileSize = upload.fileSize
+	return nil
+}
+
+// UploadFileAndWait uploads a single file and waits until its slices have been sent to the destinations
+func UploadFileAndWait(ctx context.Context, path string, isDirectory, isEncrypted bool, desiredTier uint32, allowHigherTier bool,
+	walletAddr string, walletPubkey []byte) (string, uint64, error) {
+	p, err := prepareUploadFile(ctx, path, isEncrypted, false, SliceOptions{}, desiredTier, allowHigherTier, walletAddr,
+		walletPubkey, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	p.FileInfo.IsDirectory = isDirectory
+	if err = sendUploadAndWait(ctx, p); err != nil {
+		return "", 0, err
+	}
+	return p.FileInfo.FileHash, p.FileInfo.FileSize, nil
+}
+
+func sendUploadAndWait(ctx context.Context, p *protos.ReqUploadFile) error {
+	reqId := strconv.FormatInt(core.GetReqIdFromContext(ctx), 10)
+	result := task.SubscribeUploadResult(p.FileInfo.FileHash, reqId)
+	defer task.UnsubscribeUploadResult(p.FileInfo.FileHash, reqId)
+	if err := ReqGetWalletOzForUpload(ctx, setting.WalletAddress, task.LOCAL_REQID, p); err != nil {
+		return err
+	}
+	return waitTransferResult(result)
+}
+
+// DownloadDirectory downloads the manifest of a directory uploaded by "put -r", then every file it lists into dest
+func DownloadDirectory(ctx context.Context, path, dest string) {
+	if !setting.CheckLogin() {
+		return
+	}
+	_, ownerWalletAddress, manifestHash, _, err := fwtypes.ParseFileHandle(path)
+	if err != nil {
+		pp.ErrorLog(ctx, "please input correct download link, eg: sdm://address/fileHash")
+		return
+	}
+	if ownerWalletAddress != setting.WalletAddress {
+		pp.ErrorLog(ctx, "only the file owner is allowed to download via sdm url")
+		return
+	}
+	if !filepath.IsAbs(dest) {
+		dest = filepath.Join(setting.Config.Home.DownloadPath, dest)
+	}
+
+	if err = downloadFileAndWait(ctx, ownerWalletAddress, manifestHash); err != nil {
+		pp.ErrorLog(ctx, "failed downloading the manifest of the directory, ", err)
+		return
+	}
+	manifestPath := file.GetDownloadFilePath(manifestHash, "")
+	manifest, err := file.ReadDirectoryManifest(manifestPath)
+	_ = os.Remove(manifestPath)
+	if err != nil {
+		pp.ErrorLog(ctx, err)
+		return
+	}
+	pp.Logf(ctx, "downloading %d files of the directory %v into %v", len(manifest.Files), manifest.Name, dest)
+
+	// files with the same content are downloaded once, then copied to each of their paths
+	targets := make(map[string][]string)
+	for _, entry := range manifest.Files {
+		targetPath := filepath.Join(dest, filepath.FromSlash(entry.Path))
+		if entry.IsDir {
+			if err = os.MkdirAll(targetPath, os.ModePerm); err != nil {
+				pp.ErrorLogf(ctx, "failed creating %v: %v", targetPath, err)
+			}
+			continue
+		}
+		if entry.FileHash == "" {
+			if err = os.MkdirAll(filepath.Dir(targetPath), os.ModePerm); err == nil {
+				err = os.WriteFile(targetPath, nil, 0600)
+			}
+			if err != nil {
+				pp.ErrorLogf(ctx, "failed creating %v: %v", targetPath, err)
+			}
+			continue
+		}
+		targets[entry.FileHash] = append(targets[entry.FileHash], targetPath)
+	}
+
+	failed := 0
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, DIRECTORY_MAX_CONCURRENT_TRANSFERS)
+	for fileHash, targetPaths := range targets {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(fileHash string, targetPaths []string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := downloadFileAndWait(ctx, ownerWalletAddress, fileHash)
+			if err == nil {
+				err = file.PlaceDownloadedFile(file.GetDownloadFilePath(fileHash, ""), targetPaths)
+			}
+			if err != nil {
+				pp.ErrorLogf(ctx, "failed downloading %v: %v", targetPaths[0], err)
+				mutex.Lock()
+				failed++
+				mutex.Unlock()
+			}
+		}(fileHash, targetPaths)
+	}
+	wg.Wait()
+
+	pp.Log(ctx, "******************************************************")
+	pp.Log(ctx, "* Directory ", manifest.Name)
+	if failed > 0 {
+		pp.Logf(ctx, "* has been partially downloaded, %d files failed", failed)
+		pp.Log(ctx, "* Run 'get -r' again to retry")
+	} else {
+		pp.Log(ctx, "* has been successfully downloaded into ", dest)
+	}
+	pp.Log(ctx, "******************************************************")
+}
+
+// downloadFileAndWait downloads a single file into the download folder, named after its file hash
+func downloadFileAndWait(ctx context.Context, ownerWalletAddress, fileHash string) error {
+	if task.CheckDownloadTask(fileHash, setting.WalletAddress, task.LOCAL_REQID) {
+		return errors.New("the file is being downloaded")
+	}
+	ctx = createAndRegisterFileReqId(ctx, task.LOCAL_REQID)
+	reqId := strconv.FormatInt(core.GetReqIdFromContext(ctx), 10)
+	result := task.SubscribeDownloadResult(fileHash, reqId)
+	defer task.UnsubscribeDownloadResult(fileHash, reqId)
+
+	path := fwtypes.DataMeshId{Owner: ownerWalletAddress, Hash: fileHash}.String()
+	req := requests.ReqFileStorageInfoData(ctx, path, "", fileHash, setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil, nil, time.Now().Unix())
+	if err := ReqGetWalletOzForDownload(ctx, setting.WalletAddress, task.LOCAL_REQID, req); err != nil {
+		return err
+	}
+	return waitTransferResult(result)
+}
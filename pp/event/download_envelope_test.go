@@ -0,0 +1,38 @@
+package event
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto/secp256k1"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestOpenSliceData(t *testing.T) {
+	key, err := secp256k1.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setting.WalletPrivateKey = key
+	defer func() {
+		setting.WalletPrivateKey = nil
+	}()
+
+	for _, rawData := range [][]byte{bytes.Repeat([]byte("compressible slice "), 1000), []byte("short")} {
+		sealed, err := file.SealSliceData(rawData, file.COMPRESSION_ZSTD)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encrypted, err := encryptSliceData(rawData, file.COMPRESSION_ZSTD)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, envelope := range [][]byte{sealed, encrypted} {
+			data, err := openSliceData(envelope)
+			if err != nil || !bytes.Equal(data, rawData) {
+				t.Fatal("wrong slice data", err)
+			}
+		}
+	}
+}
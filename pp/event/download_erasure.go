@@ -0,0 +1,156 @@
+package event
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/task"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+// recoverErasureSlice starts recovering a lost slice of an erasure coded file from the parity slices of its stripe.
+// It returns false when the slice should be retried through the SP instead
+func recoverErasureSlice(ctx context.Context, dTask *task.DownloadTask, sliceHash, fileReqId string) bool {
+	e, ok := task.GetErasureDownload(dTask.FileHash, fileReqId)
+	if !ok {
+		return false
+	}
+	isParity := e.IsParity(sliceHash)
+	f, ok := task.DownloadFileMap.Load(dTask.FileHash + fileReqId)
+	if !ok {
+		return isParity
+	}
+	fInfo := f.(*protos.RspFileStorageInfo)
+
+	recoverable, toDownload := e.SetSliceFailed(sliceHash)
+	if !recoverable {
+		if isParity {
+			// the lost data slices of the stripe are downloaded again from the locations given by SP
+			pp.DebugLog(ctx, "too many slices of the stripe are lost, request for help from SP")
+			CheckAndSendRetryMessage(ctx, dTask)
+			return true
+		}
+		return false
+	}
+
+	if len(toDownload) > 0 {
+		pp.Logf(ctx, "slice %v is lost, recovering it from %d parity slices", sliceHash, len(toDownload))
+	}
+	for _, slice := range toDownload {
+		req := requests.ReqDownloadSliceData(ctx, fInfo, slice)
+		newCtx := createAndRegisterSliceReqId(ctx, fileReqId)
+		SendReqDownloadSlice(newCtx, fInfo.FileHash, slice, req, fileReqId)
+	}
+	recoverErasureStripe(ctx, fInfo, dTask, e, sliceHash)
+	return true
+}
+
+func receiveParitySlice(ctx context.Context, target *protos.RspDownloadSlice, fInfo *protos.RspFileStorageInfo,
+	dTask *task.DownloadTask, e *task.ErasureDownload) {
+	if target.SliceInfo.EncryptedSliceOffset == nil {
+		return
+	}
+	sliceHash := target.SliceInfo.SliceHash
+	data, complete := e.AddParityPiece(sliceHash, target.SliceSize, target.SliceInfo.EncryptedSliceOffset.SliceOffsetStart, target.Data)
+	if !complete {
+		return
+	}
+	if file.HasSliceEnvelope(fInfo.EncryptionTag, fInfo.Compression) {
+		var err error
+		if data, err = openSliceData(data); err != nil {
+			pp.ErrorLog(ctx, "Couldn't decrypt parity slice", err)
+			return
+		}
+	}
+	e.SetParityData(sliceHash, data)
+	pp.DebugLog(ctx, "parity slice download finished", sliceHash)
+
+	target.Result = &protos.Result{
+		State: protos.ResultState_RES_SUCCESS,
+	}
+	tkSlice := target.TaskId + sliceHash
+	totalCostTime := DownRecvCostTimeMap.AddCostTime(tkSlice, int64(0))
+	SendReportDownloadResult(ctx, target, totalCostTime, false)
+	DownRecvCostTimeMap.DeleteRecord(tkSlice)
+
+	recoverErasureStripe(ctx, fInfo, dTask, e, sliceHash)
+}
+
+// recoverErasureStripe decodes the missing data slices of the stripe once enough of its slices are available
+func recoverErasureStripe(ctx context.Context, fInfo *protos.RspFileStorageInfo, dTask *task.DownloadTask,
+	e *task.ErasureDownload, sliceHash string) {
+	stripe, parity, ok := e.GetStripeToRecover(sliceHash, dTask.IsSliceSuccess)
+	if !ok {
+		return
+	}
+
+	fileName := fInfo.FileName
+	if fileName == "" {
+		fileName = fInfo.FileHash
+	}
+	tmpFilePath := file.GetDownloadTmpFilePath(fInfo.FileHash, fileName)
+	// the data of a file sent to a remote client can't be read back, its data slices are kept in memory instead
+	remote := file.IsFileRpcRemote(fInfo.FileHash + fInfo.ReqId)
+	defer e.ReleaseStripeData(sliceHash, dTask.IsSliceSuccess)
+	data := make([][]byte, len(stripe.DataSlices))
+	dataSizes := make([]uint64, len(stripe.DataSlices))
+	for j, slice := range stripe.DataSlices {
+		dataSizes[j] = slice.SliceOffset.SliceOffsetEnd - slice.SliceOffset.SliceOffsetStart
+		if !dTask.IsSliceSuccess(slice.SliceStorageInfo.SliceHash) {
+			continue
+		}
+		var sliceData []byte
+		var err error
+		if remote {
+			var ok bool
+			if sliceData, ok = e.GetDataSlice(slice.SliceStorageInfo.SliceHash); !ok {
+				err = errors.New("the slice is not kept in memory")
+			}
+		} else {
+			sliceData, err = file.GetFileData(tmpFilePath, slice.SliceOffset)
+		}
+		if err != nil {
+			pp.ErrorLog(ctx, "failed reading downloaded slice, ", err)
+			CheckAndSendRetryMessage(ctx, dTask)
+			return
+		}
+		data[j] = sliceData
+	}
+	var missing []int
+	for j := range data {
+		if data[j] == nil {
+			missing = append(missing, j)
+		}
+	}
+
+	if err := file.ReconstructErasureData(data, parity, dataSizes); err != nil {
+		pp.ErrorLog(ctx, "failed recovering lost slices from parity slices, ", err)
+		CheckAndSendRetryMessage(ctx, dTask)
+		return
+	}
+	for _, j := range missing {
+		slice := stripe.DataSlices[j]
+		err := file.SaveDownloadedFileData(data[j], int64(slice.SliceOffset.SliceOffsetStart), slice.SliceStorageInfo.SliceHash,
+			fInfo.FileName, fInfo.FileHash, fInfo.SavePath, fInfo.ReqId)
+		if err != nil {
+			pp.ErrorLog(ctx, "Failed saving recovered slice", err.Error())
+			return
+		}
+		pp.Log(ctx, "slice recovered from parity slices, ", slice.SliceStorageInfo.SliceHash)
+		recoveredSlice(ctx, fInfo, dTask, slice)
+	}
+}
+
+// recoveredSlice is receivedSlice for a slice decoded from its stripe, there is no slice download to report to SP
+func recoveredSlice(ctx context.Context, fInfo *protos.RspFileStorageInfo, dTask *task.DownloadTask, slice *protos.DownloadSliceInfo) {
+	sliceHash := slice.SliceStorageInfo.SliceHash
+	file.SaveDownloadProgress(ctx, sliceHash, fInfo.FileName, fInfo.FileHash, fInfo.SavePath, fInfo.ReqId)
+	setDownloadSliceSuccess(ctx, sliceHash, dTask)
+	task.CleanDownloadTask(ctx, fInfo.FileHash, sliceHash, fInfo.WalletAddress, fInfo.ReqId)
+	task.DownloadProgress(ctx, fInfo.FileHash, fInfo.ReqId, slice.SliceOffset.SliceOffsetEnd-slice.SliceOffset.SliceOffsetStart)
+	scheduledSliceDone(ctx, fInfo.FileHash, sliceHash, fInfo.ReqId)
+}
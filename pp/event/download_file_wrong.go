@@ -68,6 +68,7 @@ func RspDownloadFileWrong(ctx context.Context, conn core.WriteCloser) {
 			pp.Log(ctx, "download has stopped")
 			return
 		}
+		var toRequest []*protos.DownloadSliceInfo
 		for _, slice := range target.SliceInfo {
 			utils.DebugLog("taskid ======= ", slice.TaskId)
 			if file.CheckSliceExisting(target.FileHash, target.FileName, slice.SliceStorageInfo.SliceHash, fileReqId) {
@@ -78,11 +79,10 @@ func RspDownloadFileWrong(ctx context.Context, conn core.WriteCloser) {
 			} else {
 				dTask.AddFailedSlice(slice.SliceStorageInfo.SliceHash)
 				task.DownloadSliceProgress.Store(slice.TaskId+slice.SliceStorageInfo.SliceHash+fileReqId, uint64(0))
-				req := requests.ReqDownloadSliceData(ctx, &target, slice)
-				newCtx := createAndRegisterSliceReqId(ctx, fileReqId)
-				SendReqDownloadSlice(newCtx, target.FileHash, slice, req, fileReqId)
+				toRequest = append(toRequest, slice)
 			}
 		}
+		scheduleDownloadSlices(ctx, &target, toRequest, fileReqId)
 	} else {
 		dTask, ok := task.GetDownloadTask(target.FileHash + target.WalletAddress + fileReqId)
 		if ok && strings.Contains(target.Result.Msg, "cannot find the task") && time.Now().Unix() < dTask.StartTimestamp+ResendFailedDownloadTimeLimit {
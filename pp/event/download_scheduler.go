@@ -0,0 +1,113 @@
+package event
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/metrics"
+	"github.com/stratosnet/sds/pp/p2pserver"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/task"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	// interval of the checks moving the slow slices of a download to faster resource nodes
+	downloadStealInterval = 5 * time.Second
+)
+
+// scheduleDownloadSlices queues the slices to download, and requests them from the resource nodes having a free slot
+func scheduleDownloadSlices(ctx context.Context, fInfo *protos.RspFileStorageInfo, slices []*protos.DownloadSliceInfo, fileReqId string) {
+	s := task.AddDownloadScheduler(fInfo.FileHash, fileReqId)
+	s.AddSlices(fInfo, slices)
+	if job, inserted := taskMonitorClock.AddJobRepeat(downloadStealInterval, 0, func() {
+		stealSlowSlices(ctx, s)
+	}); inserted && !s.SetJob(job) {
+		job.Cancel()
+	}
+	sendScheduledSlices(ctx, s, s.Next())
+}
+
+func stealSlowSlices(ctx context.Context, s *task.DownloadScheduler) {
+	if current, ok := task.GetDownloadScheduler(s.FileHash, s.FileReqId); !ok || current != s || s.Finished() {
+		s.StopJob()
+		return
+	}
+	sendScheduledSlices(ctx, s, s.Steal())
+	sendScheduledSlices(ctx, s, s.Next())
+}
+
+// scheduledSliceDone frees the slot of the slice and requests the next slices
+func scheduledSliceDone(ctx context.Context, fileHash, sliceHash, fileReqId string) {
+	s, ok := task.GetDownloadScheduler(fileHash, fileReqId)
+	if !ok {
+		return
+	}
+	s.Done(sliceHash)
+	sendScheduledSlices(ctx, s, s.Next())
+}
+
+// scheduledSliceFailed requests the slice from another node storing it. It returns false when there is none left
+func scheduledSliceFailed(ctx context.Context, fileHash, sliceHash, fileReqId string) bool {
+	s, ok := task.GetDownloadScheduler(fileHash, fileReqId)
+	if !ok {
+		return false
+	}
+	retried := s.Failed(sliceHash)
+	sendScheduledSlices(ctx, s, s.Next())
+	return retried
+}
+
+func sendScheduledSlices(ctx context.Context, s *task.DownloadScheduler, assignments []task.SliceAssignment) {
+	for _, a := range assignments {
+		sliceHash := a.Slice.SliceStorageInfo.SliceHash
+		if a.Stolen {
+			pp.DebugLogf(ctx, "slice %v is slow, requesting it from %v", sliceHash, a.Peer.P2PAddress)
+			task.DownloadSliceProgress.Delete(a.Slice.TaskId + sliceHash + s.FileReqId)
+			task.DownloadEncryptedSlices.Delete(sliceHash + s.FileReqId)
+			if a.PreviousPeer != nil {
+				cancelSliceFromPeer(ctx, a.FileInfo, a.Slice, a.PreviousPeer, s.FileReqId)
+			}
+		}
+		req := requests.ReqDownloadSliceData(ctx, a.FileInfo, a.Slice)
+		newCtx := createAndRegisterSliceReqId(ctx, s.FileReqId)
+		sendReqDownloadSliceToPeer(newCtx, a.FileInfo.FileHash, a.Peer, a.Slice, req, s.FileReqId)
+	}
+}
+
+// cancelSliceFromPeer tells the node a slice was requested from to stop sending it. The nodes of a network whose SP
+// doesn't announce the feature may drop the whole download task instead, so the slice isn't cancelled there
+func cancelSliceFromPeer(ctx context.Context, fInfo *protos.RspFileStorageInfo, slice *protos.DownloadSliceInfo, ppInfo *protos.PPBaseInfo,
+	fileReqId string) {
+	if !setting.SpSupports(setting.SpFeatureClearSlice) {
+		return
+	}
+	key := "download#" + fInfo.FileHash + ppInfo.P2PAddress + fileReqId
+	req := requests.ReqClearDownloadSliceData(ctx, fInfo, slice)
+	err := p2pserver.GetP2pServer(ctx).SendMessageByCachedConn(ctx, key, ppInfo.NetworkAddress, req, header.ReqClearDownloadTask, nil)
+	if err != nil {
+		pp.DebugLogf(ctx, "failed cancelling slice %v on %v: %v", slice.SliceStorageInfo.SliceHash, ppInfo.P2PAddress, err)
+	}
+}
+
+// sendReqDownloadSliceToPeer requests a slice from one of the nodes storing it
+func sendReqDownloadSliceToPeer(ctx context.Context, fileHash string, ppInfo *protos.PPBaseInfo, sliceInfo *protos.DownloadSliceInfo,
+	req *protos.ReqDownloadSlice, fileReqId string) {
+	utils.DebugLog("req = ", req)
+
+	networkAddress := ppInfo.NetworkAddress
+	key := "download#" + fileHash + ppInfo.P2PAddress + fileReqId
+	metrics.UploadPerformanceLogNow(fileHash + ":SND_REQ_SLICE_DATA:" + strconv.FormatInt(int64(sliceInfo.SliceOffset.SliceOffsetStart+(req.SliceNumber-1)*setting.MaxSliceSize), 10) + ":" + networkAddress)
+	err := p2pserver.GetP2pServer(ctx).SendMessageByCachedConn(ctx, key, networkAddress, req, header.ReqDownloadSlice, nil)
+	if err != nil {
+		pp.ErrorLogf(ctx, "Failed to create connection with %v: %v", networkAddress, utils.FormatError(err))
+		if dTask, ok := task.GetDownloadTask(fileHash + req.RspFileStorageInfo.WalletAddress + fileReqId); ok {
+			setDownloadSliceFail(ctx, sliceInfo.SliceStorageInfo.SliceHash, req.RspFileStorageInfo.TaskId, fileReqId, dTask)
+		}
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"google.golang.org/protobuf/proto"
+	"github.com/pkg/errors"
 
 	"github.com/stratosnet/sds/framework/client/cf"
 	"github.com/stratosnet/sds/framework/core"
@@ -17,6 +17,7 @@ import (
 	"github.com/stratosnet/sds/framework/crypto/encryption"
 	"github.com/stratosnet/sds/framework/crypto/encryption/hdkey"
 	"github.com/stratosnet/sds/framework/msg/header"
+	fwtypes "github.com/stratosnet/sds/framework/types"
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/pp"
 	"github.com/stratosnet/sds/pp/file"
@@ -44,6 +45,9 @@ var (
 	}
 
 	downloadSliceSpamCheckMap = utils.NewAutoCleanMap(setting.SpamThresholdSliceOperations)
+
+	// sendingSlices the slices being sent to a downloader  make(map[string]string) // K: tkId+sliceHash, V: downloader P2P address
+	sendingSlices = &sync.Map{}
 )
 
 type downSendCostTime struct {
@@ -218,6 +222,23 @@ func splitSendDownloadSliceData(ctx context.Context, target *protos.ReqDownloadS
 	}
 	sliceTaskId := slice.TaskId
 
+	if IsDraining() {
+		// a slice of size 0 makes the downloader request it from another node
+		rsp = &protos.RspDownloadSlice{
+			P2PAddress:        target.P2PAddress,
+			WalletAddress:     target.RspFileStorageInfo.WalletAddress,
+			SliceInfo:         &protos.SliceOffsetInfo{SliceHash: slice.SliceStorageInfo.SliceHash, SliceOffset: slice.SliceOffset},
+			FileHash:          target.RspFileStorageInfo.FileHash,
+			TaskId:            sliceTaskId,
+			Result:            &protos.Result{State: protos.ResultState_RES_FAIL, Msg: drainingMsg},
+			SpP2PAddress:      target.RspFileStorageInfo.SpP2PAddress,
+			StorageP2PAddress: p2pserver.GetP2pServer(ctx).GetP2PAddress().String(),
+			SliceNumber:       target.SliceNumber,
+		}
+		_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, rsp, header.RspDownloadSlice)
+		return
+	}
+
 	rsp, data = requests.RspDownloadSliceData(ctx, target, slice)
 	if rsp == nil && data == nil {
 		return
@@ -272,8 +293,20 @@ func splitSendDownloadSliceData(ctx context.Context, target *protos.ReqDownloadS
 		context:  ctx,
 		response: rsp,
 	})
-
-	for _, packet := range data {
+	sendingSlices.Store(tkSliceUID, target.P2PAddress)
+	defer sendingSlices.Delete(tkSliceUID)
+
+	for i, packet := range data {
+		if _, ok := sendingSlices.Load(tkSliceUID); !ok {
+			// the downloader requested the slice from another node, the slice isn't reported to SP
+			utils.DebugLog("slice sending cancelled, sliceHash: ", rsp.SliceInfo.SliceHash)
+			downloadRspMap.Delete(tkSliceUID)
+			DownSendCostTimeMap.DeleteRecord(tkSliceUID)
+			for _, buffer := range data[i:] {
+				utils.ReleaseBuffer(buffer)
+			}
+			return
+		}
 		utils.DebugLog("_____________________________")
 		utils.DebugLog(dataStart, dataEnd, offsetStart, offsetEnd)
 
@@ -303,6 +336,13 @@ func splitSendDownloadSliceData(ctx context.Context, target *protos.ReqDownloadS
 	}
 }
 
+// cancelSendingSlice stops sending a slice, when asked by the downloader it is sent to
+func cancelSendingSlice(tkSliceUID, p2pAddress string) {
+	if downloader, ok := sendingSlices.Load(tkSliceUID); ok && downloader == p2pAddress {
+		sendingSlices.Delete(tkSliceUID)
+	}
+}
+
 func prepareSendDownloadSliceData(ctx context.Context, rsp *protos.RspDownloadSlice, tkSliceUID string) (int64, context.Context) {
 	packetId, newCtx := p2pserver.CreateNewContextPacketId(ctx)
 	tkSlice := TaskSlice{
@@ -344,6 +384,12 @@ func RspDownloadSlice(ctx context.Context, conn core.WriteCloser) {
 		return
 	}
 
+	if s, ok := task.GetDownloadScheduler(target.FileHash, fileReqId); ok && target.SliceInfo != nil &&
+		!s.Received(target.SliceInfo.SliceHash, target.StorageP2PAddress, uint64(len(target.Data))) {
+		utils.DebugLogf("slice %v has been requested from another node, ignoring the response from %v", target.SliceInfo.SliceHash, target.StorageP2PAddress)
+		return
+	}
+
 	if target.SliceSize <= 0 || (target.Result.State == protos.ResultState_RES_FAIL && target.Result.Msg == LOSE_SLICE_MSG) {
 		pp.DebugLog(ctx, "slice was not found, will send msg to sp for retry, sliceHash: ", target.SliceInfo.SliceHash)
 		setDownloadSliceFail(ctx, target.SliceInfo.SliceHash, target.TaskId, fileReqId, dTask)
@@ -366,7 +412,9 @@ func RspDownloadSlice(ctx context.Context, conn core.WriteCloser) {
 		utils.DebugLog("SliceOffset", target.SliceInfo.SliceOffset)
 		utils.DebugLog("length", len(target.Data))
 		utils.DebugLog("sliceSize", target.SliceSize)
-		if fInfo.EncryptionTag != "" {
+		if e, ok := task.GetErasureDownload(target.FileHash, fileReqId); ok && e.IsParity(target.SliceInfo.SliceHash) {
+			receiveParitySlice(ctx, &target, fInfo, dTask, e)
+		} else if file.HasSliceEnvelope(fInfo.EncryptionTag, fInfo.Compression) {
 			receiveSliceAndProgressEncrypted(ctx, &target, fInfo, dTask, costTime)
 		} else {
 			receiveSliceAndProgress(ctx, &target, fInfo, dTask, costTime)
@@ -436,12 +484,14 @@ func receiveSliceAndProgressEncrypted(ctx context.Context, target *protos.RspDow
 
 	if dataToDecryptSize >= target.SliceSize {
 		// Decrypt slice data and save it to file
-		decryptedData, err := decryptSliceData(dataToDecrypt)
+		decryptedData, err := openSliceData(dataToDecrypt)
 		if err != nil {
 			pp.ErrorLog(ctx, "Couldn't decrypt slice", err)
 			return
 		}
 		target.Data = decryptedData
+		// the download progress counts the bytes of the file, not the size of the envelope
+		target.SliceSize = uint64(len(decryptedData))
 		err = task.SaveDownloadFile(ctx, target, fInfo)
 		if err != nil {
 			pp.ErrorLog(ctx, "Failed saving download file", err.Error())
@@ -481,6 +531,12 @@ func receivedSlice(ctx context.Context, target *protos.RspDownloadSlice, fInfo *
 	instantInboundSpeed := float64(target.SliceSize) / math.Max(float64(totalCostTime), 1)
 	metrics.InboundSpeed.WithLabelValues(reportReq.OpponentP2PAddress).Set(instantInboundSpeed)
 	DownRecvCostTimeMap.DeleteRecord(tkSlice)
+
+	scheduledSliceDone(ctx, target.FileHash, target.SliceInfo.SliceHash, fInfo.ReqId)
+	if e, ok := task.GetErasureDownload(target.FileHash, fInfo.ReqId); ok {
+		recoverErasureStripe(ctx, fInfo, dTask, e, target.SliceInfo.SliceHash)
+		e.ReleaseStripeData(target.SliceInfo.SliceHash, dTask.IsSliceSuccess)
+	}
 }
 
 // SendReportDownloadResult  PP-SP OR StoragePP-SP
@@ -508,7 +564,11 @@ func DownloadFileSlices(ctx context.Context, target *protos.RspFileStorageInfo,
 	utils.DebugLog("DownloadFileSlice(&target)", target)
 	fileSize := uint64(0)
 	dTask, _ := task.GetDownloadTask(target.FileHash + target.WalletAddress + reqId)
+	_, isErasureCoded := task.AddErasureDownload(target, reqId)
 	for _, sliceInfo := range target.SliceInfo {
+		if isErasureCoded && task.IsParitySlice(target, sliceInfo) {
+			continue
+		}
 		fileSize += sliceInfo.SliceOffset.SliceOffsetEnd - sliceInfo.SliceOffset.SliceOffsetStart
 	}
 	utils.DebugLogf("file size: %v  raw file size: %v\n", fileSize, target.FileSize)
@@ -520,10 +580,15 @@ func DownloadFileSlices(ctx context.Context, target *protos.RspFileStorageInfo,
 	}
 	slicesLocallyFound := make([]*protos.DownloadSliceInfo, 0)
 	needRequest := make([]*protos.DownloadSliceInfo, 0)
-	if !file.CheckFileExisting(ctx, target.FileHash, target.FileName, target.SavePath, target.EncryptionTag, reqId) {
+	if !file.CheckFileExisting(ctx, target.FileHash, target.FileName, target.SavePath, target.EncryptionTag, target.Compression, reqId) {
 		pp.Log(ctx, "download starts: ")
 		task.DownloadSpeedOfProgress.Store(target.FileHash+reqId, sp)
 		for _, slice := range target.SliceInfo {
+			if isErasureCoded && task.IsParitySlice(target, slice) {
+				// parity slices are only downloaded to recover the lost data slices of their stripe
+				dTask.SkipSlice(slice.SliceStorageInfo.SliceHash)
+				continue
+			}
 			if file.CheckSliceExisting(target.FileHash, target.FileName, slice.SliceStorageInfo.SliceHash, reqId) {
 				task.DownloadProgress(ctx, target.FileHash, reqId, slice.SliceOffset.SliceOffsetEnd-slice.SliceOffset.SliceOffsetStart)
 				task.CleanDownloadTask(ctx, target.FileHash, slice.SliceStorageInfo.SliceHash, target.WalletAddress, reqId)
@@ -533,14 +598,13 @@ func DownloadFileSlices(ctx context.Context, target *protos.RspFileStorageInfo,
 				needRequest = append(needRequest, slice)
 			}
 		}
-		for _, slice := range needRequest {
-			req := requests.ReqDownloadSliceData(ctx, target, slice)
-			newCtx := createAndRegisterSliceReqId(ctx, reqId)
-			SendReqDownloadSlice(newCtx, target.FileHash, slice, req, reqId)
-		}
+		scheduleDownloadSlices(ctx, target, needRequest, reqId)
 	} else {
+		// the file is in place already, which is all a caller waiting for the download needs
+		task.SetDownloadResult(target.FileHash, nil)
 		task.DownloadResult(ctx, target.FileHash, false, "file exists already.")
 		task.DeleteDownloadTask(target.FileHash, target.WalletAddress, target.ReqId)
+		task.DeleteDownloadJournal(target.FileHash, reqId)
 		slicesLocallyFound = append(slicesLocallyFound, target.SliceInfo...)
 	}
 	if len(slicesLocallyFound) > 0 {
@@ -552,18 +616,7 @@ func DownloadFileSlices(ctx context.Context, target *protos.RspFileStorageInfo,
 }
 
 func SendReqDownloadSlice(ctx context.Context, fileHash string, sliceInfo *protos.DownloadSliceInfo, req *protos.ReqDownloadSlice, fileReqId string) {
-	utils.DebugLog("req = ", req)
-
-	networkAddress := sliceInfo.StoragePpInfo.NetworkAddress
-	key := "download#" + fileHash + sliceInfo.StoragePpInfo.P2PAddress + fileReqId
-	metrics.UploadPerformanceLogNow(fileHash + ":SND_REQ_SLICE_DATA:" + strconv.FormatInt(int64(sliceInfo.SliceOffset.SliceOffsetStart+(req.SliceNumber-1)*setting.MaxSliceSize), 10) + ":" + networkAddress)
-	err := p2pserver.GetP2pServer(ctx).SendMessageByCachedConn(ctx, key, networkAddress, req, header.ReqDownloadSlice, nil)
-	if err != nil {
-		pp.ErrorLogf(ctx, "Failed to create connection with %v: %v", networkAddress, utils.FormatError(err))
-		if dTask, ok := task.GetDownloadTask(fileHash + req.RspFileStorageInfo.WalletAddress + fileReqId); ok {
-			setDownloadSliceFail(ctx, sliceInfo.SliceStorageInfo.SliceHash, req.RspFileStorageInfo.TaskId, fileReqId, dTask)
-		}
-	}
+	sendReqDownloadSliceToPeer(ctx, fileHash, sliceInfo.StoragePpInfo, sliceInfo, req, fileReqId)
 }
 
 // RspReportDownloadResult  SP-P OR SP-PP
@@ -592,24 +645,118 @@ func DownloadSliceCancel(ctx context.Context, fileHash, reqID string) {
 		task.DownloadTaskMap.Delete(fileHash + setting.WalletAddress + task.LOCAL_REQID)
 		task.CleanDownloadFileAndConnMap(ctx, fileHash, reqID)
 		task.CancelDownloadTask(fileHash)
+		task.DeleteDownloadJournal(fileHash, task.LOCAL_REQID)
 	}
 }
 
-func decryptSliceData(dataToDecrypt []byte) ([]byte, error) {
-	encryptedSlice := protos.EncryptedSlice{}
-	err := proto.Unmarshal(dataToDecrypt, &encryptedSlice)
+// ResumeDownloadTasks restarts the downloads recorded in the download journal, e.g. when the node starts. Downloads
+// requested over RPC wait for their client to resume them, until their tmp files expire
+func ResumeDownloadTasks(ctx context.Context) {
+	for _, journal := range task.LoadDownloadJournals() {
+		if journal.FileReqId != task.LOCAL_REQID {
+			if time.Since(time.Unix(journal.UpdateTime, 0)) > file.DEFAULT_EXP_THRESHOLD*24*time.Hour {
+				task.DeleteDownloadJournal(journal.FileHash, journal.FileReqId)
+			}
+			continue
+		}
+		if err := resumeDownloadTask(ctx, journal); err != nil {
+			pp.ErrorLogf(ctx, "failed resuming the download of file %v: %v", journal.FileHash, err)
+			continue
+		}
+		pp.Logf(ctx, "resumed the download of file %v", journal.FileHash)
+	}
+}
+
+// ResumeDownloadTask resumes the journaled download of a single file, e.g. after it has been paused
+func ResumeDownloadTask(ctx context.Context, fileHash string) error {
+	journal, err := task.LoadDownloadJournal(fileHash, task.LOCAL_REQID)
 	if err != nil {
-		utils.ErrorLog("Couldn't unmarshal protobuf to encrypted slice", err)
-		return nil, err
+		return err
 	}
+	return resumeDownloadTask(ctx, journal)
+}
 
-	key, err := hdkey.MasterKeyForSliceEncryption(setting.WalletPrivateKey.Bytes(), encryptedSlice.HdkeyNonce)
+// resumeDownloadTask requests fresh storage info from the SP. Slices already recorded in the download progress are
+// skipped by DownloadFileSlices, so only the missing ones are downloaded
+func resumeDownloadTask(ctx context.Context, journal *task.DownloadJournal) error {
+	if journal.FileReqId != task.LOCAL_REQID {
+		return errors.New("only downloads started from the local terminal can be resumed")
+	}
+	if journal.ShareKeyword != "" {
+		return errors.New("the file was shared by another wallet, resume the download with getsharefile")
+	}
+	if journal.WalletAddress != setting.WalletAddress {
+		return errors.New("the download was started by another wallet")
+	}
+	if task.CheckDownloadTask(journal.FileHash, journal.WalletAddress, journal.FileReqId) {
+		return errors.New("the file is being downloaded")
+	}
+
+	path := fwtypes.DataMeshId{Owner: journal.WalletAddress, Hash: journal.FileHash}.String()
+	ctx = core.RegisterRemoteReqId(ctx, task.LOCAL_REQID)
+	req := requests.ReqFileStorageInfoData(ctx, path, journal.SavePath, journal.FileName, setting.WalletAddress,
+		setting.WalletPublicKey.Bytes(), nil, nil, time.Now().Unix())
+	return ReqGetWalletOzForDownload(ctx, setting.WalletAddress, task.LOCAL_REQID, req)
+}
+
+// ResumeRpcDownloadTask resumes the journaled download requested over RPC with the given reqId. The slices are then
+// sent to the client the same way as for a new download
+func ResumeRpcDownloadTask(ctx context.Context, fileHash, reqId, walletAddr string, walletPubkey, walletSign []byte, reqTime int64) error {
+	journal, err := task.LoadDownloadJournal(fileHash, reqId)
 	if err != nil {
-		utils.ErrorLog("Couldn't generate slice encryption master key", err)
+		return err
+	}
+	if journal.WalletAddress != walletAddr {
+		return errors.New("the download was started by another wallet")
+	}
+	if journal.ShareKeyword != "" {
+		return errors.New("the file was shared by another wallet, download it again with RequestGetShared")
+	}
+	if task.CheckDownloadTask(journal.FileHash, journal.WalletAddress, journal.FileReqId) {
+		return errors.New("the file is being downloaded")
+	}
+
+	path := fwtypes.DataMeshId{Owner: journal.WalletAddress, Hash: journal.FileHash}.String()
+	ctx = core.RegisterRemoteReqId(ctx, reqId)
+	req := requests.ReqFileStorageInfoData(ctx, path, "", "", walletAddr, walletPubkey, walletSign, nil, reqTime)
+	p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, req, header.ReqFileStorageInfo)
+	return nil
+}
+
+// ListDownloadTasks lists the downloads recorded in the download journal for the given wallet, or for all wallets
+// when walletAddr is empty
+func ListDownloadTasks(walletAddr string) []task.DownloadJournalSummary {
+	var summaries []task.DownloadJournalSummary
+	for _, journal := range task.LoadDownloadJournals() {
+		if walletAddr != "" && journal.WalletAddress != walletAddr {
+			continue
+		}
+		summaries = append(summaries, journal.Summary())
+	}
+	return summaries
+}
+
+// openSliceData reads the data of a slice out of its envelope, decrypting and decompressing it when required
+func openSliceData(envelope []byte) ([]byte, error) {
+	encryptedSlice, compression, encrypted, err := file.UnmarshalSliceEnvelope(envelope)
+	if err != nil {
+		utils.ErrorLog("Couldn't unmarshal protobuf to encrypted slice", err)
 		return nil, err
 	}
 
-	return encryption.DecryptAES(key.PrivateKey(), encryptedSlice.Data, encryptedSlice.AesNonce, false)
+	data := encryptedSlice.Data
+	if encrypted {
+		key, err := hdkey.MasterKeyForSliceEncryption(setting.WalletPrivateKey.Bytes(), encryptedSlice.HdkeyNonce)
+		if err != nil {
+			utils.ErrorLog("Couldn't generate slice encryption master key", err)
+			return nil, err
+		}
+		data, err = encryption.DecryptAES(key.PrivateKey(), data, encryptedSlice.AesNonce, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return file.DecompressSliceData(data, compression, encryptedSlice.RawSize)
 }
 
 func verifyDownloadSliceHash(fileHash string, sliceNumber uint64, slice *protos.DownloadSliceInfo, buffers [][]byte) bool {
@@ -617,13 +764,7 @@ func verifyDownloadSliceHash(fileHash string, sliceNumber uint64, slice *protos.
 	for _, buffer := range buffers {
 		data = append(data, buffer...)
 	}
-	sliceHash, err := crypto.CalcSliceHash(data, fileHash, sliceNumber)
-	if err != nil {
-		utils.ErrorLog(err)
-		return false
-	}
-
-	return slice.SliceStorageInfo.SliceHash == sliceHash
+	return crypto.VerifySliceHash(data, fileHash, sliceNumber, slice.SliceStorageInfo.SliceHash)
 }
 
 func setDownloadSliceSuccess(ctx context.Context, sliceHash string, dTask *task.DownloadTask) {
@@ -631,6 +772,12 @@ func setDownloadSliceSuccess(ctx context.Context, sliceHash string, dTask *task.
 }
 
 func setDownloadSliceFail(ctx context.Context, sliceHash, taskId, fileReqId string, dTask *task.DownloadTask) {
+	if scheduledSliceFailed(ctx, dTask.FileHash, sliceHash, fileReqId) {
+		return
+	}
+	if recoverErasureSlice(ctx, dTask, sliceHash, fileReqId) {
+		return
+	}
 	dTask.AddFailedSlice(sliceHash)
 	CheckAndSendRetryMessage(ctx, dTask)
 }
@@ -0,0 +1,173 @@
+package event
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/task"
+)
+
+const (
+	drainCheckInterval  = time.Second
+	drainReportInterval = 10 * time.Second
+
+	drainingMsg = "the node is draining, it doesn't accept new slices"
+)
+
+// DrainTasks the slice work still ongoing on the node
+type DrainTasks struct {
+	Uploads       int // slices being uploaded to the node
+	Downloads     int // slices being served to the downloaders
+	Transfers     int // backups and transfers, sent or received
+	Verifications int
+}
+
+func (t DrainTasks) Total() int {
+	return t.Uploads + t.Downloads + t.Transfers + t.Verifications
+}
+
+// DrainStatus the progress of the drain of the node
+type DrainStatus struct {
+	Draining bool
+	Finished bool // the ongoing tasks are finished, or the timeout expired
+	Started  time.Time
+	Deadline time.Time
+	Ongoing  DrainTasks
+}
+
+var drain = struct {
+	draining bool
+	finished bool
+	started  time.Time
+	deadline time.Time
+	done     chan struct{} // closed when the drain is finished or stopped
+	mutex    sync.Mutex
+}{}
+
+// IsDraining tells whether the new slice work must be refused
+func IsDraining() bool {
+	drain.mutex.Lock()
+	defer drain.mutex.Unlock()
+	return drain.draining
+}
+
+func getDrainTasks() DrainTasks {
+	return DrainTasks{
+		Uploads:       GetOngoingUploadTaskCount(),
+		Downloads:     GetOngoingDownloadTaskCount(),
+		Transfers:     task.GetOngoingTransferTaskCnt(),
+		Verifications: task.GetOngoingVerifyTaskCnt(),
+	}
+}
+
+// GetDrainStatus returns the progress of the drain, and the slice work ongoing
+func GetDrainStatus() DrainStatus {
+	drain.mutex.Lock()
+	defer drain.mutex.Unlock()
+	return DrainStatus{
+		Draining: drain.draining,
+		Finished: drain.finished,
+		Started:  drain.started,
+		Deadline: drain.deadline,
+		Ongoing:  getDrainTasks(),
+	}
+}
+
+// StartDrain stops accepting new slices to upload, download, transfer or verify, and waits at most timeout for the
+// ongoing ones to finish. then is called once they are finished or the timeout expired, unless the drain is stopped
+// before. The node keeps refusing new slices until StopDrain is called. The drain outlives ctx, which is only used for
+// the logs and by then
+func StartDrain(ctx context.Context, timeout time.Duration, then func(ctx context.Context, finished bool)) error {
+	drain.mutex.Lock()
+	defer drain.mutex.Unlock()
+	if drain.draining {
+		return errors.New("the node is already draining")
+	}
+	done := make(chan struct{})
+	drain.draining = true
+	drain.finished = false
+	drain.started = time.Now()
+	drain.deadline = drain.started.Add(timeout)
+	drain.done = done
+	pp.Logf(ctx, "Draining the node, new slices are refused. Waiting up to %v for the ongoing tasks to finish", timeout)
+	go waitDrain(ctx, done, drain.deadline, then)
+	return nil
+}
+
+// StopDrain accepts new slices again. The function passed to StartDrain isn't called if the drain wasn't finished
+func StopDrain(ctx context.Context) error {
+	drain.mutex.Lock()
+	defer drain.mutex.Unlock()
+	if !drain.draining {
+		return errors.New("the node is not draining")
+	}
+	drain.draining = false
+	if !drain.finished {
+		close(drain.done)
+	}
+	pp.Log(ctx, "Drain stopped, new slices are accepted again")
+	return nil
+}
+
+// Drain drains the node and blocks until the ongoing tasks are finished, the timeout expired or ctx is done. It
+// returns whether all the tasks finished
+func Drain(ctx context.Context, timeout time.Duration) bool {
+	result := make(chan bool, 1)
+	if err := StartDrain(ctx, timeout, func(_ context.Context, finished bool) { result <- finished }); err != nil {
+		pp.ErrorLog(ctx, err.Error())
+		return false
+	}
+	select {
+	case finished := <-result:
+		return finished
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func waitDrain(ctx context.Context, done chan struct{}, deadline time.Time, then func(ctx context.Context, finished bool)) {
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	lastReport := time.Now()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			ongoing := getDrainTasks()
+			finished := ongoing.Total() == 0
+			if !finished && now.Before(deadline) {
+				if now.Sub(lastReport) >= drainReportInterval {
+					pp.Logf(ctx, "Draining: %v ongoing tasks (upload %v, download %v, transfer %v, verify %v), %v left",
+						ongoing.Total(), ongoing.Uploads, ongoing.Downloads, ongoing.Transfers, ongoing.Verifications,
+						deadline.Sub(now).Round(time.Second))
+					lastReport = now
+				}
+				continue
+			}
+
+			drain.mutex.Lock()
+			if drain.done != done || !drain.draining {
+				drain.mutex.Unlock()
+				return
+			}
+			drain.finished = true
+			close(done)
+			drain.mutex.Unlock()
+
+			if finished {
+				pp.Log(ctx, "Drain finished, all the tasks are completed")
+			} else {
+				pp.Logf(ctx, "Drain timed out with %v ongoing tasks", ongoing.Total())
+			}
+			if then != nil {
+				then(ctx, finished)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/pp/task"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestDrain(t *testing.T) {
+	ctx := context.Background()
+	task.AddVerifyTask("task", "slice", task.VerifyTask{SliceStorageInfo: &protos.SliceStorageInfo{}, LastTouchTime: time.Now().Unix()})
+	defer task.CleanVerifyTask("task", "slice")
+
+	result := make(chan bool, 1)
+	if err := StartDrain(ctx, time.Minute, func(_ context.Context, finished bool) { result <- finished }); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = StopDrain(ctx) }()
+	if !IsDraining() || StartDrain(ctx, time.Minute, nil) == nil {
+		t.Fatal("the node should be draining once")
+	}
+
+	time.Sleep(2 * drainCheckInterval)
+	if status := GetDrainStatus(); status.Finished || status.Ongoing.Verifications != 1 {
+		t.Fatalf("the drain shouldn't be finished, status %+v", status)
+	}
+	task.CleanVerifyTask("task", "slice")
+	select {
+	case finished := <-result:
+		if !finished || !GetDrainStatus().Finished {
+			t.Fatal("the drain should be finished")
+		}
+	case <-time.After(5 * drainCheckInterval):
+		t.Fatal("the drain didn't finish")
+	}
+}
+
+func TestDrainUploadSlice(t *testing.T) {
+	UpRecvCostTimeMap.mux.Lock()
+	UpRecvCostTimeMap.dataMap.Store("task1", int64(0))
+	UpRecvCostTimeMap.mux.Unlock()
+	defer func() {
+		UpRecvCostTimeMap.mux.Lock()
+		UpRecvCostTimeMap.dataMap.Delete("task1")
+		UpRecvCostTimeMap.mux.Unlock()
+	}()
+	if !isReceivingUploadSlice("task1") {
+		t.Fatal("the slice being received should be finished while draining")
+	}
+	if isReceivingUploadSlice("task2") {
+		t.Fatal("a new slice should be refused while draining, whatever the offset of its first piece")
+	}
+}
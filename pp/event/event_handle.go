@@ -63,6 +63,8 @@ func RegisterAllEventHandlers() {
 	registerEvent(header.RspSpLatencyCheck, RspSpLatencyCheck, SpRspVerifier)
 	registerEvent(header.RspDeleteFile, RspDeleteFile, SpRspVerifier)
 	registerEvent(header.RspClearExpiredShareLinks, RspClearExpiredShareLinks, SpRspVerifier)
+	registerEvent(header.RspReportLostSlices, RspReportLostSlices, SpRspVerifier)
+	registerEvent(header.RspSliceIndex, RspSliceIndex, SpRspVerifier)
 
 	// not_pp---sp--(*rsp*)--pp
 	registerEvent(header.NoticeActivatedPP, NoticeActivatedPP, SpAddressVerifier)
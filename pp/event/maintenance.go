@@ -36,6 +36,9 @@ func StartMaintenance(ctx context.Context, duration uint64) error {
 }
 
 func StopMaintenance(ctx context.Context) error {
+	if IsDraining() {
+		_ = StopDrain(ctx)
+	}
 	req := requests.ReqStopMaintenance(ctx)
 	pp.Log(ctx, "Sending maintenance stop request to SP!")
 	p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, req, header.ReqStopMaintenance)
@@ -101,9 +101,20 @@ func ReqClearDownloadTask(ctx context.Context, conn core.WriteCloser) {
 		fwutils.ErrorLog("failed verifying the message, ", err.Error())
 		return
 	}
-	if requests.UnmarshalData(ctx, &target) {
-		task.DeleteDownloadTask(target.WalletAddress, target.WalletAddress, "")
+	if !requests.UnmarshalData(ctx, &target) {
+		return
+	}
+	if target.SliceHash != "" {
+		// only the downloader itself can stop the sending, and the address in the message isn't signed
+		downloader := core.GetAuthenticatedSrcP2pAddrFromContext(ctx)
+		if downloader == "" || downloader != target.P2PAddress {
+			fwutils.DebugLogf("ignoring the request of an unauthenticated node to stop sending slice %v", target.SliceHash)
+			return
+		}
+		cancelSendingSlice(target.TaskId+target.SliceHash, downloader)
+		return
 	}
+	task.DeleteDownloadTask(target.WalletAddress, target.WalletAddress, "")
 }
 
 // ReqFileStorageInfo  P-PP , PP-SP
@@ -139,6 +150,7 @@ func RspFileStorageInfo(ctx context.Context, conn core.WriteCloser) {
 	if target.Result.State == protos.ResultState_RES_FAIL {
 		task.DownloadResult(ctx, target.FileHash, false, "failed ReqFileStorageInfo, "+target.Result.Msg)
 		file.SetDownloadSliceResult(target.FileHash+fileReqId, &rpc.Result{Return: rpc.FILE_REQ_FAILURE, Detail: "failed ReqFileStorageInfo" + target.Result.Msg})
+		file.SetRangeDownloadResult(target.FileHash+fileReqId, &rpc.Result{Return: rpc.FILE_REQ_FAILURE, Detail: "failed ReqFileStorageInfo" + target.Result.Msg})
 		return
 	}
 	metrics.DownloadPerformanceLogNow(target.FileHash + ":RCV_STORAGE_INFO_SP:")
@@ -167,10 +179,21 @@ func RspFileStorageInfo(ctx context.Context, conn core.WriteCloser) {
 		Slices:   slices,
 	})
 	file.SetDownloadSliceResult(target.FileHash+fileReqId, &rpc.Result{Return: rpc.DOWNLOAD_OK})
+	if file.IsRangeDownload(target.FileHash + fileReqId) {
+		// only the slices holding the requested range are downloaded, by the rpc server
+		file.SetRangeDownloadResult(target.FileHash+fileReqId, &rpc.Result{Return: rpc.DOWNLOAD_OK, FileHash: target.FileHash})
+		return
+	}
 	if crypto.IsVideoStream(target.FileHash) {
 		_ = file.SetRemoteFileResult(target.FileHash+fileReqId, rpc.Result{Return: rpc.DOWNLOAD_OK, FileHash: target.FileHash})
 		return
 	}
+	// downloads written to the tmp folder can be resumed, the streamed ones can't
+	if !file.IsFileRpcRemote(target.FileHash + fileReqId) {
+		if err := task.SaveDownloadJournal(newTarget); err != nil {
+			pp.ErrorLog(ctx, "failed saving download journal", err)
+		}
+	}
 	if !rpcRequested {
 		file.StartLocalDownload(target.FileHash)
 	}
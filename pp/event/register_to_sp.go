@@ -44,6 +44,9 @@ func RspRegister(ctx context.Context, conn core.WriteCloser) {
 
 	pp.Log(ctx, "Register successful", target.Result.Msg)
 	setting.IsPPSyncedWithSP = true
+	setting.SetSpFeatures(target.Features)
+	ReportLostSlices(ctx)
+	BackfillSliceIndex(ctx)
 	pp.DebugLog(ctx, "@@@@@@@@@@@@@@@@@@@@@@@@@@@@", p2pserver.GetP2pServer(ctx).GetConnectionName(conn))
 	setting.IsPP = target.IsPP
 	if setting.IsPP {
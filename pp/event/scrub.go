@@ -0,0 +1,279 @@
+package event
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alex023/clock"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/metrics"
+	"github.com/stratosnet/sds/pp/p2pserver"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	// slices written this recently may still be in transfer, they are checked by the next pass
+	scrubMinSliceAge         = time.Hour
+	scrubProgressLogInterval = time.Minute
+)
+
+var (
+	scrubClock = clock.NewClock()
+	scrubJob   clock.Job
+
+	errScrubStopped = errors.New("scrub stopped")
+)
+
+// ScrubResult the outcome of a pass of the scrubber over the stored slices
+type ScrubResult struct {
+	Started      time.Time
+	Finished     time.Time
+	Checked      int   // slices whose hash matched
+	Unverifiable int   // slices missing from the slice index, their hash can't be computed
+	Failed       int   // slices that couldn't be read
+	Bytes        int64 // size of the slices read
+	Corrupt      []string
+}
+
+// ScrubStatus the progress of the running pass and the result of the last complete one
+type ScrubStatus struct {
+	Running bool
+	Current ScrubResult
+	Last    *ScrubResult
+}
+
+var scrub = struct {
+	running bool
+	stop    chan struct{}
+	current ScrubResult
+	last    *ScrubResult
+	mutex   sync.Mutex
+}{}
+
+// StartScrubJob checks all the stored slices at the interval of the config
+func StartScrubJob(ctx context.Context) {
+	interval := setting.Config.Node.Scrub.Interval
+	if interval == 0 {
+		return
+	}
+	utils.Log("Starting ScrubJob......")
+	scrubJob, _ = scrubClock.AddJobRepeat(time.Hour*time.Duration(interval), 0, func() {
+		if err := StartScrub(ctx); err != nil {
+			utils.DebugLog("scheduled scrub not started,", err.Error())
+		}
+	})
+}
+
+func StopScrubJob() {
+	if scrubJob != nil {
+		utils.Log("Stopping ScrubJob......")
+		scrubJob.Cancel()
+	}
+	_ = StopScrub()
+}
+
+// StartScrub starts a pass over the stored slices in the background. The pass outlives ctx, which is used for the logs
+// and to report the corrupt slices
+func StartScrub(ctx context.Context) error {
+	scrub.mutex.Lock()
+	defer scrub.mutex.Unlock()
+	if scrub.running {
+		return errors.New("the scrubber is already running")
+	}
+	stop := make(chan struct{})
+	scrub.running = true
+	scrub.stop = stop
+	scrub.current = ScrubResult{Started: time.Now()}
+	go runScrub(ctx, stop)
+	return nil
+}
+
+// StopScrub interrupts the running pass. The corrupt slices found so far are still reported
+func StopScrub() error {
+	scrub.mutex.Lock()
+	defer scrub.mutex.Unlock()
+	if !scrub.running {
+		return errors.New("the scrubber is not running")
+	}
+	scrub.running = false
+	close(scrub.stop)
+	return nil
+}
+
+// GetScrubStatus returns the progress of the running pass, and the result of the last complete one
+func GetScrubStatus() ScrubStatus {
+	scrub.mutex.Lock()
+	defer scrub.mutex.Unlock()
+	status := ScrubStatus{Running: scrub.running, Current: scrub.current, Last: scrub.last}
+	status.Current.Corrupt = append([]string(nil), scrub.current.Corrupt...)
+	return status
+}
+
+func runScrub(ctx context.Context, stop chan struct{}) {
+	pp.Log(ctx, "Scrubbing the stored slices")
+	metas, err := file.LoadSliceMetas()
+	if err != nil {
+		pp.ErrorLog(ctx, "failed loading the slice index,", err.Error())
+		finishScrub(ctx, stop, false)
+		return
+	}
+
+	bucket := utils.NewTokenBucket(setting.Config.Node.Scrub.Rate * 1024)
+	lastLog := time.Now()
+	err = file.WalkSlices(func(sliceHash string, size int64, modTime time.Time) error {
+		select {
+		case <-stop:
+			return errScrubStopped
+		default:
+		}
+		if time.Since(modTime) < scrubMinSliceAge {
+			return nil
+		}
+		meta, ok := metas[sliceHash]
+		if !ok {
+			updateScrub(stop, func(r *ScrubResult) { r.Unverifiable++ })
+			metrics.ScrubSliceCount.WithLabelValues("unverifiable").Inc()
+			return nil
+		}
+
+		bucket.Wait(int(size))
+		data, err := file.GetSliceData(sliceHash)
+		if errors.Is(err, file.ErrSliceDamaged) {
+			pp.ErrorLogf(ctx, "slice %v of file %v is damaged in its segment, it is lost", sliceHash, meta.FileHash)
+			if err = file.MarkSlicesLost([]file.SliceMeta{meta}); err != nil {
+				pp.ErrorLog(ctx, "failed marking the slice lost,", err.Error())
+			}
+			updateScrub(stop, func(r *ScrubResult) { r.Corrupt = append(r.Corrupt, sliceHash) })
+			metrics.ScrubSliceCount.WithLabelValues("corrupt").Inc()
+			return nil
+		}
+		if err != nil {
+			pp.ErrorLogf(ctx, "scrub failed reading slice %v, %v", sliceHash, err.Error())
+			updateScrub(stop, func(r *ScrubResult) { r.Failed++ })
+			metrics.ScrubSliceCount.WithLabelValues("failed").Inc()
+			return nil
+		}
+		if crypto.VerifySliceHash(data, meta.FileHash, meta.SliceNumber, sliceHash) {
+			updateScrub(stop, func(r *ScrubResult) { r.Checked++; r.Bytes += int64(len(data)) })
+			metrics.ScrubSliceCount.WithLabelValues("ok").Inc()
+		} else {
+			pp.ErrorLogf(ctx, "slice %v of file %v is corrupt, moving it to quarantine", sliceHash, meta.FileHash)
+			if err = file.QuarantineSlice(meta); err != nil {
+				pp.ErrorLog(ctx, "failed quarantining the slice,", err.Error())
+			}
+			updateScrub(stop, func(r *ScrubResult) { r.Bytes += int64(len(data)); r.Corrupt = append(r.Corrupt, sliceHash) })
+			metrics.ScrubSliceCount.WithLabelValues("corrupt").Inc()
+		}
+
+		if time.Since(lastLog) >= scrubProgressLogInterval {
+			status := GetScrubStatus()
+			pp.Logf(ctx, "Scrubbing: %v slices checked, %v corrupt", status.Current.Checked, len(status.Current.Corrupt))
+			lastLog = time.Now()
+		}
+		return nil
+	})
+	if err != nil && err != errScrubStopped {
+		pp.ErrorLog(ctx, "failed walking the storage,", err.Error())
+	}
+
+	ReportLostSlices(ctx)
+	finishScrub(ctx, stop, err == nil)
+}
+
+// updateScrub updates the progress of the pass, unless another one was started since it was stopped
+func updateScrub(stop chan struct{}, update func(r *ScrubResult)) {
+	scrub.mutex.Lock()
+	defer scrub.mutex.Unlock()
+	if scrub.stop == stop {
+		update(&scrub.current)
+	}
+}
+
+// finishScrub records the result of the pass when it went over all the slices
+func finishScrub(ctx context.Context, stop chan struct{}, complete bool) {
+	scrub.mutex.Lock()
+	defer scrub.mutex.Unlock()
+	if scrub.stop != stop {
+		return
+	}
+	if scrub.running {
+		scrub.running = false
+		close(stop)
+	}
+	result := scrub.current
+	result.Finished = time.Now()
+	if !complete {
+		pp.Logf(ctx, "Scrub interrupted after %v slices, %v corrupt", result.Checked, len(result.Corrupt))
+		return
+	}
+	scrub.last = &result
+	pp.Logf(ctx, "Scrub finished in %v: %v slices checked, %v corrupt, %v unverifiable, %v unreadable",
+		result.Finished.Sub(result.Started).Round(time.Second), result.Checked, len(result.Corrupt), result.Unverifiable, result.Failed)
+	metrics.ScrubLastPass.WithLabelValues("time").Set(float64(result.Finished.Unix()))
+	metrics.ScrubLastPass.WithLabelValues("checked").Set(float64(result.Checked))
+	metrics.ScrubLastPass.WithLabelValues("corrupt").Set(float64(len(result.Corrupt)))
+	metrics.ScrubLastPass.WithLabelValues("unverifiable").Set(float64(result.Unverifiable))
+	metrics.ScrubLastPass.WithLabelValues("failed").Set(float64(result.Failed))
+}
+
+// ReportLostSlices asks the SP to replicate again the slices which were stored on missing storage pools, found corrupt
+// by the scrubber, or found missing by a storage check. They stay marked lost until the SP acknowledges them, or until
+// the node registers to an SP answering ReqReportLostSlices
+func ReportLostSlices(ctx context.Context) {
+	lost := file.GetLostSlices()
+	if len(lost) == 0 {
+		return
+	}
+	pp.ErrorLogf(ctx, "%v stored slices are lost", len(lost))
+	if !setting.SpSupports(setting.SpFeatureLostSlices) {
+		pp.Log(ctx, "SP doesn't take lost slices reports, they are kept until the node registers to an SP taking them")
+		return
+	}
+	slices := make(map[string][]string) // by file hash
+	for _, meta := range lost {
+		slices[meta.FileHash] = append(slices[meta.FileHash], meta.SliceHash)
+	}
+	fileHashes := make([]string, 0, len(slices))
+	for fileHash := range slices {
+		fileHashes = append(fileHashes, fileHash)
+	}
+	sort.Strings(fileHashes)
+	for _, fileHash := range fileHashes {
+		pp.Logf(ctx, "Reporting %v lost slices of file %v to SP", len(slices[fileHash]), fileHash)
+		p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, requests.ReqLostSlicesData(ctx, fileHash, slices[fileHash]), header.ReqReportLostSlices)
+	}
+}
+
+// RspReportLostSlices forgets the lost slices of the file once the SP will replicate them
+func RspReportLostSlices(ctx context.Context, conn core.WriteCloser) {
+	var target protos.RspReportLostSlices
+	if err := VerifyMessage(ctx, header.RspReportLostSlices, &target); err != nil {
+		utils.ErrorLog("failed verifying the message, ", err.Error())
+		return
+	}
+	if !requests.UnmarshalData(ctx, &target) {
+		return
+	}
+	if target.Result.State != protos.ResultState_RES_SUCCESS {
+		pp.ErrorLogf(ctx, "SP refused the lost slices of file %v, they are reported again on the next registration: %v", target.FileHash, target.Result.Msg)
+		return
+	}
+	var reported []file.SliceMeta
+	for _, meta := range file.GetLostSlices() {
+		if meta.FileHash == target.FileHash {
+			reported = append(reported, meta)
+		}
+	}
+	file.ForgetLostSlices(reported)
+}
@@ -0,0 +1,122 @@
+package event
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/p2pserver"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+// sliceIndexBatchSize number of slices asked to the SP in one message
+const sliceIndexBatchSize = 1000
+
+// slices stored before the slice index existed, waiting for the SP to tell their file hash and slice number
+var sliceIndexBackfill = struct {
+	running bool
+	pending map[string]file.SliceMeta
+	mutex   sync.Mutex
+}{}
+
+// BackfillSliceIndex adds the slices stored by a version without the slice index to it, once. It is started each time
+// the node registers to an SP answering ReqSliceIndex, until the SP answered for all the slices
+func BackfillSliceIndex(ctx context.Context) {
+	if file.IsSliceIndexBackfilled() || !setting.SpSupports(setting.SpFeatureSliceIndex) {
+		return
+	}
+	sliceIndexBackfill.mutex.Lock()
+	defer sliceIndexBackfill.mutex.Unlock()
+	if sliceIndexBackfill.running {
+		return
+	}
+	sliceIndexBackfill.running = true
+	go func() {
+		unindexed, err := file.FindUnindexedSlices()
+		sliceIndexBackfill.mutex.Lock()
+		defer sliceIndexBackfill.mutex.Unlock()
+		sliceIndexBackfill.running = false
+		if err != nil {
+			pp.ErrorLog(ctx, "failed finding the slices missing from the slice index,", err.Error())
+			return
+		}
+		sliceIndexBackfill.pending = unindexed
+		if finishSliceIndexBackfill(ctx) {
+			return
+		}
+
+		sliceHashes := make([]string, 0, len(unindexed))
+		for sliceHash := range unindexed {
+			sliceHashes = append(sliceHashes, sliceHash)
+		}
+		sort.Strings(sliceHashes)
+		pp.Logf(ctx, "Asking SP about %v slices missing from the slice index", len(sliceHashes))
+		for start := 0; start < len(sliceHashes); start += sliceIndexBatchSize {
+			end := start + sliceIndexBatchSize
+			if end > len(sliceHashes) {
+				end = len(sliceHashes)
+			}
+			p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, requests.ReqSliceIndexData(ctx, sliceHashes[start:end]), header.ReqSliceIndex)
+		}
+	}()
+}
+
+// RspSliceIndex adds the slices the SP knows to the slice index. The ones it doesn't know are left out of it
+func RspSliceIndex(ctx context.Context, conn core.WriteCloser) {
+	var target protos.RspSliceIndex
+	if err := VerifyMessage(ctx, header.RspSliceIndex, &target); err != nil {
+		utils.ErrorLog("failed verifying the message, ", err.Error())
+		return
+	}
+	if !requests.UnmarshalData(ctx, &target) {
+		return
+	}
+	if target.Result.State != protos.ResultState_RES_SUCCESS {
+		pp.ErrorLog(ctx, "failed getting the slice index from SP,", target.Result.Msg)
+		return
+	}
+
+	sliceIndexBackfill.mutex.Lock()
+	defer sliceIndexBackfill.mutex.Unlock()
+	for _, entry := range target.Slices {
+		meta, ok := sliceIndexBackfill.pending[entry.SliceHash]
+		if !ok {
+			continue
+		}
+		delete(sliceIndexBackfill.pending, entry.SliceHash)
+		meta.FileHash = entry.FileHash
+		meta.SliceNumber = entry.SliceNumber
+		meta.Owner = entry.Owner
+		if err := file.BackfillSliceMeta(meta); err != nil {
+			pp.ErrorLogf(ctx, "slice %v not added to the slice index: %v", entry.SliceHash, err.Error())
+		}
+	}
+	for _, sliceHash := range target.UnknownSliceHashes {
+		if _, ok := sliceIndexBackfill.pending[sliceHash]; ok {
+			utils.DebugLogf("slice %v is unknown to SP, left out of the slice index", sliceHash)
+			delete(sliceIndexBackfill.pending, sliceHash)
+		}
+	}
+	finishSliceIndexBackfill(ctx)
+}
+
+// finishSliceIndexBackfill marks the backfill done once no slice is pending. It must be called with the mutex held
+func finishSliceIndexBackfill(ctx context.Context) bool {
+	if sliceIndexBackfill.pending == nil || len(sliceIndexBackfill.pending) > 0 {
+		return false
+	}
+	sliceIndexBackfill.pending = nil
+	if err := file.MarkSliceIndexBackfilled(); err != nil {
+		pp.ErrorLog(ctx, "failed marking the slice index backfilled,", err.Error())
+		return true
+	}
+	pp.Log(ctx, "All the stored slices are in the slice index")
+	return true
+}
@@ -50,6 +50,12 @@ func NoticeFileSliceBackup(ctx context.Context, conn core.WriteCloser) {
 		utils.DebugLog("CheckTransfer failed")
 		return
 	}
+	if IsDraining() {
+		// the SP picks another node for the slice
+		utils.DebugLog("Refusing slice backup notice because " + drainingMsg)
+		p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, requests.ReqTransferDownloadWrongData(ctx, target), header.ReqTransferDownloadWrong)
+		return
+	}
 
 	tTask := task.TransferTask{
 		IsReceiver:         true,
@@ -83,6 +89,16 @@ func ReqTransferDownload(ctx context.Context, conn core.WriteCloser) {
 	if !requests.UnmarshalData(ctx, &target) {
 		return
 	}
+	if IsDraining() {
+		rsp := &protos.RspTransferDownload{
+			Result: &protos.Result{
+				State: protos.ResultState_RES_FAIL,
+				Msg:   drainingMsg,
+			},
+		}
+		_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, rsp, header.RspTransferDownload)
+		return
+	}
 	setWriteHookForRspTransferSlice(conn)
 
 	noticeFileSliceBackup := target.NoticeFileSliceBackup
@@ -188,6 +204,7 @@ func RspTransferDownload(ctx context.Context, conn core.WriteCloser) {
 	}
 
 	// data is received
+	task.RecordPeerSuccess(core.GetAuthenticatedSrcP2pAddrFromContext(ctx), time.Duration(totalCostTIme)*time.Millisecond)
 	SendReportBackupSliceResult(ctx, target.TaskId, target.SliceHash, target.SpP2PAddress, true, false, totalCostTIme)
 	_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, requests.RspTransferDownloadResultData(target.TaskId, target.SliceHash, target.SpP2PAddress), header.RspTransferDownloadResult)
 }
@@ -213,9 +230,11 @@ func RspTransferDownloadResult(ctx context.Context, conn core.WriteCloser) {
 
 	if target.Result.State != protos.ResultState_RES_SUCCESS {
 		// Transfer failed
+		task.RecordPeerFailure(core.GetAuthenticatedSrcP2pAddrFromContext(ctx))
 		SendReportBackupSliceResult(ctx, target.TaskId, target.SliceHash, target.SpP2PAddress, false, false, totalCostTime)
 		return
 	}
+	task.RecordPeerSuccess(core.GetAuthenticatedSrcP2pAddrFromContext(ctx), time.Duration(totalCostTime)*time.Millisecond)
 
 	deleteOrigin := false
 	if tTask, ok := task.GetTransferTask(target.TaskId, target.SliceHash); ok && tTask.DeleteOrigin {
@@ -332,6 +351,11 @@ func sendTransferFailureReportToSP(ctx context.Context, taskSliceUIDs []string)
 		}
 		utils.DebugLogf("--- reporting backup failure for task[%v]-sliceHash[%v] to sp[%v], isReceiver=%v",
 			tTask.TaskId, tTask.SliceStorageInfo.SliceHash, tTask.SpP2pAddress, tTask.IsReceiver)
+		if tTask.IsReceiver && tTask.PpInfo != nil {
+			task.RecordPeerFailure(tTask.PpInfo.P2PAddress)
+		} else if !tTask.IsReceiver {
+			task.RecordPeerFailure(tTask.ReceiverP2pAddress)
+		}
 		SendReportBackupSliceResult(ctx, tTask.TaskId, tTask.SliceStorageInfo.SliceHash, tTask.SpP2pAddress, false, false, 0)
 		// delete KV from maps
 		task.CleanTransferTaskByTaskSliceUID(taskSliceUID)
@@ -7,12 +7,12 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/stratosnet/sds/framework/client/cf"
 	"github.com/stratosnet/sds/framework/core"
@@ -38,38 +38,46 @@ var (
 )
 
 // RequestUploadFile request to SP for upload file
-func RequestUploadFile(ctx context.Context, path string, isEncrypted, isVideoStream bool, desiredTier uint32, allowHigherTier bool,
-	walletAddr string, walletPubkey, wsign []byte) {
+func RequestUploadFile(ctx context.Context, path string, isEncrypted, isVideoStream bool, sliceOptions SliceOptions, desiredTier uint32,
+	allowHigherTier bool, walletAddr string, walletPubkey, wsign []byte) {
 	pp.DebugLog(ctx, "______________path", path)
 	if !setting.CheckLogin() {
 		return
 	}
 
-	isFile, err := file.IsFile(path)
+	p, err := prepareUploadFile(ctx, path, isEncrypted, isVideoStream, sliceOptions, desiredTier, allowHigherTier,
+		walletAddr, walletPubkey, wsign)
 	if err != nil {
 		pp.ErrorLog(ctx, err)
 		return
 	}
+	if err = ReqGetWalletOzForUpload(ctx, setting.WalletAddress, task.LOCAL_REQID, p); err != nil {
+		pp.ErrorLog(ctx, err)
+	}
+}
+
+// prepareUploadFile slices the file into the tmp folder and builds the upload request to send to SP
+func prepareUploadFile(ctx context.Context, path string, isEncrypted, isVideoStream bool, sliceOptions SliceOptions, desiredTier uint32,
+	allowHigherTier bool, walletAddr string, walletPubkey, wsign []byte) (*protos.ReqUploadFile, error) {
+	isFile, err := file.IsFile(path)
+	if err != nil {
+		return nil, err
+	}
 	if !isFile {
-		pp.ErrorLog(ctx, "the provided path indicates a directory, not a file")
-		return
+		return nil, errors.New("the provided path indicates a directory, not a file")
 	}
 	encryptionTag := ""
 	if isEncrypted {
 		encryptionTag = utils.GetRandomString(8)
 	}
-	uploadFileHandler := GetUploadFileHandler(isVideoStream)
+	uploadFileHandler := GetUploadFileHandler(isVideoStream, sliceOptions)
 	fileInfo, slices, err := uploadFileHandler.PreUpload(ctx, path, encryptionTag)
 	if err != nil {
-		pp.ErrorLog(ctx, "failed to slice file before upload ", err)
-		return
+		return nil, errors.Wrap(err, "failed to slice file before upload")
 	}
 
 	reqTime := time.Now().Unix()
-	p := requests.RequestUploadFileData(ctx, fileInfo, slices, desiredTier, allowHigherTier, walletAddr, walletPubkey, wsign, reqTime)
-	if err = ReqGetWalletOzForUpload(ctx, setting.WalletAddress, task.LOCAL_REQID, p); err != nil {
-		pp.ErrorLog(ctx, err)
-	}
+	return requests.RequestUploadFileData(ctx, fileInfo, slices, desiredTier, allowHigherTier, walletAddr, walletPubkey, wsign, reqTime), nil
 }
 
 func ScheduleReqBackupStatus(ctx context.Context, fileHash string) {
@@ -116,8 +124,11 @@ func RspUploadFile(ctx context.Context, _ core.WriteCloser) {
 	if target.Result.State != protos.ResultState_RES_SUCCESS {
 		if strings.Contains(target.Result.Msg, "Same file with the name") {
 			pp.ErrorLog(ctx, target.Result.Msg)
+			// the wallet owns the file already
+			task.SetUploadResult(target.FileHash, nil)
 		} else {
 			pp.ErrorLog(ctx, "upload failed: ", target.Result.Msg)
+			task.SetUploadResult(target.FileHash, errors.New("upload failed: "+target.Result.Msg))
 		}
 
 		if file.IsFileRpcRemote(target.FileHash) {
@@ -135,6 +146,9 @@ func RspUploadFile(ctx context.Context, _ core.WriteCloser) {
 		uploadTask := task.CreateUploadFileTask(target, uploadTaskHelper)
 		p2pserver.GetP2pServer(ctx).CleanUpConnMap(target.FileHash)
 		task.UploadFileTaskMap.Store(target.FileHash, uploadTask)
+		if err := task.SaveUploadJournal(uploadTask); err != nil {
+			pp.ErrorLog(ctx, "failed saving upload journal, the upload can't be resumed after a restart: ", err)
+		}
 
 		go startUploadTask(ctx, target.FileHash, uploadTask)
 	} else {
@@ -142,6 +156,7 @@ func RspUploadFile(ctx context.Context, _ core.WriteCloser) {
 		//var p float32 = 100
 		//ProgressMap.Store(target.FileHash, p)
 		task.UploadProgressMap.Delete(target.FileHash)
+		task.SetUploadResult(target.FileHash, nil)
 	}
 
 	// tell the rpc client, uploading to sds network has successfully started.
@@ -244,6 +259,12 @@ func uploadTaskHelper(ctx context.Context, fileHash string) {
 	if errors.Is(err, task.UploadErrMaxRetries) || errors.Is(err, task.UploadFinished) || errors.Is(err, task.UploadErrFatalError) {
 		task.StopRepeatedUploadTaskJob(fileHash)
 		task.UploadFileTaskMap.Delete(fileHash)
+		task.DeleteUploadJournal(fileHash)
+		if errors.Is(err, task.UploadFinished) {
+			task.SetUploadResult(fileHash, nil)
+		} else {
+			task.SetUploadResult(fileHash, err)
+		}
 		return
 	}
 	if errors.Is(err, task.UploadErrNoUploadTask) {
@@ -308,12 +329,76 @@ func UploadPause(ctx context.Context, fileHash, reqID string, w http.ResponseWri
 		pp.DebugLog(ctx, "UploadPause", conn)
 		return true
 	})
+	p2pserver.GetP2pServer(ctx).CloseCachedStreams("upload#" + fileHash)
 	p2pserver.GetP2pServer(ctx).CleanUpConnMap(fileHash)
+	// keep the journal up to date, so the paused upload can be resumed later
+	if value, ok := task.UploadFileTaskMap.Load(fileHash); ok {
+		if err := task.SaveUploadJournal(value.(*task.UploadFileTask)); err != nil {
+			pp.ErrorLog(ctx, "failed saving upload journal", err)
+		}
+		task.StopRepeatedUploadTaskJob(fileHash)
+	}
 	task.UploadFileTaskMap.Delete(fileHash)
 	task.UploadProgressMap.Delete(fileHash)
 }
 
-func encryptSliceData(rawData []byte) ([]byte, error) {
+// ResumeUploadTasks resumes all the uploads found in the upload journal, typically when the node starts
+func ResumeUploadTasks(ctx context.Context) {
+	for _, journal := range task.LoadUploadJournals() {
+		if err := resumeUploadTask(ctx, journal); err != nil {
+			pp.ErrorLogf(ctx, "failed resuming the upload of file %v: %v", journal.FileHash, err)
+			continue
+		}
+		pp.Logf(ctx, "resumed the upload of file %v", journal.FileHash)
+	}
+}
+
+// ResumeUploadTask resumes the journaled upload of a single file, e.g. after it has been paused
+func ResumeUploadTask(ctx context.Context, fileHash string) error {
+	if _, ok := task.UploadFileTaskMap.Load(fileHash); ok {
+		return errors.New("the file is being uploaded")
+	}
+	journal, err := task.LoadUploadJournal(fileHash)
+	if err != nil {
+		return err
+	}
+	return resumeUploadTask(ctx, journal)
+}
+
+func resumeUploadTask(ctx context.Context, journal *task.UploadJournal) error {
+	uploadTask, err := task.RestoreUploadFileTask(journal, uploadTaskHelper)
+	if err != nil {
+		if errors.Is(err, task.UploadErrMaxRetries) || errors.Is(err, task.UploadErrFatalError) {
+			task.DeleteUploadJournal(journal.FileHash)
+		}
+		return err
+	}
+	p2pserver.GetP2pServer(ctx).CleanUpConnMap(journal.FileHash)
+	task.UploadTaskIdMap.Store(journal.FileHash, uploadTask.GetUploadTaskId())
+	task.UploadFileTaskMap.Store(journal.FileHash, uploadTask)
+	if err = task.SaveUploadJournal(uploadTask); err != nil {
+		pp.ErrorLog(ctx, "failed saving upload journal", err)
+	}
+	go startUploadTask(ctx, journal.FileHash, uploadTask)
+	return nil
+}
+
+// ListUploadTasks lists the uploads recorded in the upload journal
+func ListUploadTasks() []task.UploadJournalSummary {
+	var summaries []task.UploadJournalSummary
+	for _, journal := range task.LoadUploadJournals() {
+		summaries = append(summaries, journal.Summary())
+	}
+	return summaries
+}
+
+// encryptSliceData compresses the slice with the codec if it shrinks, then encrypts it into a slice envelope
+func encryptSliceData(rawData []byte, compression string) ([]byte, error) {
+	data, compression, err := file.CompressSliceData(rawData, compression)
+	if err != nil {
+		return nil, err
+	}
+
 	hdKeyNonce := rand.Uint32()
 	if hdKeyNonce > hdkey.HardenedKeyStart {
 		hdKeyNonce -= hdkey.HardenedKeyStart
@@ -325,7 +410,7 @@ func encryptSliceData(rawData []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	encryptedData, err := encryption.EncryptAES(key.PrivateKey(), rawData, aesNonce)
+	encryptedData, err := encryption.EncryptAES(key.PrivateKey(), data, aesNonce)
 	if err != nil {
 		return nil, err
 	}
@@ -336,14 +421,14 @@ func encryptSliceData(rawData []byte) ([]byte, error) {
 		Data:       encryptedData,
 		RawSize:    uint64(len(rawData)),
 	}
-	return proto.Marshal(encryptedSlice)
+	return file.MarshalSliceEnvelope(encryptedSlice, compression, true)
 }
 
-func GetUploadFileHandler(isVideoStream bool) UploadFileHandler {
+func GetUploadFileHandler(isVideoStream bool, sliceOptions SliceOptions) UploadFileHandler {
 	if isVideoStream {
 		return UploadStreamFileHandler{}
 	}
-	return UploadRawFileHandler{}
+	return UploadRawFileHandler{SliceOptions: sliceOptions}
 }
 
 type UploadFileHandler interface {
@@ -353,7 +438,23 @@ type UploadFileHandler interface {
 type UploadStreamFileHandler struct {
 }
 
+// SliceOptions how a raw file is cut into slices before being uploaded
+type SliceOptions struct {
+	// ContentDefinedChunking cuts slices at boundaries found from the file content instead of at fixed sizes, so that
+	// the versions of a file share most of their slice boundaries. Like the others, these slices are hashed with the file
+	// hash, so they are not shared between files
+	ContentDefinedChunking bool
+	// ErasureDataSlices and ErasureParitySlices enable the erasure coding when ErasureParitySlices > 0. Every stripe of
+	// ErasureDataSlices data slices is followed by ErasureParitySlices Reed-Solomon parity slices, so that any
+	// ErasureDataSlices slices of the stripe are enough to recover its data
+	ErasureDataSlices   int
+	ErasureParitySlices int
+	// Compression is the codec compressing every slice before its encryption, "" when the slices aren't compressed
+	Compression string
+}
+
 type UploadRawFileHandler struct {
+	SliceOptions
 }
 
 func (UploadStreamFileHandler) PreUpload(ctx context.Context, filePath, encryptionTag string) (*protos.FileInfo, []*protos.SliceHashAddr, error) {
@@ -414,7 +515,7 @@ func (UploadStreamFileHandler) PreUpload(ctx context.Context, filePath, encrypti
 
 		data := rawData
 		if encryptionTag != "" {
-			data, err = encryptSliceData(rawData)
+			data, err = encryptSliceData(rawData, "")
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "Couldn't encrypt slice data")
 			}
@@ -459,7 +560,7 @@ func (UploadStreamFileHandler) PreUpload(ctx context.Context, filePath, encrypti
 	return fileInfo, slices, nil
 }
 
-func (UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionTag string) (*protos.FileInfo, []*protos.SliceHashAddr, error) {
+func (h UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionTag string) (*protos.FileInfo, []*protos.SliceHashAddr, error) {
 	info, err := file.GetFileInfo(filePath)
 	if err != nil {
 		pp.ErrorLog(ctx, "wrong filePath", err.Error())
@@ -467,47 +568,84 @@ func (UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionT
 	}
 	fileName := info.Name()
 	fileSize := uint64(info.Size())
-	fileHash := file.GetFileHash(filePath, encryptionTag)
-	sliceSize := uint64(setting.DefaultSliceBlockSize)
-	sliceCount := uint64(math.Ceil(float64(info.Size()) / float64(sliceSize)))
+	if h.ErasureParitySlices > 0 && !setting.SpSupports(setting.SpFeatureErasureLayout) {
+		pp.Log(ctx, "SP doesn't keep the erasure layout of the files, uploading the file without erasure coding")
+		h.ErasureDataSlices, h.ErasureParitySlices = 0, 0
+	}
+	if h.Compression != "" && !setting.SpSupports(setting.SpFeatureCompression) {
+		pp.Log(ctx, "SP doesn't keep the compression of the files, uploading the file without compressing it")
+		h.Compression = ""
+	}
+	fileHash := file.GetFileHash(filePath, encryptionTag, h.Compression)
 
 	metrics.UploadPerformanceLogNow(fileHash + ":RCV_CMD_START:")
 
-	var slices []*protos.SliceHashAddr
-	for sliceNumber := uint64(1); sliceNumber <= sliceCount; sliceNumber++ {
-		sliceOffset := requests.GetSliceOffset(sliceNumber, sliceCount, sliceSize, fileSize)
-
-		rawData, err := file.GetFileData(filePath, sliceOffset)
+	var sliceOffsets []*protos.SliceOffset
+	if h.ContentDefinedChunking {
+		sliceOffsets, err = file.GetContentDefinedSliceOffsets(filePath)
 		if err != nil {
-			return nil, nil, errors.New("Failed reading data from file")
+			return nil, nil, errors.Wrap(err, "failed cutting the file into slices")
+		}
+	} else {
+		sliceSize := uint64(setting.DefaultSliceBlockSize)
+		sliceCount := uint64(math.Ceil(float64(info.Size()) / float64(sliceSize)))
+		for sliceNumber := uint64(1); sliceNumber <= sliceCount; sliceNumber++ {
+			sliceOffsets = append(sliceOffsets, requests.GetSliceOffset(sliceNumber, sliceCount, sliceSize, fileSize))
+		}
+	}
 
+	// with the erasure coding, the parity slices of a stripe follow its data slices, as described by the erasure layout of
+	// the file. They are also placed after the end of the file, which tells them apart for the SPs not keeping the layout
+	var erasureLayout *protos.ErasureLayout
+	stripeSize := len(sliceOffsets)
+	if h.ErasureParitySlices > 0 {
+		erasureLayout = &protos.ErasureLayout{DataSlices: uint32(h.ErasureDataSlices), ParitySlices: uint32(h.ErasureParitySlices)}
+		stripeSize = h.ErasureDataSlices
+	}
+	parityOffset := fileSize
+	var slices []*protos.SliceHashAddr
+	for stripeStart := 0; stripeStart < len(sliceOffsets); stripeStart += stripeSize {
+		stripeEnd := stripeStart + stripeSize
+		if stripeEnd > len(sliceOffsets) {
+			stripeEnd = len(sliceOffsets)
 		}
 
-		// Encrypt slice data if required
-		data := rawData
-		if encryptionTag != "" {
-			data, err = encryptSliceData(rawData)
+		for _, sliceOffset := range sliceOffsets[stripeStart:stripeEnd] {
+			rawData, err := file.GetFileData(filePath, sliceOffset)
 			if err != nil {
-				return nil, nil, errors.Wrap(err, "Couldn't encrypt slice data")
+				return nil, nil, errors.New("Failed reading data from file")
+			}
+			slice, err := saveUploadSlice(fileHash, encryptionTag, h.Compression, uint64(len(slices)+1), rawData, sliceOffset)
+			if err != nil {
+				return nil, nil, err
 			}
+			slices = append(slices, slice)
 		}
-		sliceHash, err := crypto.CalcSliceHash(data, fileHash, sliceNumber)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "failed to calc slice hash")
+		if erasureLayout == nil {
+			continue
 		}
-		err = file.SaveTmpSliceData(fileHash, sliceHash, data)
+
+		parityPaths, err := file.EncodeErasureFileStripe(filePath, fileHash, sliceOffsets[stripeStart:stripeEnd], h.ErasureParitySlices)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "failed to save to temp file")
+			return nil, nil, errors.Wrap(err, "failed computing parity slices")
 		}
-
-		SliceHashAddr := &protos.SliceHashAddr{
-			SliceHash:   sliceHash,
-			SliceSize:   sliceOffset.SliceOffsetEnd - sliceOffset.SliceOffsetStart,
-			SliceNumber: sliceNumber,
-			SliceOffset: sliceOffset,
+		for _, parityPath := range parityPaths {
+			parityData, err := os.ReadFile(parityPath)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "failed reading parity slice")
+			}
+			_ = os.Remove(parityPath)
+			sliceOffset := &protos.SliceOffset{
+				SliceOffsetStart: parityOffset,
+				SliceOffsetEnd:   parityOffset + uint64(len(parityData)),
+			}
+			parityOffset = sliceOffset.SliceOffsetEnd
+			slice, err := saveUploadSlice(fileHash, encryptionTag, h.Compression, uint64(len(slices)+1), parityData, sliceOffset)
+			if err != nil {
+				return nil, nil, err
+			}
+			slices = append(slices, slice)
 		}
-
-		slices = append(slices, SliceHashAddr)
 	}
 
 	fileInfo := &protos.FileInfo{
@@ -517,7 +655,43 @@ func (UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionT
 		StoragePath:        filePath,
 		EncryptionTag:      encryptionTag,
 		OwnerWalletAddress: setting.WalletAddress,
+		ErasureLayout:      erasureLayout,
+		Compression:        h.Compression,
 	}
 
 	return fileInfo, slices, nil
 }
+
+// saveUploadSlice compresses and encrypts the slice if required and saves it to the tmp folder until it is uploaded
+func saveUploadSlice(fileHash, encryptionTag, compression string, sliceNumber uint64, rawData []byte, sliceOffset *protos.SliceOffset) (*protos.SliceHashAddr, error) {
+	data := rawData
+	var err error
+	switch {
+	case encryptionTag == "" && compression == "":
+	case encryptionTag == "":
+		data, err = file.SealSliceData(rawData, compression)
+		if err != nil {
+			return nil, errors.Wrap(err, "Couldn't compress slice data")
+		}
+	default:
+		data, err = encryptSliceData(rawData, compression)
+		if err != nil {
+			return nil, errors.Wrap(err, "Couldn't encrypt slice data")
+		}
+	}
+	sliceHash, err := crypto.CalcSliceHash(data, fileHash, sliceNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calc slice hash")
+	}
+	err = file.SaveTmpSliceData(fileHash, sliceHash, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save to temp file")
+	}
+
+	return &protos.SliceHashAddr{
+		SliceHash:   sliceHash,
+		SliceSize:   sliceOffset.SliceOffsetEnd - sliceOffset.SliceOffsetStart,
+		SliceNumber: sliceNumber,
+		SliceOffset: sliceOffset,
+	}, nil
+}
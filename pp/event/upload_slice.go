@@ -81,6 +81,14 @@ func GetOngoingUploadTaskCount() int {
 	return count
 }
 
+// isReceivingUploadSlice tells whether pieces of the slice of the task were already received
+func isReceivingUploadSlice(tkSlice string) bool {
+	UpRecvCostTimeMap.mux.Lock()
+	defer UpRecvCostTimeMap.mux.Unlock()
+	_, ok := UpRecvCostTimeMap.dataMap.Load(tkSlice)
+	return ok
+}
+
 // ReqUploadFileSlice storage PP receives a request with file data from the PP who initiated uploading
 func ReqUploadFileSlice(ctx context.Context, conn core.WriteCloser) {
 	costTime := core.GetRecvCostTimeFromContext(ctx)
@@ -113,6 +121,19 @@ func ReqUploadFileSlice(ctx context.Context, conn core.WriteCloser) {
 		return
 	}
 
+	// only the slices already being received are finished while draining, whatever order their pieces come in
+	tkSlice := rspUploadFile.TaskId + strconv.FormatUint(target.SliceNumber, 10)
+	if IsDraining() && !isReceivingUploadSlice(tkSlice) {
+		rsp := &protos.RspUploadFileSlice{
+			Result: &protos.Result{
+				State: protos.ResultState_RES_FAIL,
+				Msg:   drainingMsg,
+			},
+		}
+		_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, rsp, header.RspUploadFileSlice)
+		return
+	}
+
 	// spam check
 	key := rspUploadFile.TaskId + strconv.FormatInt(int64(target.SliceNumber), 10) +
 		strconv.FormatInt(int64(target.PieceOffset.SliceOffsetStart), 10) + target.P2PAddress +
@@ -148,7 +169,6 @@ func ReqUploadFileSlice(ctx context.Context, conn core.WriteCloser) {
 
 	// add up costTime
 	totalCostTime := costTime
-	tkSlice := rspUploadFile.TaskId + strconv.FormatUint(target.SliceNumber, 10)
 	UpRecvCostTimeMap.mux.Lock()
 	if val, ok := UpRecvCostTimeMap.dataMap.Load(tkSlice); ok {
 		totalCostTime += val.(int64)
@@ -193,13 +213,11 @@ func ReqUploadFileSlice(ctx context.Context, conn core.WriteCloser) {
 			utils.ErrorLog("Failed getting slice data", err.Error())
 			return
 		}
-		sliceHash, err := crypto.CalcSliceHash(sliceData, fileHash, target.SliceNumber)
-		if err != nil {
-			utils.ErrorLog("Failed to calc slice hash", err.Error())
-			return
-		}
-		if sliceHash == target.SliceHash {
+		if crypto.VerifySliceHash(sliceData, fileHash, target.SliceNumber, target.SliceHash) {
 			_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, requests.RspUploadFileSliceData(ctx, &target), header.RspUploadFileSlice)
+			if err = file.CommitSliceData(target.SliceHash, fileHash, target.SliceNumber, target.RspUploadFile.OwnerWalletAddress); err != nil {
+				utils.ErrorLog("Failed committing the slice", err)
+			}
 			// report upload result to SP
 			newSlice.SliceHash = target.SliceHash
 			_, newCtx := p2pserver.CreateNewContextPacketId(ctx)
@@ -269,6 +287,10 @@ func RspUploadFileSlice(ctx context.Context, conn core.WriteCloser) {
 				return
 			}
 			fileTask.Touch()
+			task.RecordPeerSuccess(target.Slice.PpInfo.P2PAddress, time.Duration(ctStat.TotalCostTime)*time.Millisecond)
+			if err := task.SaveUploadJournalSlice(fileTask, target.SliceHash, task.SLICE_STATUS_FINISHED); err != nil {
+				utils.DebugLog("failed saving upload journal,", err.Error())
+			}
 			p := fileTask.GetUploadProgress()
 			pp.Logf(ctx, "fileHash: %v  uploaded：%.2f %% ", target.FileHash, p)
 			setting.ShowProgress(ctx, p)
@@ -378,13 +400,11 @@ func ReqBackupFileSlice(ctx context.Context, conn core.WriteCloser) {
 			utils.ErrorLog("Failed getting slice data", err.Error())
 			return
 		}
-		sliceHash, err := crypto.CalcSliceHash(sliceData, fileHash, target.SliceNumber)
-		if err != nil {
-			utils.ErrorLog("Failed to calc slice hash", err)
-			return
-		}
-		if sliceHash == target.SliceHash {
+		if crypto.VerifySliceHash(sliceData, fileHash, target.SliceNumber, target.SliceHash) {
 			_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, requests.RspBackupFileSliceData(&target), header.RspBackupFileSlice)
+			if err = file.CommitSliceData(target.SliceHash, fileHash, target.SliceNumber, target.WalletAddress); err != nil {
+				utils.ErrorLog("Failed committing the slice", err)
+			}
 			// report upload result to SP
 
 			_, newCtx := p2pserver.CreateNewContextPacketId(ctx)
@@ -486,6 +506,9 @@ func RspUploadSlicesWrong(ctx context.Context, _ core.WriteCloser) {
 	uploadTask.UpdateSliceDestinationsForRetry(rspUploadFile.Slices)
 
 	uploadTask.SetRspUploadFile(target.RspUploadFile)
+	if err := task.SaveUploadJournal(uploadTask); err != nil {
+		pp.ErrorLog(ctx, "failed saving upload journal", err)
+	}
 	// Start upload for all new destinations
 	uploadTask.SignalNewDestinations(ctx)
 }
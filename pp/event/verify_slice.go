@@ -42,6 +42,12 @@ func NoticeFileSliceVerify(ctx context.Context, conn core.WriteCloser) {
 		AlreadySize:        uint64(0),
 		LastTouchTime:      time.Now().Unix(),
 	}
+	if IsDraining() {
+		// the SP picks another node for the verification
+		utils.DebugLog("Refusing verify notice because " + drainingMsg)
+		reportVerifyResult(ctx, target.TaskId, target.SpP2PAddress, tTask, false, 0)
+		return
+	}
 	task.AddVerifyTask(target.TaskId, target.SliceStorageInfo.SliceHash, tTask)
 	p2pserver.GetP2pServer(ctx).SendMessageToPPServ(ctx, target.PpInfo.NetworkAddress, requests.ReqVerifyDownloadData(ctx, target), nil, nil, header.MsgType{Id: 0, Name: ""})
 }
@@ -55,8 +61,23 @@ func ReqVerifyDownload(ctx context.Context, conn core.WriteCloser) {
 	if !requests.UnmarshalData(ctx, &target) {
 		return
 	}
-	setWriteHookForRspTransferSlice(conn)
 	noticeVerify := target.NoticeFileSliceVerify
+	if IsDraining() {
+		rsp := &protos.RspVerifyDownload{
+			TaskId:       noticeVerify.TaskId,
+			SpP2PAddress: noticeVerify.SpP2PAddress,
+			SliceHash:    noticeVerify.SliceStorageInfo.SliceHash,
+			P2PAddress:   p2pserver.GetP2pServer(ctx).GetP2PAddress().String(),
+			SliceNumber:  noticeVerify.SliceNumber,
+			Result: &protos.Result{
+				State: protos.ResultState_RES_FAIL,
+				Msg:   drainingMsg,
+			},
+		}
+		_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, rsp, header.RspVerifyDownload)
+		return
+	}
+	setWriteHookForRspTransferSlice(conn)
 
 	tTask := task.VerifyTask{
 		IsReceiver:         false,
@@ -68,6 +89,7 @@ func ReqVerifyDownload(ctx context.Context, conn core.WriteCloser) {
 		ReceiverP2pAddress: target.NewPp.P2PAddress,
 		SpP2pAddress:       noticeVerify.SpP2PAddress,
 		AlreadySize:        uint64(0),
+		LastTouchTime:      time.Now().Unix(),
 	}
 	task.AddVerifyTask(noticeVerify.TaskId, noticeVerify.SliceStorageInfo.SliceHash, tTask)
 	sliceHash := noticeVerify.SliceStorageInfo.SliceHash
@@ -120,6 +142,8 @@ func RspVerifyDownload(ctx context.Context, conn core.WriteCloser) {
 	}
 	if target.Result != nil && target.Result.State == protos.ResultState_RES_FAIL {
 		utils.ErrorLog("received failed transfer download,", target.Result.Msg)
+		SendReportVerifyResult(ctx, target.TaskId, target.SliceHash, target.SpP2PAddress, false, 0)
+		task.CleanVerifyTask(target.TaskId, target.SliceHash)
 		return
 	}
 	if target.Data == nil {
@@ -140,6 +164,7 @@ func RspVerifyDownload(ctx context.Context, conn core.WriteCloser) {
 
 	// data is received
 	SendReportVerifyResult(ctx, target.TaskId, target.SliceHash, target.SpP2PAddress, true, target.SliceSize)
+	task.CleanVerifyTask(target.TaskId, target.SliceHash)
 
 	_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, requests.RspVerifyDownloadResultData(target.TaskId, target.SliceHash, target.SpP2PAddress), header.RspVerifyDownloadResult)
 }
@@ -150,6 +175,10 @@ func SendReportVerifyResult(ctx context.Context, taskId, sliceHash, spP2pAddress
 		utils.ErrorLog("Transfer/backup task is already removed.")
 		return
 	}
+	reportVerifyResult(ctx, taskId, spP2pAddress, tTask, result, sliceSize)
+}
+
+func reportVerifyResult(ctx context.Context, taskId, spP2pAddress string, tTask task.VerifyTask, result bool, sliceSize uint64) {
 	opponentP2PAddress := tTask.PpInfo.P2PAddress
 	if !tTask.IsReceiver {
 		opponentP2PAddress = tTask.ReceiverP2pAddress
@@ -181,4 +210,5 @@ func RspVerifyDownloadResult(ctx context.Context, conn core.WriteCloser) {
 	if !requests.UnmarshalData(ctx, &target) {
 		return
 	}
+	task.CleanVerifyTask(target.TaskId, target.SliceHash)
 }
@@ -0,0 +1,108 @@
+package file
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	// the gear hash must match one of these masks at a slice boundary. The stricter mask is used below the average size
+	// and the looser one above it (FastCDC normalized chunking), so that slice sizes gather around the average
+	cdcMaskS = uint64(1<<25-1) << (64 - 25)
+	cdcMaskL = uint64(1<<21-1) << (64 - 21)
+
+	cdcGearSeed = 0x5d5f_7e2c_a4f1_e2b3
+)
+
+// gearTable maps every byte to a random value for the rolling gear hash. The values are derived from a fixed seed and
+// must never change, otherwise the slice boundaries of files already uploaded could not be found again
+var gearTable [256]uint64
+
+func init() {
+	// splitmix64
+	x := uint64(cdcGearSeed)
+	for i := range gearTable {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gearTable[i] = z ^ (z >> 31)
+	}
+}
+
+// ContentDefinedCut returns the size of the slice starting at data[0]. The boundary only depends on the bytes around
+// it, so an insertion or a deletion in a file moves the boundaries next to it and leaves the others in place.
+// data must hold setting.MaxSliceSize bytes, or all the remaining bytes of the file when it is shorter
+func ContentDefinedCut(data []byte) int {
+	n := len(data)
+	if n <= setting.CdcMinSliceSize {
+		return n
+	}
+	if n > setting.MaxSliceSize {
+		n = setting.MaxSliceSize
+	}
+	normal := setting.CdcAvgSliceSize
+	if n < normal {
+		normal = n
+	}
+
+	var fp uint64
+	i := setting.CdcMinSliceSize
+	for ; i < normal; i++ {
+		fp = (fp << 1) + gearTable[data[i]]
+		if fp&cdcMaskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		fp = (fp << 1) + gearTable[data[i]]
+		if fp&cdcMaskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
+
+// GetContentDefinedSliceOffsets cuts the file into slices at content defined boundaries
+func GetContentDefinedSliceOffsets(filePath string) ([]*protos.SliceOffset, error) {
+	fin, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening file")
+	}
+	defer func() {
+		_ = fin.Close()
+	}()
+
+	var offsets []*protos.SliceOffset
+	buf := make([]byte, setting.MaxSliceSize)
+	filled := 0
+	eof := false
+	start := uint64(0)
+	for {
+		if !eof {
+			n, err := io.ReadFull(fin, buf[filled:])
+			filled += n
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				eof = true
+			} else if err != nil {
+				return nil, errors.Wrap(err, "failed reading file")
+			}
+		}
+		if filled == 0 {
+			return offsets, nil
+		}
+
+		cut := ContentDefinedCut(buf[:filled])
+		offsets = append(offsets, &protos.SliceOffset{
+			SliceOffsetStart: start,
+			SliceOffsetEnd:   start + uint64(cut),
+		})
+		start += uint64(cut)
+		filled = copy(buf, buf[cut:filled])
+	}
+}
@@ -0,0 +1,63 @@
+package file
+
+import (
+	"crypto/sha256"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func sliceDigests(t *testing.T, data []byte) map[[32]byte]bool {
+	filePath := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	offsets, err := GetContentDefinedSliceOffsets(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digests := make(map[[32]byte]bool)
+	expectedStart := uint64(0)
+	for i, offset := range offsets {
+		size := offset.SliceOffsetEnd - offset.SliceOffsetStart
+		if offset.SliceOffsetStart != expectedStart {
+			t.Fatalf("slice %v starts at %v, expected %v", i, offset.SliceOffsetStart, expectedStart)
+		}
+		if size > setting.MaxSliceSize || (size < setting.CdcMinSliceSize && i != len(offsets)-1) {
+			t.Fatalf("slice %v has an invalid size %v", i, size)
+		}
+		digests[sha256.Sum256(data[offset.SliceOffsetStart:offset.SliceOffsetEnd])] = true
+		expectedStart = offset.SliceOffsetEnd
+	}
+	if expectedStart != uint64(len(data)) {
+		t.Fatalf("slices cover %v bytes, expected %v", expectedStart, len(data))
+	}
+	return digests
+}
+
+func TestContentDefinedChunkingKeepsBoundaries(t *testing.T) {
+	data := make([]byte, 6*setting.MaxSliceSize)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	edited := make([]byte, 0, len(data)+1)
+	edited = append(edited, data[:1000]...)
+	edited = append(edited, 'x')
+	edited = append(edited, data[1000:]...)
+
+	original := sliceDigests(t, data)
+	modified := sliceDigests(t, edited)
+	shared := 0
+	for digest := range modified {
+		if original[digest] {
+			shared++
+		}
+	}
+	// only the slice holding the inserted byte changes
+	if shared < len(original)-1 {
+		t.Fatalf("only %v of %v slices are shared after a one byte insertion", shared, len(original))
+	}
+}
@@ -37,6 +37,18 @@ func clearAllCaches(ctx context.Context) func() {
 		clearTmpSlices(ctx)
 		clearTmpDownloadCaches(ctx)
 		clearTmpDownloadVideo(ctx)
+		compactSliceStore()
+	}
+}
+
+// compactSliceStore reclaims the space of the deleted slices, for the stores that need it
+func compactSliceStore() {
+	store, err := getSliceStore()
+	if err != nil {
+		return
+	}
+	if err = store.Compact(); err != nil {
+		utils.ErrorLog("failed compacting the slice store", err)
 	}
 }
 
@@ -0,0 +1,88 @@
+package file
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DIRECTORY_MANIFEST_VERSION = 2 // version 2 adds the empty directories
+	DIRECTORY_MANIFEST_SUFFIX  = ".manifest.json"
+)
+
+// DirectoryManifest describes a directory uploaded with "put -r". It is uploaded as a file of its own, and its file hash
+// identifies the whole tree
+type DirectoryManifest struct {
+	Version int                       `json:"version"`
+	Name    string                    `json:"name"`
+	Files   []*DirectoryManifestEntry `json:"files"`
+}
+
+// DirectoryManifestEntry is one file of an uploaded directory, or an empty directory. Empty files have no file hash
+type DirectoryManifestEntry struct {
+	Path     string `json:"path"` // slash separated, relative to the root of the directory
+	IsDir    bool   `json:"is_dir,omitempty"`
+	FileHash string `json:"file_hash,omitempty"`
+	FileSize uint64 `json:"file_size"`
+}
+
+// GetTmpManifestPath path to the manifest generated for the upload of a directory. Each upload has its own folder, so
+// that directories with the same name don't share their manifest
+func GetTmpManifestPath(dirName, uploadId string) string {
+	return filepath.Join(getTmpFolderPath(), "manifest", uploadId, dirName+DIRECTORY_MANIFEST_SUFFIX)
+}
+
+// SaveDirectoryManifest writes the manifest to the tmp folder and returns its path
+func SaveDirectoryManifest(manifest *DirectoryManifest, uploadId string) (string, error) {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "failed marshalling directory manifest")
+	}
+	manifestPath := GetTmpManifestPath(manifest.Name, uploadId)
+	if err = os.MkdirAll(filepath.Dir(manifestPath), os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed creating manifest folder")
+	}
+	if err = os.WriteFile(manifestPath, data, 0600); err != nil {
+		return "", errors.Wrap(err, "failed writing directory manifest")
+	}
+	return manifestPath, nil
+}
+
+// ReadDirectoryManifest parses a downloaded manifest and rejects any entry which would be written outside the destination
+func ReadDirectoryManifest(manifestPath string) (*DirectoryManifest, error) {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading directory manifest")
+	}
+	manifest := &DirectoryManifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "the file is not a directory manifest")
+	}
+	if manifest.Version < 1 || manifest.Version > DIRECTORY_MANIFEST_VERSION {
+		return nil, errors.Errorf("unsupported directory manifest version %v", manifest.Version)
+	}
+	for _, entry := range manifest.Files {
+		cleaned := path.Clean(entry.Path)
+		if entry.Path == "" || cleaned == "." || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") ||
+			strings.Contains(entry.Path, "\\") {
+			return nil, errors.Errorf("invalid path %v in directory manifest", entry.Path)
+		}
+		entry.Path = cleaned
+	}
+	return manifest, nil
+}
+
+// PlaceDownloadedFile copies a downloaded file to every path of the directory holding the same content, then removes it
+func PlaceDownloadedFile(srcPath string, targetPaths []string) error {
+	for _, targetPath := range targetPaths {
+		if _, err := copyFile(srcPath, targetPath); err != nil {
+			return errors.Wrapf(err, "failed copying the file to %v", targetPath)
+		}
+	}
+	return os.Remove(srcPath)
+}
@@ -0,0 +1,33 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadDirectoryManifest(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "dir"+DIRECTORY_MANIFEST_SUFFIX)
+	read := func(files string) error {
+		data := `{"version":2,"name":"dir","files":[` + files + `]}`
+		if err := os.WriteFile(manifestPath, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+		_, err := ReadDirectoryManifest(manifestPath)
+		return err
+	}
+
+	// the paths are escaped for JSON
+	for _, path := range []string{"", ".", "..", "../a", "a/../../b", "/etc/passwd", `a\\..\\b`} {
+		if err := read(`{"path":"` + path + `"}`); err == nil {
+			t.Fatalf("the path %q should be rejected", path)
+		}
+	}
+	if err := read(`{"path":"a/./b/../c","file_hash":"h","file_size":1},{"path":"empty","is_dir":true}`); err != nil {
+		t.Fatal(err)
+	}
+	manifest, _ := ReadDirectoryManifest(manifestPath)
+	if manifest.Files[0].Path != "a/c" || !manifest.Files[1].IsDir {
+		t.Fatalf("wrong manifest %+v", manifest.Files)
+	}
+}
@@ -0,0 +1,171 @@
+package file
+
+import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	COMPRESSION_NONE = "none"
+	COMPRESSION_ZSTD = "zstd"
+
+	// fields appended to the EncryptedSlice message. Peers which don't know them skip them when parsing the envelope
+	envelopeCompressionField protowire.Number = 100
+	envelopePlainField       protowire.Number = 101
+)
+
+var (
+	zstdEncoder     *zstd.Encoder
+	zstdDecoder     *zstd.Decoder
+	zstdEncoderOnce sync.Once
+	zstdDecoderOnce sync.Once
+)
+
+// CheckCompression checks the name of a compression codec given by the user. It returns the codec to record in the
+// slice options, "" meaning no compression
+func CheckCompression(codec string) (string, error) {
+	switch codec {
+	case "", COMPRESSION_NONE:
+		return "", nil
+	case COMPRESSION_ZSTD:
+		return codec, nil
+	default:
+		return "", errors.Errorf("unsupported compression %v, should be %v or %v", codec, COMPRESSION_ZSTD, COMPRESSION_NONE)
+	}
+}
+
+// FileHashTag returns the tag mixed into the file hash. The compression codec is part of it, so that the compressed and
+// the plain uploads of a file are different files. The hash of an uncompressed file is unchanged
+func FileHashTag(encryptionTag, compression string) string {
+	if compression == "" {
+		return encryptionTag
+	}
+	return encryptionTag + "~" + compression
+}
+
+// HasSliceEnvelope tells whether the slices of a file are wrapped into an envelope, i.e. encrypted or compressed
+func HasSliceEnvelope(encryptionTag, compression string) bool {
+	return encryptionTag != "" || compression != ""
+}
+
+// CompressSliceData compresses the data of a slice with the codec. The data is kept as it is when it doesn't shrink,
+// the codec actually applied is returned
+func CompressSliceData(rawData []byte, codec string) ([]byte, string, error) {
+	switch codec {
+	case "", COMPRESSION_NONE:
+		return rawData, "", nil
+	case COMPRESSION_ZSTD:
+		zstdEncoderOnce.Do(func() {
+			zstdEncoder, _ = zstd.NewWriter(nil)
+		})
+		if zstdEncoder == nil {
+			return nil, "", errors.New("failed creating zstd encoder")
+		}
+		compressed := zstdEncoder.EncodeAll(rawData, make([]byte, 0, len(rawData)))
+		if len(compressed) >= len(rawData) {
+			return rawData, "", nil
+		}
+		return compressed, codec, nil
+	default:
+		return nil, "", errors.Errorf("unsupported compression %v", codec)
+	}
+}
+
+// DecompressSliceData reverts CompressSliceData. rawSize is the size of the slice before its compression
+func DecompressSliceData(data []byte, codec string, rawSize uint64) ([]byte, error) {
+	switch codec {
+	case "":
+		return data, nil
+	case COMPRESSION_ZSTD:
+		if rawSize > setting.MaxSliceSize {
+			return nil, errors.Errorf("invalid raw slice size %v", rawSize)
+		}
+		zstdDecoderOnce.Do(func() {
+			zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(setting.MaxSliceSize))
+		})
+		if zstdDecoder == nil {
+			return nil, errors.New("failed creating zstd decoder")
+		}
+		rawData, err := zstdDecoder.DecodeAll(data, make([]byte, 0, rawSize))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed decompressing slice")
+		}
+		if uint64(len(rawData)) != rawSize {
+			return nil, errors.Errorf("decompressed slice has %v bytes, expected %v", len(rawData), rawSize)
+		}
+		return rawData, nil
+	default:
+		return nil, errors.Errorf("unsupported compression %v", codec)
+	}
+}
+
+// MarshalSliceEnvelope wraps a slice stored by a file having an encryption tag. The envelope is an EncryptedSlice,
+// followed by the compression codec of the data and whether the data is left unencrypted. An encrypted and
+// uncompressed slice is marshalled exactly like an EncryptedSlice
+func MarshalSliceEnvelope(slice *protos.EncryptedSlice, compression string, encrypted bool) ([]byte, error) {
+	data, err := proto.Marshal(slice)
+	if err != nil {
+		return nil, err
+	}
+	if compression != "" {
+		data = protowire.AppendTag(data, envelopeCompressionField, protowire.BytesType)
+		data = protowire.AppendString(data, compression)
+	}
+	if !encrypted {
+		data = protowire.AppendTag(data, envelopePlainField, protowire.VarintType)
+		data = protowire.AppendVarint(data, 1)
+	}
+	return data, nil
+}
+
+// UnmarshalSliceEnvelope parses a slice envelope written by MarshalSliceEnvelope
+func UnmarshalSliceEnvelope(data []byte) (slice *protos.EncryptedSlice, compression string, encrypted bool, err error) {
+	slice = &protos.EncryptedSlice{}
+	if err = proto.Unmarshal(data, slice); err != nil {
+		return nil, "", false, errors.Wrap(err, "failed unmarshalling slice envelope")
+	}
+
+	encrypted = true
+	unknown := slice.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return nil, "", false, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+		switch {
+		case num == envelopeCompressionField && typ == protowire.BytesType:
+			compression, n = protowire.ConsumeString(unknown)
+		case num == envelopePlainField && typ == protowire.VarintType:
+			var plain uint64
+			plain, n = protowire.ConsumeVarint(unknown)
+			encrypted = plain == 0
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, unknown)
+		}
+		if n < 0 {
+			return nil, "", false, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+	}
+	return slice, compression, encrypted, nil
+}
+
+// SealSliceData compresses a slice and wraps it into an envelope without encrypting it
+func SealSliceData(rawData []byte, compression string) ([]byte, error) {
+	data, compression, err := CompressSliceData(rawData, compression)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalSliceEnvelope(&protos.EncryptedSlice{
+		Data:    data,
+		RawSize: uint64(len(rawData)),
+	}, compression, false)
+}
@@ -0,0 +1,64 @@
+package file
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestCompressSliceData(t *testing.T) {
+	rawData := bytes.Repeat([]byte("compressible slice "), 1000)
+	data, codec, err := CompressSliceData(rawData, COMPRESSION_ZSTD)
+	if err != nil || codec != COMPRESSION_ZSTD || len(data) >= len(rawData) {
+		t.Fatalf("the slice should be compressed, codec %q, %v bytes, %v", codec, len(data), err)
+	}
+	decompressed, err := DecompressSliceData(data, codec, uint64(len(rawData)))
+	if err != nil || !bytes.Equal(decompressed, rawData) {
+		t.Fatal("wrong decompressed slice", err)
+	}
+	if _, err = DecompressSliceData(data, codec, uint64(len(rawData))-1); err == nil {
+		t.Fatal("a wrong raw size should be rejected")
+	}
+
+	// data which doesn't shrink is kept as it is
+	if data, codec, _ = CompressSliceData([]byte{1, 2, 3}, COMPRESSION_ZSTD); codec != "" || !bytes.Equal(data, []byte{1, 2, 3}) {
+		t.Fatal("the slice shouldn't be compressed")
+	}
+}
+
+func TestSliceEnvelope(t *testing.T) {
+	slice := &protos.EncryptedSlice{HdkeyNonce: 1, AesNonce: 2, Data: []byte("data"), RawSize: 10}
+	for _, c := range []struct {
+		compression string
+		encrypted   bool
+	}{{"", true}, {COMPRESSION_ZSTD, true}, {COMPRESSION_ZSTD, false}, {"", false}} {
+		envelope, err := MarshalSliceEnvelope(slice, c.compression, c.encrypted)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opened, compression, encrypted, err := UnmarshalSliceEnvelope(envelope)
+		if err != nil || opened.HdkeyNonce != slice.HdkeyNonce || opened.AesNonce != slice.AesNonce || opened.RawSize != slice.RawSize ||
+			!bytes.Equal(opened.Data, slice.Data) || compression != c.compression || encrypted != c.encrypted {
+			t.Fatalf("wrong envelope %+v, compression %q, encrypted %v, %v", c, compression, encrypted, err)
+		}
+	}
+
+	// an encrypted slice without compression is still a plain EncryptedSlice for the peers not knowing envelopes
+	envelope, _ := MarshalSliceEnvelope(slice, "", true)
+	legacy, _ := proto.Marshal(slice)
+	if !bytes.Equal(envelope, legacy) {
+		t.Fatal("the envelope of an uncompressed encrypted slice should be an EncryptedSlice")
+	}
+}
+
+func TestFileHashTag(t *testing.T) {
+	if FileHashTag("tag", "") != "tag" || FileHashTag("", "") != "" {
+		t.Fatal("the tag of an uncompressed file should be unchanged")
+	}
+	if FileHashTag("", COMPRESSION_ZSTD) == "" || FileHashTag("tag", COMPRESSION_ZSTD) == "tag" {
+		t.Fatal("the compression should be part of the file hash")
+	}
+}
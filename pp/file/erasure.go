@@ -0,0 +1,289 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	ERASURE_MAX_DATA_SLICES   = 16
+	ERASURE_MAX_PARITY_SLICES = 16
+	// ERASURE_ENCODE_CHUNK the size of the columns of a stripe encoded at once. The encoder only holds a column of the
+	// stripe in memory, not its whole data
+	ERASURE_ENCODE_CHUNK = 1 << 20
+)
+
+// Reed-Solomon code over GF(2^8). The parity slices are computed with a Cauchy matrix, so that any k of the k data and
+// m parity slices of a stripe are enough to recover the k data slices
+var (
+	gfExp [510]byte
+	gfLog [256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfLog[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+	for i := 255; i < len(gfExp); i++ {
+		gfExp[i] = gfExp[i-255]
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfInv(a byte) byte {
+	return gfExp[255-int(gfLog[a])]
+}
+
+// erasureCoefficients the row of the encoding matrix which computes the parity slice i from the data slices
+func erasureCoefficients(dataCount, parityIndex int) []byte {
+	row := make([]byte, dataCount)
+	for j := range row {
+		row[j] = gfInv(byte(dataCount+parityIndex) ^ byte(j))
+	}
+	return row
+}
+
+// mulAdd adds coefficient * in to out
+func mulAdd(coefficient byte, in, out []byte) {
+	if coefficient == 0 {
+		return
+	}
+	var table [256]byte
+	for i := range table {
+		table[i] = gfMul(coefficient, byte(i))
+	}
+	for i, b := range in {
+		out[i] ^= table[b]
+	}
+}
+
+func checkErasureShape(dataCount, parityCount int) error {
+	if dataCount < 1 || dataCount > ERASURE_MAX_DATA_SLICES {
+		return errors.Errorf("the number of data slices of a stripe should be between 1 and %v", ERASURE_MAX_DATA_SLICES)
+	}
+	if parityCount < 1 || parityCount > ERASURE_MAX_PARITY_SLICES {
+		return errors.Errorf("the number of parity slices of a stripe should be between 1 and %v", ERASURE_MAX_PARITY_SLICES)
+	}
+	return nil
+}
+
+// EncodeErasureParity computes the parity slices of a stripe. The parity slices have the size of the largest data slice,
+// the shorter data slices are considered as padded with zeros
+func EncodeErasureParity(data [][]byte, parityCount int) ([][]byte, error) {
+	if err := checkErasureShape(len(data), parityCount); err != nil {
+		return nil, err
+	}
+	shardSize := 0
+	for _, d := range data {
+		if len(d) > shardSize {
+			shardSize = len(d)
+		}
+	}
+
+	parity := make([][]byte, parityCount)
+	for i := range parity {
+		parity[i] = make([]byte, shardSize)
+		for j, coefficient := range erasureCoefficients(len(data), i) {
+			mulAdd(coefficient, data[j], parity[i])
+		}
+	}
+	return parity, nil
+}
+
+// EncodeErasureFileStripe computes the parity slices of the stripe made of the given data slices of the file, one column
+// of ERASURE_ENCODE_CHUNK bytes at a time. The parity slices are written to the tmp folder of the file hash, the caller
+// removes them once uploaded
+func EncodeErasureFileStripe(filePath, fileHash string, dataOffsets []*protos.SliceOffset, parityCount int) ([]string, error) {
+	if err := checkErasureShape(len(dataOffsets), parityCount); err != nil {
+		return nil, err
+	}
+	shardSize := uint64(0)
+	for _, offset := range dataOffsets {
+		if size := offset.SliceOffsetEnd - offset.SliceOffsetStart; size > shardSize {
+			shardSize = size
+		}
+	}
+
+	fin, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening file")
+	}
+	defer func() {
+		_ = fin.Close()
+	}()
+
+	folder := GetTmpFileFolderPath(fileHash)
+	if err = os.MkdirAll(folder, os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "failed creating tmp folder")
+	}
+	parityPaths := make([]string, parityCount)
+	parityFiles := make([]*os.File, parityCount)
+	defer func() {
+		for _, f := range parityFiles {
+			if f != nil {
+				_ = f.Close()
+			}
+		}
+	}()
+	for i := range parityFiles {
+		parityPaths[i] = filepath.Join(folder, fmt.Sprintf("parity_%v", i))
+		if parityFiles[i], err = os.Create(parityPaths[i]); err != nil {
+			return nil, errors.Wrap(err, "failed creating parity slice")
+		}
+	}
+
+	column := make([][]byte, len(dataOffsets))
+	for start := uint64(0); start < shardSize; start += ERASURE_ENCODE_CHUNK {
+		for j, offset := range dataOffsets {
+			size := offset.SliceOffsetEnd - offset.SliceOffsetStart
+			column[j] = column[j][:0]
+			if start >= size {
+				continue
+			}
+			end := start + ERASURE_ENCODE_CHUNK
+			if end > size {
+				end = size
+			}
+			if uint64(cap(column[j])) < end-start {
+				column[j] = make([]byte, end-start)
+			}
+			column[j] = column[j][:end-start]
+			if _, err = fin.ReadAt(column[j], int64(offset.SliceOffsetStart+start)); err != nil {
+				return nil, errors.Wrap(err, "failed reading data from file")
+			}
+		}
+		parity, err := EncodeErasureParity(column, parityCount)
+		if err != nil {
+			return nil, err
+		}
+		for i, p := range parity {
+			if _, err = parityFiles[i].Write(p); err != nil {
+				return nil, errors.Wrap(err, "failed writing parity slice")
+			}
+		}
+	}
+	return parityPaths, nil
+}
+
+// ReconstructErasureData recovers the missing (nil) data slices of a stripe from the remaining data and parity slices.
+// dataSizes holds the size of every data slice of the stripe
+func ReconstructErasureData(data, parity [][]byte, dataSizes []uint64) error {
+	dataCount := len(data)
+	if err := checkErasureShape(dataCount, len(parity)); err != nil {
+		return err
+	}
+	if len(dataSizes) != dataCount {
+		return errors.New("wrong number of data slice sizes")
+	}
+
+	// pick dataCount available slices, each one giving a row of the encoding matrix
+	shardSize := 0
+	var rows [][]byte
+	var shards [][]byte
+	for j, d := range data {
+		if d == nil {
+			continue
+		}
+		row := make([]byte, dataCount)
+		row[j] = 1
+		rows = append(rows, row)
+		shards = append(shards, d)
+	}
+	for i, p := range parity {
+		if p == nil || len(rows) == dataCount {
+			continue
+		}
+		if shardSize != 0 && len(p) != shardSize {
+			return errors.New("the parity slices of a stripe should have the same size")
+		}
+		shardSize = len(p)
+		rows = append(rows, erasureCoefficients(dataCount, i))
+		shards = append(shards, p)
+	}
+	if len(rows) < dataCount {
+		return errors.Errorf("%v slices are needed to recover the stripe, only %v are available", dataCount, len(rows))
+	}
+	if len(rows) == len(data) && shardSize == 0 {
+		return nil // nothing is missing
+	}
+	for j, size := range dataSizes {
+		if size > uint64(shardSize) || (data[j] != nil && uint64(len(data[j])) != size) {
+			return errors.New("the size of a data slice doesn't match the parity slices")
+		}
+	}
+
+	inverse, err := invertMatrix(rows)
+	if err != nil {
+		return err
+	}
+	for j := range data {
+		if data[j] != nil {
+			continue
+		}
+		recovered := make([]byte, shardSize)
+		for r, shard := range shards {
+			mulAdd(inverse[j][r], shard, recovered)
+		}
+		data[j] = recovered[:dataSizes[j]]
+	}
+	return nil
+}
+
+// invertMatrix inverts a square matrix over GF(2^8) with the Gauss-Jordan elimination
+func invertMatrix(matrix [][]byte) ([][]byte, error) {
+	n := len(matrix)
+	work := make([][]byte, n)
+	for i := range matrix {
+		work[i] = make([]byte, 2*n)
+		copy(work[i], matrix[i])
+		work[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && work[pivot][col] == 0 {
+			pivot++
+		}
+		if pivot == n {
+			return nil, errors.New("singular matrix")
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+
+		scale := gfInv(work[col][col])
+		for k := range work[col] {
+			work[col][k] = gfMul(work[col][k], scale)
+		}
+		for r := 0; r < n; r++ {
+			if r == col || work[r][col] == 0 {
+				continue
+			}
+			factor := work[r][col]
+			for k := range work[r] {
+				work[r][k] ^= gfMul(factor, work[col][k])
+			}
+		}
+	}
+
+	inverse := make([][]byte, n)
+	for i := range work {
+		inverse[i] = work[i][n:]
+	}
+	return inverse, nil
+}
@@ -0,0 +1,87 @@
+package file
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestErasureReconstruct(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sizes := []uint64{1000, 1000, 1000, 617}
+	data := make([][]byte, len(sizes))
+	for j, size := range sizes {
+		data[j] = make([]byte, size)
+		r.Read(data[j])
+	}
+	parity, err := EncodeErasureParity(data, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every combination of 2 lost slices out of the 6 slices of the stripe
+	for lost1 := 0; lost1 < 6; lost1++ {
+		for lost2 := lost1 + 1; lost2 < 6; lost2++ {
+			received := append([][]byte{}, data...)
+			receivedParity := append([][]byte{}, parity...)
+			for _, lost := range []int{lost1, lost2} {
+				if lost < len(data) {
+					received[lost] = nil
+				} else {
+					receivedParity[lost-len(data)] = nil
+				}
+			}
+			if err = ReconstructErasureData(received, receivedParity, sizes); err != nil {
+				t.Fatalf("slices %v and %v lost: %v", lost1, lost2, err)
+			}
+			for j := range data {
+				if !bytes.Equal(received[j], data[j]) {
+					t.Fatalf("slices %v and %v lost: data slice %v wasn't recovered", lost1, lost2, j)
+				}
+			}
+		}
+	}
+
+	received := [][]byte{nil, nil, nil, data[3]}
+	if err = ReconstructErasureData(received, parity, sizes); err == nil {
+		t.Fatal("3 lost slices shouldn't be recoverable with 2 parity slices")
+	}
+}
+
+func TestEncodeErasureFileStripe(t *testing.T) {
+	setting.SetupRoot(t.TempDir())
+	r := rand.New(rand.NewSource(1))
+	// the slices span several encoding columns, the last one is shorter
+	sizes := []uint64{2*ERASURE_ENCODE_CHUNK + 10, ERASURE_ENCODE_CHUNK + 3}
+	var content []byte
+	var data [][]byte
+	var offsets []*protos.SliceOffset
+	for _, size := range sizes {
+		d := make([]byte, size)
+		r.Read(d)
+		offsets = append(offsets, &protos.SliceOffset{SliceOffsetStart: uint64(len(content)), SliceOffsetEnd: uint64(len(content)) + size})
+		content = append(content, d...)
+		data = append(data, d)
+	}
+	filePath := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(filePath, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	parityPaths, err := EncodeErasureFileStripe(filePath, "file1", offsets, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parity, _ := EncodeErasureParity(data, 2)
+	for i, parityPath := range parityPaths {
+		written, _ := os.ReadFile(parityPath)
+		if !bytes.Equal(written, parity[i]) {
+			t.Fatalf("wrong parity slice %v", i)
+		}
+	}
+}
@@ -100,8 +100,8 @@ func GetFileSuffix(fileName string) string {
 	return fileSuffix
 }
 
-func GetFileHash(filePath, encryptionTag string) string {
-	filehash, err := crypto.CalcFileHash(filePath, encryptionTag, crypto.SDS_CODEC)
+func GetFileHash(filePath, encryptionTag, compression string) string {
+	filehash, err := crypto.CalcFileHash(filePath, FileHashTag(encryptionTag, compression), crypto.SDS_CODEC)
 	if err != nil {
 		utils.ErrorLog(err)
 	}
@@ -124,6 +124,11 @@ func GetFilePath(hash string) string {
 	return fileMap[hash]
 }
 
+// SetFilePath records the local path of a file, e.g. when an upload task is restored from its journal
+func SetFilePath(hash, filePath string) {
+	fileMap[hash] = filePath
+}
+
 func ClearFileMap(hash string) {
 	delete(fileMap, hash)
 }
@@ -183,29 +188,29 @@ func GetSliceDataFromTmp(fileHash, sliceHash string) ([]byte, error) {
 }
 
 func ReadSliceData(fileHash, sliceHash string) (int64, [][]byte, error) {
-
-	slicePath, err := getSlicePath(sliceHash)
+	store, err := getSliceStore()
 	if err != nil {
 		return 0, nil, err
 	}
+	size, buffer, err := store.Read(sliceHash)
+	if err == nil {
+		return size, buffer, nil
+	}
+
+	slicePath := GetTmpSlicePath(fileHash, sliceHash)
 	r, err := mmap.Open(slicePath)
 	if err != nil {
-		slicePath = GetTmpSlicePath(fileHash, sliceHash)
-		r, err = mmap.Open(slicePath)
-		if err != nil {
-			return 0, nil, err
-		}
+		return 0, nil, err
 	}
-
 	return ReadFileDataToPackets(r, slicePath)
 }
 
 func GetSliceData(sliceHash string) ([]byte, error) {
-	slicePath, err := getSlicePath(sliceHash)
+	store, err := getSliceStore()
 	if err != nil {
 		return nil, err
 	}
-	return GetWholeFileData(slicePath)
+	return store.Get(sliceHash)
 }
 
 func GetVerifySliceData(sliceHash string) ([]byte, error) {
@@ -227,15 +232,11 @@ func GetWholeFileData(filePath string) ([]byte, error) {
 }
 
 func GetSliceSize(sliceHash string) (int64, error) {
-	slicePath, err := getSlicePath(sliceHash)
-	if err != nil {
-		return 0, errors.Wrap(err, "failed getting slice path")
-	}
-	info, err := GetFileInfo(slicePath)
+	store, err := getSliceStore()
 	if err != nil {
-		return 0, errors.Wrap(err, "failed getting file info")
+		return 0, err
 	}
-	return info.Size(), nil
+	return store.Size(sliceHash)
 }
 func OpenTmpFile(fileHash, fileName string) (*os.File, error) {
 	tmpFileFolderPath := GetTmpFileFolderPath(fileHash)
@@ -305,27 +306,41 @@ func SaveVerifySliceData(data []byte, sliceHash string, offset uint64) error {
 }
 
 func SaveSliceData(data []byte, sliceHash string, offset uint64) error {
-	wmutex.Lock()
-	defer wmutex.Unlock()
-	slicePath, err := getSlicePath(sliceHash)
+	store, err := getSliceStore()
 	if err != nil {
-		return errors.Wrap(err, "failed getting slice path")
-	}
-	fileMg, err := os.OpenFile(slicePath, os.O_CREATE|os.O_RDWR, 0600)
-	defer func() {
-		_ = fileMg.Close()
-	}()
-	if err != nil {
-		return errors.Wrap(err, "failed opening a file")
+		return err
 	}
-	_, err = fileMg.WriteAt(data, int64(offset))
-	if err != nil {
+	if err = store.Save(sliceHash, data, offset); err != nil {
 		utils.ErrorLog("error save file")
-		return errors.Wrap(err, "failed writing data")
+		return err
 	}
 	return nil
 }
 
+// CommitSliceData is called once the whole slice is received and its hash validated. The slice is sealed in the store
+// and added to the slice index, with the wallet of the file owner when it is known
+func CommitSliceData(sliceHash, fileHash string, sliceNumber uint64, owner string) error {
+	store, err := getSliceStore()
+	if err != nil {
+		return err
+	}
+	if err = store.Seal(sliceHash); err != nil {
+		return errors.Wrap(err, "failed sealing the slice")
+	}
+	size, err := store.Size(sliceHash)
+	if err != nil {
+		return errors.Wrap(err, "failed getting the slice size")
+	}
+	return recordSliceMeta(SliceMeta{
+		SliceHash:   sliceHash,
+		FileHash:    fileHash,
+		SliceNumber: sliceNumber,
+		Size:        size,
+		Owner:       owner,
+		Disk:        store.Disk(sliceHash),
+	})
+}
+
 func WriteFile(data []byte, offset int64, fileMg *os.File) error {
 	_, err := fileMg.Seek(offset, 0)
 	if err != nil {
@@ -431,7 +446,7 @@ func RecordDownloadCSV(target *protos.RspFileStorageInfo) {
 	writer.Flush()
 }
 
-func CheckFileExisting(ctx context.Context, fileHash, fileName, savePath, encryptionTag, fileReqId string) bool {
+func CheckFileExisting(ctx context.Context, fileHash, fileName, savePath, encryptionTag, compression, fileReqId string) bool {
 	utils.DebugLog("CheckFileExisting: file Hash", fileHash)
 
 	// check if the target path is remote, return false for "not match"
@@ -449,7 +464,7 @@ func CheckFileExisting(ctx context.Context, fileHash, fileName, savePath, encryp
 		return false
 	}
 
-	hash, err := crypto.CalcFileHash(filePath, encryptionTag, crypto.SDS_CODEC)
+	hash, err := crypto.CalcFileHash(filePath, FileHashTag(encryptionTag, compression), crypto.SDS_CODEC)
 	if err != nil {
 		utils.ErrorLog(err)
 	}
@@ -528,16 +543,56 @@ func CheckSliceExisting(fileHash, fileName, sliceHash, fileReqId string) bool {
 }
 
 func DeleteSlice(sliceHash string) error {
-	slicePath, err := getSlicePath(sliceHash)
+	store, err := getSliceStore()
 	if err != nil {
-		return errors.Wrap(err, "failed getting slice path")
+		return err
 	}
-	if err := os.Remove(slicePath); err != nil {
+	if err = store.Delete(sliceHash); err != nil {
 		return errors.Wrap(err, "failed removing slice")
 	}
+	if err = removeSliceMeta(sliceHash); err != nil {
+		utils.ErrorLog("failed removing slice from the index", err)
+	}
 	return nil
 }
 
+// QuarantineSlice moves a corrupt slice out of the storage, so it is neither served nor counted as stored anymore. It
+// is kept in the quarantine folder for inspection, and marked lost in the slice index until it is reported to the SP
+func QuarantineSlice(meta SliceMeta) error {
+	if err := quarantineSliceData(meta.SliceHash); err != nil {
+		return err
+	}
+	return markSliceLost(meta)
+}
+
+func quarantineSliceData(sliceHash string) error {
+	store, err := getSliceStore()
+	if err != nil {
+		return err
+	}
+	data, err := store.Get(sliceHash)
+	if err != nil {
+		return errors.Wrap(err, "failed reading the slice")
+	}
+	quarantinePath := getQuarantineFolderPath()
+	if err = os.MkdirAll(quarantinePath, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating the quarantine folder")
+	}
+	if err = os.WriteFile(filepath.Join(quarantinePath, sliceHash), data, 0600); err != nil {
+		return errors.Wrap(err, "failed moving the slice to quarantine")
+	}
+	return errors.Wrap(store.Delete(sliceHash), "failed removing slice")
+}
+
+// WalkSlices calls fn for each slice in the storage, with its size and last modification time
+func WalkSlices(fn func(sliceHash string, size int64, modTime time.Time) error) error {
+	store, err := getSliceStore()
+	if err != nil {
+		return err
+	}
+	return store.Walk(fn)
+}
+
 func DeleteVerifySlice(sliceHash string) error {
 	slicePath, err := getVerifySlicePath(sliceHash)
 	if err != nil {
@@ -684,7 +739,7 @@ func ExtractTarWithZstd(source string, target string) error {
 }
 
 // CheckDownloadCache check there is download cache for the file with fileHash
-func CheckDownloadCache(fileHash string) error {
+func CheckDownloadCache(fileHash, encryptionTag, compression string) error {
 	fileInfo, err := os.Stat(getDownloadTmpFolderPath(fileHash))
 	if err != nil {
 		return errors.Wrap(err, "download cache doesn't exist, ")
@@ -698,7 +753,7 @@ func CheckDownloadCache(fileHash string) error {
 	}
 
 	filePath := GetDownloadTmpFilePath(fileHash, fileName)
-	if fileHash != GetFileHash(filePath, "") {
+	if fileHash != GetFileHash(filePath, encryptionTag, compression) {
 		return errors.New("the cached file doesn't match file hash")
 	}
 	return nil
@@ -821,6 +876,15 @@ func rangeCsvFile(fileHash, fileName string, f func(sliceInCsv string)) bool {
 	return false
 }
 
+// GetDownloadedSlices returns the slices of the file recorded in its download tmp folder
+func GetDownloadedSlices(fileHash, fileName string) map[string]bool {
+	slices := make(map[string]bool)
+	rangeCsvFile(fileHash, fileName, func(sliceInCsv string) {
+		slices[sliceInCsv] = true
+	})
+	return slices
+}
+
 func UpdateDownloadSlices(fileInfo DownloadFile, reqId string) (updated bool) {
 	updated = false
 	d, found := downloadMap.Load(fileInfo.FileHash + reqId)
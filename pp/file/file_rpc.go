@@ -33,6 +33,9 @@ var (
 	// key(sliceHash + fileReqId) : value(chan *rpc.Result)
 	rpcSliceEventChan = &sync.Map{}
 
+	// key(fileHash + fileReqId) : value(chan *rpc.Result)
+	rpcRangeDownloadChan = &sync.Map{}
+
 	// key(fileHash) : value(chan []byte)
 	rpcUploadDataChan = &sync.Map{}
 
@@ -308,6 +311,33 @@ func SetRemoteSliceResult(key string, result rpc.Result) {
 	}
 }
 
+// SubscribeRangeDownload marks the download as reading a byte range of the file. The result of the file storage info request is sent to the returned channel
+func SubscribeRangeDownload(key string) chan *rpc.Result {
+	event := make(chan *rpc.Result, 1)
+	rpcRangeDownloadChan.Store(key, event)
+	return event
+}
+
+func UnsubscribeRangeDownload(key string) {
+	rpcRangeDownloadChan.Delete(key)
+}
+
+func IsRangeDownload(key string) bool {
+	_, found := rpcRangeDownloadChan.Load(key)
+	return found
+}
+
+// SetRangeDownloadResult application sends the result of the file storage info request of a range download to rpc server
+func SetRangeDownloadResult(key string, result *rpc.Result) {
+	ch, found := rpcRangeDownloadChan.Load(key)
+	if found {
+		select {
+		case ch.(chan *rpc.Result) <- result:
+		default:
+		}
+	}
+}
+
 func SubscribeDownloadSliceDone(key string) chan bool {
 	done := make(chan bool)
 	rpcDownloadReady.Store(key, done)
@@ -0,0 +1,604 @@
+package file
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	PACK_FOLDER = "packs"
+
+	packStagingFolder    = "staging"
+	packSegmentPrefix    = "segment-"
+	packSegmentSuffix    = ".pack"
+	packSegmentMaxSize   = 1 << 30
+	packCompactDeadRatio = 0.5 // segments with more deleted bytes than this are rewritten by Compact
+
+	// a record is the header, the slice hash then the slice data. The header checksum covers the header but the flags,
+	// and the hash
+	packHeaderSize          = 28 // magic, flags, hash length (2), data length (8), modification time (8), header checksum (4), data checksum (4)
+	packRecordMagic    byte = 0xd5
+	packFlagDeleted    byte = 1
+	packMaxHashLength       = 256
+	packScanBufferSize      = 1 << 20
+)
+
+// ErrSliceDamaged the data of a sealed slice doesn't match the checksum of its record
+var ErrSliceDamaged = errors.New("slice damaged in its segment")
+
+type packLocation struct {
+	segment uint32
+	offset  int64 // of the record in the segment
+	hashLen int64
+	size    int64 // of the slice
+	modTime time.Time
+	dataSum uint32
+}
+
+func (l packLocation) recordSize() int64 {
+	return packHeaderSize + l.hashLen + l.size
+}
+
+func (l packLocation) dataOffset() int64 {
+	return l.offset + packHeaderSize + l.hashLen
+}
+
+type packSegment struct {
+	id   uint32
+	file *os.File
+	size int64
+	dead int64 // bytes of the deleted or replaced records
+}
+
+// packSliceStore appends the slices to segment files of up to packSegmentMaxSize bytes, so that millions of slices
+// don't take millions of inodes. A slice being received is written to the staging folder until it is sealed. A deleted
+// slice is only flagged in its record, Compact reclaims the space
+type packSliceStore struct {
+	path     string
+	maxSize  int64 // of a segment
+	segments map[uint32]*packSegment
+	active   *packSegment // the segment the slices are appended to
+	index    map[string]packLocation
+	damaged  bool // records of sealed segments were skipped when loading, their slices are lost
+	mutex    sync.RWMutex
+}
+
+func openPackSliceStore(path string) (*packSliceStore, error) {
+	if err := os.MkdirAll(filepath.Join(path, packStagingFolder), os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "failed creating the pack folder")
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading the pack folder")
+	}
+	var ids []uint32
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, packSegmentPrefix) || !strings.HasSuffix(name, packSegmentSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, packSegmentPrefix), packSegmentSuffix), 10, 32)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint32(id))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	s := &packSliceStore{
+		path:     path,
+		maxSize:  packSegmentMaxSize,
+		segments: make(map[uint32]*packSegment),
+		index:    make(map[string]packLocation),
+	}
+	for i, id := range ids {
+		f, err := os.OpenFile(s.segmentPath(id), os.O_RDWR, 0600)
+		if err != nil {
+			_ = s.Close()
+			return nil, errors.Wrap(err, "failed opening segment")
+		}
+		seg := &packSegment{id: id, file: f}
+		s.segments[id] = seg
+		// active while it is loaded, so that it isn't removed before all its records are counted
+		s.active = seg
+		if err = s.loadSegment(seg, i == len(ids)-1); err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+	}
+	for _, seg := range s.segments {
+		s.removeIfDead(seg)
+	}
+	if s.active == nil || s.active.size >= s.maxSize {
+		if err = s.rotate(); err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *packSliceStore) segmentPath(id uint32) string {
+	return filepath.Join(s.path, fmt.Sprintf("%v%08d%v", packSegmentPrefix, id, packSegmentSuffix))
+}
+
+func (s *packSliceStore) stagingPath(sliceHash string) string {
+	return filepath.Join(s.path, packStagingFolder, sliceHash)
+}
+
+// loadSegment adds the slices of the segment to the index. Only the last segment, the one that was active, can end with
+// a record cut by a crash: it is truncated there. A damaged record in a sealed segment is skipped up to the next valid
+// one, and the slices it held are lost
+func (s *packSliceStore) loadSegment(seg *packSegment, last bool) error {
+	info, err := seg.file.Stat()
+	if err != nil {
+		return errors.Wrap(err, "failed reading segment")
+	}
+	var offset int64
+	for offset < info.Size() {
+		loc, hash, deleted, ok, err := s.readRecord(seg, offset, info.Size())
+		if err != nil {
+			return err
+		}
+		if !ok && last {
+			utils.ErrorLogf("segment %v is damaged after %v bytes, truncating it", seg.id, offset)
+			if err = seg.file.Truncate(offset); err != nil {
+				return errors.Wrap(err, "failed truncating segment")
+			}
+			break
+		}
+		if !ok {
+			next, err := s.nextRecord(seg, offset+1, info.Size())
+			if err != nil {
+				return err
+			}
+			utils.ErrorLogf("segment %v is damaged from %v to %v bytes, the slices there are lost", seg.id, offset, next)
+			s.damaged = true
+			seg.dead += next - offset
+			offset = next
+			continue
+		}
+
+		if deleted {
+			seg.dead += loc.recordSize()
+		} else {
+			// a slice sealed again, or copied by a compaction interrupted before the old record was flagged
+			if previous, ok := s.index[hash]; ok {
+				s.markDeleted(previous)
+			}
+			s.index[hash] = loc
+		}
+		offset += loc.recordSize()
+	}
+	seg.size = offset
+	return nil
+}
+
+// readRecord reads the record at offset. ok is false when there is no valid record there
+func (s *packSliceStore) readRecord(seg *packSegment, offset, segSize int64) (loc packLocation, hash string, deleted, ok bool, err error) {
+	if offset+packHeaderSize > segSize {
+		return loc, "", false, false, nil
+	}
+	header := make([]byte, packHeaderSize)
+	if _, err = seg.file.ReadAt(header, offset); err != nil && err != io.EOF {
+		return loc, "", false, false, errors.Wrap(err, "failed reading segment")
+	}
+	loc = packLocation{
+		segment: seg.id,
+		offset:  offset,
+		hashLen: int64(binary.BigEndian.Uint16(header[2:4])),
+		size:    int64(binary.BigEndian.Uint64(header[4:12])),
+		modTime: time.Unix(0, int64(binary.BigEndian.Uint64(header[12:20]))),
+		dataSum: binary.BigEndian.Uint32(header[24:28]),
+	}
+	if header[0] != packRecordMagic || loc.hashLen == 0 || loc.hashLen > packMaxHashLength || loc.size < 0 ||
+		loc.size > segSize || offset+loc.recordSize() > segSize {
+		return loc, "", false, false, nil
+	}
+	hashBytes := make([]byte, loc.hashLen)
+	if _, err = seg.file.ReadAt(hashBytes, offset+packHeaderSize); err != nil {
+		return loc, "", false, false, errors.Wrap(err, "failed reading segment")
+	}
+	if packHeaderSum(header, hashBytes) != binary.BigEndian.Uint32(header[20:24]) {
+		return loc, "", false, false, nil
+	}
+	return loc, string(hashBytes), header[1]&packFlagDeleted != 0, true, nil
+}
+
+// nextRecord returns the offset of the first valid record from offset, or the end of the segment
+func (s *packSliceStore) nextRecord(seg *packSegment, offset, segSize int64) (int64, error) {
+	buffer := make([]byte, packScanBufferSize)
+	for offset < segSize {
+		n, err := seg.file.ReadAt(buffer, offset)
+		if err != nil && err != io.EOF {
+			return 0, errors.Wrap(err, "failed reading segment")
+		}
+		if n == 0 {
+			break
+		}
+		for i := 0; i < n; i++ {
+			if buffer[i] != packRecordMagic {
+				continue
+			}
+			if _, _, _, ok, err := s.readRecord(seg, offset+int64(i), segSize); err != nil {
+				return 0, err
+			} else if ok {
+				return offset + int64(i), nil
+			}
+		}
+		offset += int64(n)
+	}
+	return segSize, nil
+}
+
+func packHeaderSum(header, hash []byte) uint32 {
+	sum := crc32.NewIEEE()
+	_, _ = sum.Write(header[:1])
+	_, _ = sum.Write(header[2:20])
+	_, _ = sum.Write(hash)
+	return sum.Sum32()
+}
+
+// rotate starts a new active segment. It must be called with the mutex held
+func (s *packSliceStore) rotate() error {
+	var id uint32
+	if s.active != nil {
+		id = s.active.id + 1
+	}
+	f, err := os.OpenFile(s.segmentPath(id), os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed creating segment")
+	}
+	s.active = &packSegment{id: id, file: f}
+	s.segments[id] = s.active
+	return nil
+}
+
+// appendRecord must be called with the mutex held
+func (s *packSliceStore) appendRecord(sliceHash string, data []byte, modTime time.Time) (packLocation, error) {
+	loc := packLocation{hashLen: int64(len(sliceHash)), size: int64(len(data)), modTime: modTime, dataSum: crc32.ChecksumIEEE(data)}
+	if s.active.size > 0 && s.active.size+loc.recordSize() > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return loc, err
+		}
+	}
+	loc.segment = s.active.id
+	loc.offset = s.active.size
+
+	header := make([]byte, packHeaderSize, packHeaderSize+len(sliceHash))
+	header[0] = packRecordMagic
+	binary.BigEndian.PutUint16(header[2:4], uint16(len(sliceHash)))
+	binary.BigEndian.PutUint64(header[4:12], uint64(len(data)))
+	binary.BigEndian.PutUint64(header[12:20], uint64(modTime.UnixNano()))
+	binary.BigEndian.PutUint32(header[20:24], packHeaderSum(header, []byte(sliceHash)))
+	binary.BigEndian.PutUint32(header[24:28], loc.dataSum)
+	header = append(header, sliceHash...)
+	if _, err := s.active.file.WriteAt(header, loc.offset); err != nil {
+		return loc, errors.Wrap(err, "failed writing segment")
+	}
+	if _, err := s.active.file.WriteAt(data, loc.dataOffset()); err != nil {
+		return loc, errors.Wrap(err, "failed writing segment")
+	}
+	if err := s.active.file.Sync(); err != nil {
+		return loc, errors.Wrap(err, "failed writing segment")
+	}
+	s.active.size += loc.recordSize()
+	return loc, nil
+}
+
+// markDeleted flags the record as deleted, and removes its segment once all its records are. It must be called with
+// the mutex held
+func (s *packSliceStore) markDeleted(loc packLocation) {
+	seg, ok := s.segments[loc.segment]
+	if !ok {
+		return
+	}
+	if _, err := seg.file.WriteAt([]byte{packFlagDeleted}, loc.offset+1); err != nil {
+		utils.ErrorLog("failed flagging a deleted slice in segment", err)
+	}
+	seg.dead += loc.recordSize()
+	s.removeIfDead(seg)
+}
+
+// removeIfDead removes the segment when all its records are deleted. It must be called with the mutex held
+func (s *packSliceStore) removeIfDead(seg *packSegment) {
+	if seg == s.active || seg.dead < seg.size {
+		return
+	}
+	_ = seg.file.Close()
+	if err := os.Remove(s.segmentPath(seg.id)); err != nil {
+		utils.ErrorLog("failed removing segment", err)
+	}
+	delete(s.segments, seg.id)
+}
+
+func (s *packSliceStore) Save(sliceHash string, data []byte, offset uint64) error {
+	wmutex.Lock()
+	defer wmutex.Unlock()
+	return writeSliceFile(s.stagingPath(sliceHash), data, offset)
+}
+
+func (s *packSliceStore) Seal(sliceHash string) error {
+	wmutex.Lock()
+	defer wmutex.Unlock()
+	stagingPath := s.stagingPath(sliceHash)
+	info, err := os.Stat(stagingPath)
+	if os.IsNotExist(err) {
+		if _, err = s.Size(sliceHash); err != nil {
+			return errors.Wrapf(err, "slice %v is not stored", sliceHash)
+		}
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed reading staged slice")
+	}
+	data, err := os.ReadFile(stagingPath)
+	if err != nil {
+		return errors.Wrap(err, "failed reading staged slice")
+	}
+
+	if err = s.putRecord(sliceHash, data, info.ModTime()); err != nil {
+		return err
+	}
+	return os.Remove(stagingPath)
+}
+
+// putRecord appends a record for the slice, replacing the one it had
+func (s *packSliceStore) putRecord(sliceHash string, data []byte, modTime time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	loc, err := s.appendRecord(sliceHash, data, modTime)
+	if err != nil {
+		return err
+	}
+	if previous, ok := s.index[sliceHash]; ok {
+		s.markDeleted(previous)
+	}
+	s.index[sliceHash] = loc
+	return nil
+}
+
+// importDirSlices moves the slices of a dir layout store to the segments. A slice is removed from the folder once its
+// record is written, so an interrupted import is resumed the next time the store is opened
+func (s *packSliceStore) importDirSlices(dir *dirSliceStore) error {
+	var sliceHashes []string
+	err := dir.Walk(func(sliceHash string, _ int64, _ time.Time) error {
+		sliceHashes = append(sliceHashes, sliceHash)
+		return nil
+	})
+	if err != nil || len(sliceHashes) == 0 {
+		return err
+	}
+	utils.Logf("Moving %v slices of the dir storage layout to the packs", len(sliceHashes))
+	for _, sliceHash := range sliceHashes {
+		slicePath, err := dir.slicePath(sliceHash)
+		if err != nil {
+			return err
+		}
+		info, err := os.Stat(slicePath)
+		if err != nil {
+			return errors.Wrap(err, "failed reading slice")
+		}
+		data, err := os.ReadFile(slicePath)
+		if err != nil {
+			return errors.Wrap(err, "failed reading slice")
+		}
+		if err = s.putRecord(sliceHash, data, info.ModTime()); err != nil {
+			return err
+		}
+		if err = os.Remove(slicePath); err != nil {
+			return errors.Wrap(err, "failed removing slice")
+		}
+		// the folders are only removed once empty
+		_ = os.Remove(filepath.Dir(slicePath))
+		_ = os.Remove(filepath.Dir(filepath.Dir(slicePath)))
+	}
+	utils.Log("The slices of the dir storage layout are in the packs")
+	return nil
+}
+
+func (s *packSliceStore) Read(sliceHash string) (int64, [][]byte, error) {
+	data, err := s.Get(sliceHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	size := int64(len(data))
+	buffer := RequestBuffersForSlice(size)
+	for i := range buffer {
+		copy(buffer[i], data[int64(i)*int64(len(buffer[0])):])
+	}
+	return size, buffer, nil
+}
+
+// Get returns the slice being received when there is one, the sealed slice otherwise
+func (s *packSliceStore) Get(sliceHash string) ([]byte, error) {
+	data, err := GetWholeFileData(s.stagingPath(sliceHash))
+	if !os.IsNotExist(err) {
+		return data, err
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	loc, ok := s.index[sliceHash]
+	if !ok {
+		return nil, errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+	}
+	return s.readData(loc)
+}
+
+// readData reads the data of a record, and checks it against its checksum. It must be called with the mutex held
+func (s *packSliceStore) readData(loc packLocation) ([]byte, error) {
+	data := make([]byte, loc.size)
+	if _, err := s.segments[loc.segment].file.ReadAt(data, loc.dataOffset()); err != nil {
+		return nil, errors.Wrap(err, "failed reading segment")
+	}
+	if crc32.ChecksumIEEE(data) != loc.dataSum {
+		return nil, errors.Wrapf(ErrSliceDamaged, "segment %v at %v bytes", loc.segment, loc.offset)
+	}
+	return data, nil
+}
+
+// Damaged tells whether records of sealed segments were skipped when loading the store
+func (s *packSliceStore) Damaged() bool {
+	return s.damaged
+}
+
+func (s *packSliceStore) Size(sliceHash string) (int64, error) {
+	info, err := GetFileInfo(s.stagingPath(sliceHash))
+	if err == nil {
+		return info.Size(), nil
+	}
+	if !os.IsNotExist(err) {
+		return 0, errors.Wrap(err, "failed getting file info")
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	loc, ok := s.index[sliceHash]
+	if !ok {
+		return 0, errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+	}
+	return loc.size, nil
+}
+
+func (s *packSliceStore) Delete(sliceHash string) error {
+	err := os.Remove(s.stagingPath(sliceHash))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	staged := err == nil
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	loc, ok := s.index[sliceHash]
+	if !ok {
+		if staged {
+			return nil
+		}
+		return errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+	}
+	s.markDeleted(loc)
+	delete(s.index, sliceHash)
+	return nil
+}
+
+func (s *packSliceStore) Walk(fn func(sliceHash string, size int64, modTime time.Time) error) error {
+	type slice struct {
+		hash    string
+		size    int64
+		modTime time.Time
+	}
+	var slices []slice
+	staged := make(map[string]bool)
+	entries, err := os.ReadDir(filepath.Join(s.path, packStagingFolder))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed reading the staging folder")
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || entry.IsDir() {
+			continue
+		}
+		staged[entry.Name()] = true
+		slices = append(slices, slice{hash: entry.Name(), size: info.Size(), modTime: info.ModTime()})
+	}
+
+	s.mutex.RLock()
+	for hash, loc := range s.index {
+		if !staged[hash] {
+			slices = append(slices, slice{hash: hash, size: loc.size, modTime: loc.modTime})
+		}
+	}
+	s.mutex.RUnlock()
+
+	for _, sl := range slices {
+		if err = fn(sl.hash, sl.size, sl.modTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Compact rewrites the live slices of the segments which are mostly deleted at the end of the active segment, and
+// removes them
+func (s *packSliceStore) Compact() error {
+	s.mutex.RLock()
+	var ids []uint32
+	for id, seg := range s.segments {
+		if seg != s.active && float64(seg.dead) > float64(seg.size)*packCompactDeadRatio {
+			ids = append(ids, id)
+		}
+	}
+	s.mutex.RUnlock()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		s.mutex.RLock()
+		var hashes []string
+		for hash, loc := range s.index {
+			if loc.segment == id {
+				hashes = append(hashes, hash)
+			}
+		}
+		s.mutex.RUnlock()
+
+		// one slice at a time, so that the store stays usable during the compaction
+		for _, hash := range hashes {
+			if err := s.moveRecord(hash, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *packSliceStore) moveRecord(sliceHash string, from uint32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	loc, ok := s.index[sliceHash]
+	if !ok || loc.segment != from {
+		return nil
+	}
+	data, err := s.readData(loc)
+	if errors.Is(err, ErrSliceDamaged) {
+		// left in place, it is found by the next scrub
+		utils.ErrorLogf("slice %v is damaged, not compacted", sliceHash)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	newLoc, err := s.appendRecord(sliceHash, data, loc.modTime)
+	if err != nil {
+		return err
+	}
+	s.index[sliceHash] = newLoc
+	s.markDeleted(loc)
+	return nil
+}
+
+func (s *packSliceStore) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var err error
+	for _, seg := range s.segments {
+		if closeErr := seg.file.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	s.segments = make(map[uint32]*packSegment)
+	return err
+}
@@ -0,0 +1,163 @@
+package file
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestPackSliceStore(t *testing.T) {
+	path := t.TempDir()
+	s, err := openPackSliceStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.maxSize = 100
+	slices := map[string][]byte{
+		"slice1": bytes.Repeat([]byte{1}, 40),
+		"slice2": bytes.Repeat([]byte{2}, 40),
+		"slice3": bytes.Repeat([]byte{3}, 40),
+	}
+	for _, hash := range []string{"slice1", "slice2", "slice3"} {
+		// the slices are received piece by piece
+		if err = s.Save(hash, slices[hash][20:], 20); err != nil {
+			t.Fatal(err)
+		}
+		if err = s.Save(hash, slices[hash][:20], 0); err != nil {
+			t.Fatal(err)
+		}
+		if err = s.Seal(hash); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(s.segments) != 3 {
+		t.Fatalf("the slices should be in 3 segments, got %v", len(s.segments))
+	}
+	if err = s.Delete("slice1"); err != nil || len(s.segments) != 2 {
+		t.Fatalf("the segment of the deleted slice should be removed, %v segments, %v", len(s.segments), err)
+	}
+
+	// the store is loaded again from the segments
+	if err = s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if s, err = openPackSliceStore(path); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = s.Close() }()
+	s.maxSize = 100
+	count := 0
+	err = s.Walk(func(sliceHash string, size int64, _ time.Time) error {
+		data, err := s.Get(sliceHash)
+		if err != nil || !bytes.Equal(data, slices[sliceHash]) || size != 40 {
+			t.Fatalf("wrong slice %v, %v", sliceHash, err)
+		}
+		count++
+		return nil
+	})
+	if err != nil || count != 2 {
+		t.Fatalf("2 slices should be stored, got %v, %v", count, err)
+	}
+	if _, err = s.Get("slice1"); err == nil {
+		t.Fatal("the deleted slice should be gone")
+	}
+
+	// slice2 is moved to the active segment by the compaction
+	s.mutex.Lock()
+	s.segments[s.index["slice2"].segment].dead = 40
+	s.mutex.Unlock()
+	if err = s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := s.Get("slice2"); err != nil || !bytes.Equal(data, slices["slice2"]) || s.index["slice2"].segment != s.active.id {
+		t.Fatalf("slice2 should be compacted, %v", err)
+	}
+}
+
+func TestPackSliceStoreDamage(t *testing.T) {
+	path := t.TempDir()
+	s, err := openPackSliceStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte{1}, 40)
+	for _, hash := range []string{"slice1", "slice2", "slice3"} {
+		if hash == "slice3" {
+			if err = s.rotate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = s.Save(hash, data, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err = s.Seal(hash); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sealed, active := s.index["slice1"].segment, s.active.id
+	slice2 := s.index["slice2"]
+	if err = s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the header of slice1 and the data of slice2 are damaged in the sealed segment, a write to the active one is torn
+	f, err := os.OpenFile(s.segmentPath(sealed), os.O_RDWR, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteAt([]byte{0xff}, 13)
+	_, _ = f.WriteAt([]byte{0xff}, slice2.dataOffset())
+	_ = f.Close()
+	f, err = os.OpenFile(s.segmentPath(active), os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.Write([]byte{packRecordMagic, 0, 0})
+	_ = f.Close()
+
+	if s, err = openPackSliceStore(path); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = s.Close() }()
+	if !s.Damaged() {
+		t.Fatal("the store should be damaged")
+	}
+	if _, err = s.Size("slice1"); err == nil {
+		t.Fatal("slice1 should be lost")
+	}
+	if _, err = s.Get("slice2"); !errors.Is(err, ErrSliceDamaged) {
+		t.Fatalf("slice2 should be damaged, got %v", err)
+	}
+	if got, err := s.Get("slice3"); err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("slice3 should be intact, %v", err)
+	}
+	if s.segments[active].size != s.index["slice3"].recordSize() {
+		t.Fatal("the torn write should be truncated from the active segment")
+	}
+}
+
+func TestPackSliceStoreImportDirSlices(t *testing.T) {
+	path := t.TempDir()
+	dir := &dirSliceStore{path: path}
+	hash := "0123456789abcdef"
+	data := bytes.Repeat([]byte{1}, 40)
+	if err := dir.Save(hash, data, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := newSliceStore(SLICE_STORE_PACK, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = s.Close() }()
+	if got, err := s.Get(hash); err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("the slice of the dir layout should be in the packs, %v", err)
+	}
+	if _, err = os.Stat(filepath.Join(path, hash[:8])); !os.IsNotExist(err) {
+		t.Fatal("the folders of the dir layout should be removed")
+	}
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/stratosnet/sds/pp/setting"
 )
 
+const (
+	JOURNAL_FOLDER    = "journal"
+	QUARANTINE_FOLDER = "quarantine"
+)
+
 // getTmpFolderPath path to the tmp file folder
 func getTmpFolderPath() string {
 	return filepath.Join(setting.GetRootPath(), TEMP_FOLDER)
@@ -33,10 +38,35 @@ func GetTmpVerifyPath() string {
 	return filepath.Join(getTmpFolderPath(), "verify")
 }
 
+// GetMountCacheFilePath path to a block of the file cached by a mounted wallet, aged out with the other download tmp files
+func GetMountCacheFilePath(fileHash string, block uint64) string {
+	return filepath.Join(getDownloadTmpFolderPath(fileHash), fmt.Sprintf("mount.%d.tmp", block))
+}
+
+// GetMountUploadFolderPath path to the folder of the files copied into a mounted wallet, until they are uploaded
+func GetMountUploadFolderPath() string {
+	return filepath.Join(getTmpFolderPath(), "mount")
+}
+
 func getDownloadTmpFolderPath(fileHash string) string {
 	return filepath.Join(GetTmpDownloadPath(), fileHash)
 }
 
+// getJournalFolderPath path to the folder of task journals, kept outside tmp so that the tmp cleaner never removes it
+func getJournalFolderPath() string {
+	return filepath.Join(setting.GetRootPath(), JOURNAL_FOLDER)
+}
+
+// GetUploadJournalFolderPath path to the folder of upload task journals
+func GetUploadJournalFolderPath() string {
+	return filepath.Join(getJournalFolderPath(), "upload")
+}
+
+// GetDownloadJournalFolderPath path to the folder of download task journals
+func GetDownloadJournalFolderPath() string {
+	return filepath.Join(getJournalFolderPath(), "download")
+}
+
 // GetDownloadTmpFilePath path to the download tmp file
 func GetDownloadTmpFilePath(fileHash, fileName string) string {
 	return filepath.Join(getDownloadTmpFolderPath(fileHash), fileName+".tmp")
@@ -63,30 +93,16 @@ func GetDownloadFileNameFromTmp(fileHash string) (string, error) {
 	return "", errors.New("can't find cached files")
 }
 
+// getQuarantineFolderPath path to the folder of the corrupt slices removed from the storage
+func getQuarantineFolderPath() string {
+	return filepath.Join(setting.Config.Home.StoragePath, QUARANTINE_FOLDER)
+}
+
 // GetDownloadFilePath path to a file as in download folder
 func GetDownloadFilePath(fileName, savePath string) string {
 	return filepath.Join(setting.Config.Home.DownloadPath, savePath, fileName)
 }
 
-func getSlicePath(hash string) (string, error) {
-	if len(hash) < 10 {
-		return "", errors.New("wrong size of slice hash")
-	}
-	s1 := string([]rune(hash)[:8])
-	s2 := string([]rune(hash)[8:10])
-	path := filepath.Join(setting.Config.Home.StoragePath, s1, s2)
-	exist, err := PathExists(path)
-	if err != nil {
-		return "", errors.Wrap(err, "failed checking path")
-	}
-	if !exist {
-		if err = os.MkdirAll(path, os.ModePerm); err != nil {
-			return "", errors.Wrap(err, "failed creating dir")
-		}
-	}
-	return filepath.Join(path, hash), nil
-}
-
 func getVerifySlicePath(hash string) (string, error) {
 	if len(hash) < 10 {
 		return "", errors.New("wrong size of slice hash")
@@ -0,0 +1,213 @@
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	SLICE_INDEX_FILE = "slices.index"
+	// SLICE_INDEX_BACKFILLED_FILE marks that the slices stored before the slice index existed were added to it
+	SLICE_INDEX_BACKFILLED_FILE = "slices.index.backfilled"
+
+	// the index is rewritten when it holds this many outdated entries more than live ones
+	sliceIndexCompactThreshold = 1000
+	// slices written this recently may still be in transfer, they are indexed once committed
+	sliceIndexBackfillMinAge = time.Hour
+)
+
+// SliceMeta what is known of a stored slice besides its hash. The file hash and slice number are needed to recompute
+// the slice hash
+type SliceMeta struct {
+	SliceHash   string `json:"slice"`
+	FileHash    string `json:"file,omitempty"`
+	SliceNumber uint64 `json:"number,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	Owner       string `json:"owner,omitempty"` // wallet of the file owner, unknown for the slices transferred from other nodes
+	StoredTime  int64  `json:"time,omitempty"`
+	Disk        string `json:"disk,omitempty"` // path of the storage pool
+	Lost        bool   `json:"lost,omitempty"` // the slice is gone, it is reported to the SP when the node registers
+	Deleted     bool   `json:"deleted,omitempty"`
+}
+
+var sliceIndexMutex sync.Mutex
+
+// getSliceIndexPath the index is an append-only log of SliceMeta, one JSON object per line, next to the slices
+func getSliceIndexPath() string {
+	return filepath.Join(setting.Config.Home.StoragePath, SLICE_INDEX_FILE)
+}
+
+// recordSliceMeta adds a slice fully stored and validated to the index
+func recordSliceMeta(meta SliceMeta) error {
+	meta.StoredTime = time.Now().Unix()
+	return appendSliceMeta(meta)
+}
+
+func markSliceMetaLost(meta SliceMeta) error {
+	meta.Lost = true
+	return appendSliceMeta(meta)
+}
+
+func removeSliceMeta(sliceHash string) error {
+	return appendSliceMeta(SliceMeta{SliceHash: sliceHash, Deleted: true})
+}
+
+func appendSliceMeta(meta SliceMeta) error {
+	line, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	sliceIndexMutex.Lock()
+	defer sliceIndexMutex.Unlock()
+	if err = os.MkdirAll(setting.Config.Home.StoragePath, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating the storage folder")
+	}
+	f, err := os.OpenFile(getSliceIndexPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening the slice index")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		return errors.Wrap(err, "failed writing the slice index")
+	}
+	applySliceUsage(meta)
+	return nil
+}
+
+// LoadSliceMetas returns the slices of the index by slice hash. The index is compacted when it holds too many outdated
+// entries
+func LoadSliceMetas() (map[string]SliceMeta, error) {
+	sliceIndexMutex.Lock()
+	defer sliceIndexMutex.Unlock()
+	return loadSliceMetas()
+}
+
+// loadSliceMetas must be called with sliceIndexMutex held
+func loadSliceMetas() (map[string]SliceMeta, error) {
+	metas := make(map[string]SliceMeta)
+	f, err := os.Open(getSliceIndexPath())
+	if os.IsNotExist(err) {
+		return metas, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening the slice index")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	entries := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var meta SliceMeta
+		if err = json.Unmarshal(scanner.Bytes(), &meta); err != nil || meta.SliceHash == "" {
+			// a line cut by a crash, the entries after it are still good
+			continue
+		}
+		entries++
+		if meta.Deleted {
+			delete(metas, meta.SliceHash)
+		} else {
+			metas[meta.SliceHash] = meta
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed reading the slice index")
+	}
+
+	if entries-len(metas) > len(metas)+sliceIndexCompactThreshold {
+		if err = writeSliceIndex(metas); err != nil {
+			return nil, err
+		}
+	}
+	return metas, nil
+}
+
+// IsSliceIndexBackfilled tells whether all the stored slices are in the slice index. The slices stored by a version
+// without the index are added to it once, from what the SP knows of them
+func IsSliceIndexBackfilled() bool {
+	_, err := os.Stat(filepath.Join(setting.Config.Home.StoragePath, SLICE_INDEX_BACKFILLED_FILE))
+	return err == nil
+}
+
+func MarkSliceIndexBackfilled() error {
+	if err := os.MkdirAll(setting.Config.Home.StoragePath, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating the storage folder")
+	}
+	f, err := os.Create(filepath.Join(setting.Config.Home.StoragePath, SLICE_INDEX_BACKFILLED_FILE))
+	if err != nil {
+		return errors.Wrap(err, "failed marking the slice index backfilled")
+	}
+	return f.Close()
+}
+
+// FindUnindexedSlices returns the stored slices missing from the slice index, with their size, and their modification
+// time as stored time
+func FindUnindexedSlices() (map[string]SliceMeta, error) {
+	store, err := getSliceStore()
+	if err != nil {
+		return nil, err
+	}
+	metas, err := LoadSliceMetas()
+	if err != nil {
+		return nil, err
+	}
+	unindexed := make(map[string]SliceMeta)
+	err = store.Walk(func(sliceHash string, size int64, modTime time.Time) error {
+		if _, ok := metas[sliceHash]; ok || time.Since(modTime) < sliceIndexBackfillMinAge {
+			return nil
+		}
+		unindexed[sliceHash] = SliceMeta{SliceHash: sliceHash, Size: size, StoredTime: modTime.Unix(), Disk: store.Disk(sliceHash)}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed walking the storage")
+	}
+	return unindexed, nil
+}
+
+// BackfillSliceMeta adds to the index a slice stored before the index existed, once its hash matches the file hash and
+// slice number given by the SP
+func BackfillSliceMeta(meta SliceMeta) error {
+	data, err := GetSliceData(meta.SliceHash)
+	if err != nil {
+		return errors.Wrap(err, "failed reading the slice")
+	}
+	if !crypto.VerifySliceHash(data, meta.FileHash, meta.SliceNumber, meta.SliceHash) {
+		return errors.New("the slice doesn't match its file hash and slice number")
+	}
+	return appendSliceMeta(meta)
+}
+
+// writeSliceIndex must be called with sliceIndexMutex held
+func writeSliceIndex(metas map[string]SliceMeta) error {
+	tmpPath := getSliceIndexPath() + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed creating the slice index")
+	}
+	w := bufio.NewWriter(f)
+	for _, meta := range metas {
+		line, _ := json.Marshal(meta)
+		_, _ = w.Write(append(line, '\n'))
+	}
+	if err = w.Flush(); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed writing the slice index")
+	}
+	if err = f.Close(); err != nil {
+		return errors.Wrap(err, "failed writing the slice index")
+	}
+	return errors.Wrap(os.Rename(tmpPath, getSliceIndexPath()), "failed replacing the slice index")
+}
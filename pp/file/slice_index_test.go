@@ -0,0 +1,124 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestSliceIndexAndQuarantine(t *testing.T) {
+	setting.Config = setting.DefaultConfig()
+	setting.Config.Home.StoragePath = t.TempDir()
+	defer func() { _ = CloseSliceStore() }()
+
+	slices := []string{"aaaaaaaaaa01", "aaaaaaaaaa02", "bbbbbbbbbb01"}
+	for i, sliceHash := range slices {
+		if err := SaveSliceData([]byte(sliceHash), sliceHash, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := CommitSliceData(sliceHash, "file", uint64(i+1), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := DeleteSlice(slices[1]); err != nil {
+		t.Fatal(err)
+	}
+	metas, err := LoadSliceMetas()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = QuarantineSlice(metas[slices[2]]); err != nil {
+		t.Fatal(err)
+	}
+
+	// the quarantined slice stays in the index, lost until it is reported
+	if metas, err = LoadSliceMetas(); err != nil {
+		t.Fatal(err)
+	}
+	if len(metas) != 2 || metas[slices[0]].SliceNumber != 1 || metas[slices[0]].Lost || !metas[slices[2]].Lost {
+		t.Fatalf("wrong slice index %+v", metas)
+	}
+	if lost := GetLostSlices(); len(lost) != 1 || lost[0].SliceHash != slices[2] {
+		t.Fatalf("wrong lost slices %+v", lost)
+	}
+	var walked []string
+	err = WalkSlices(func(sliceHash string, size int64, _ time.Time) error {
+		walked = append(walked, sliceHash)
+		return nil
+	})
+	if err != nil || len(walked) != 1 || walked[0] != slices[0] {
+		t.Fatalf("wrong slices in the storage %v, %v", walked, err)
+	}
+	if _, err = os.Stat(filepath.Join(getQuarantineFolderPath(), slices[2])); err != nil {
+		t.Fatal("the slice was not quarantined", err)
+	}
+}
+
+func TestSliceIndexBackfill(t *testing.T) {
+	setting.Config = setting.DefaultConfig()
+	setting.Config.Home.StoragePath = t.TempDir()
+	defer func() { _ = CloseSliceStore() }()
+
+	filePath := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(filePath, []byte("file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fileHash, err := crypto.CalcFileHash(filePath, "", crypto.SDS_CODEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileData := []byte("slice of a file")
+	fileSlice, err := crypto.CalcSliceHash(fileData, fileHash, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stored by a version without the slice index
+	old := time.Now().Add(-2 * sliceIndexBackfillMinAge)
+	if err = SaveSliceData(fileData, fileSlice, 0); err != nil {
+		t.Fatal(err)
+	}
+	err = filepath.Walk(setting.Config.Home.StoragePath, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			err = os.Chtimes(path, old, old)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if IsSliceIndexBackfilled() {
+		t.Fatal("the slice index shouldn't be backfilled yet")
+	}
+	unindexed, err := FindUnindexedSlices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta, ok := unindexed[fileSlice]
+	if len(unindexed) != 1 || !ok || meta.StoredTime != old.Unix() || meta.Size != int64(len(fileData)) {
+		t.Fatalf("wrong unindexed slices %+v", unindexed)
+	}
+
+	meta.FileHash, meta.SliceNumber = fileHash, 2
+	if err = BackfillSliceMeta(meta); err == nil {
+		t.Fatal("a slice with the wrong slice number shouldn't be indexed")
+	}
+	meta.SliceNumber = 3
+	if err = BackfillSliceMeta(meta); err != nil {
+		t.Fatal(err)
+	}
+	if err = MarkSliceIndexBackfilled(); err != nil || !IsSliceIndexBackfilled() {
+		t.Fatal("the slice index should be backfilled", err)
+	}
+	metas, err := LoadSliceMetas()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metas) != 1 || metas[fileSlice].SliceNumber != 3 || metas[fileSlice].StoredTime != old.Unix() {
+		t.Fatalf("wrong slice index %+v", metas)
+	}
+}
@@ -0,0 +1,232 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/mmap"
+
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	SLICE_STORE_DIR  = "dir"
+	SLICE_STORE_PACK = "pack"
+)
+
+// SliceStore keeps the slices stored by the node. A slice is written piece by piece with Save, and Seal is called once
+// the whole slice is received and its hash validated
+type SliceStore interface {
+	Save(sliceHash string, data []byte, offset uint64) error
+	Seal(sliceHash string) error
+	// Read returns the slice split in packets of setting.MaxData bytes
+	Read(sliceHash string) (int64, [][]byte, error)
+	Get(sliceHash string) ([]byte, error)
+	Size(sliceHash string) (int64, error)
+	Delete(sliceHash string) error
+	// Walk calls fn for each slice, sealed or not, with its size and last modification time
+	Walk(fn func(sliceHash string, size int64, modTime time.Time) error) error
+	Close() error
+}
+
+var (
+	sliceStore      *poolSliceStore
+	sliceStoreMutex sync.Mutex
+)
+
+// OpenSliceStore opens the slice stores of the storage pools in the config. They are opened on first use otherwise
+func OpenSliceStore() error {
+	_, err := getSliceStore()
+	return err
+}
+
+func CloseSliceStore() error {
+	sliceStoreMutex.Lock()
+	defer sliceStoreMutex.Unlock()
+	if sliceStore == nil {
+		return nil
+	}
+	err := sliceStore.Close()
+	sliceStore = nil
+	resetSliceUsage()
+	return err
+}
+
+func getSliceStore() (*poolSliceStore, error) {
+	sliceStoreMutex.Lock()
+	defer sliceStoreMutex.Unlock()
+	if sliceStore != nil {
+		return sliceStore, nil
+	}
+	store, err := openPoolSliceStore(setting.Config.Home.StorageLayout, setting.Config.Home.StoragePath, setting.GetStoragePools())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening the slice store")
+	}
+	sliceStore = store
+	return sliceStore, nil
+}
+
+func newSliceStore(layout, storagePath string) (SliceStore, error) {
+	switch layout {
+	case "", SLICE_STORE_DIR:
+		// the slices in the packs would be hidden
+		segments, _ := filepath.Glob(filepath.Join(storagePath, PACK_FOLDER, packSegmentPrefix+"*"+packSegmentSuffix))
+		if len(segments) > 0 {
+			return nil, errors.Errorf("%v holds slices of the pack layout, the storage layout can't be changed back to dir", storagePath)
+		}
+		return &dirSliceStore{path: storagePath}, nil
+	case SLICE_STORE_PACK:
+		store, err := openPackSliceStore(filepath.Join(storagePath, PACK_FOLDER))
+		if err != nil {
+			return nil, err
+		}
+		// the slices stored before switching to the pack layout
+		if err = store.importDirSlices(&dirSliceStore{path: storagePath}); err != nil {
+			_ = store.Close()
+			return nil, errors.Wrap(err, "failed moving the slices of the dir layout to the packs")
+		}
+		return store, nil
+	default:
+		return nil, errors.Errorf("unknown storage layout %v", layout)
+	}
+}
+
+// dirSliceStore stores each slice in its own file, under <hash[:8]>/<hash[8:10]>/<hash>
+type dirSliceStore struct {
+	path string
+}
+
+func (s *dirSliceStore) slicePath(hash string) (string, error) {
+	if len(hash) < 10 {
+		return "", errors.New("wrong size of slice hash")
+	}
+	s1 := string([]rune(hash)[:8])
+	s2 := string([]rune(hash)[8:10])
+	return filepath.Join(s.path, s1, s2, hash), nil
+}
+
+func (s *dirSliceStore) Save(sliceHash string, data []byte, offset uint64) error {
+	wmutex.Lock()
+	defer wmutex.Unlock()
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return errors.Wrap(err, "failed getting slice path")
+	}
+	if err = os.MkdirAll(filepath.Dir(slicePath), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating dir")
+	}
+	return writeSliceFile(slicePath, data, offset)
+}
+
+func (s *dirSliceStore) Seal(_ string) error {
+	return nil
+}
+
+func (s *dirSliceStore) Read(sliceHash string) (int64, [][]byte, error) {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	r, err := mmap.Open(slicePath)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	return ReadFileDataToPackets(r, slicePath)
+}
+
+func (s *dirSliceStore) Get(sliceHash string) ([]byte, error) {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return nil, err
+	}
+	return GetWholeFileData(slicePath)
+}
+
+func (s *dirSliceStore) Size(sliceHash string) (int64, error) {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed getting slice path")
+	}
+	info, err := GetFileInfo(slicePath)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed getting file info")
+	}
+	return info.Size(), nil
+}
+
+func (s *dirSliceStore) Delete(sliceHash string) error {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return errors.Wrap(err, "failed getting slice path")
+	}
+	return os.Remove(slicePath)
+}
+
+func (s *dirSliceStore) Walk(fn func(sliceHash string, size int64, modTime time.Time) error) error {
+	dirs1, err := os.ReadDir(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed reading the storage folder")
+	}
+	for _, dir1 := range dirs1 {
+		// the other folders of the storage have longer names
+		if !dir1.IsDir() || len(dir1.Name()) != 8 {
+			continue
+		}
+		dirs2, err := os.ReadDir(filepath.Join(s.path, dir1.Name()))
+		if err != nil {
+			return errors.Wrap(err, "failed reading the storage folder")
+		}
+		for _, dir2 := range dirs2 {
+			if !dir2.IsDir() || len(dir2.Name()) != 2 {
+				continue
+			}
+			slices, err := os.ReadDir(filepath.Join(s.path, dir1.Name(), dir2.Name()))
+			if err != nil {
+				return errors.Wrap(err, "failed reading the storage folder")
+			}
+			for _, slice := range slices {
+				if slice.IsDir() || !strings.HasPrefix(slice.Name(), dir1.Name()+dir2.Name()) {
+					continue
+				}
+				info, err := slice.Info()
+				if err != nil {
+					// removed since the folder was read
+					continue
+				}
+				if err = fn(slice.Name(), info.Size(), info.ModTime()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *dirSliceStore) Close() error {
+	return nil
+}
+
+// writeSliceFile must be called with wmutex held
+func writeSliceFile(path string, data []byte, offset uint64) error {
+	fileMg, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening a file")
+	}
+	defer func() {
+		_ = fileMg.Close()
+	}()
+	if _, err = fileMg.WriteAt(data, int64(offset)); err != nil {
+		return errors.Wrap(err, "failed writing data")
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package file
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FileUsage the slices stored for a file
+type FileUsage struct {
+	FileHash string
+	Owner    string
+	Slices   int
+	Bytes    int64
+}
+
+// OwnerUsage the slices stored for the files of a wallet
+type OwnerUsage struct {
+	Owner  string
+	Files  int
+	Slices int
+	Bytes  int64
+}
+
+// DayUsage the slices stored on a day, in UTC
+type DayUsage struct {
+	Day    string
+	Slices int
+	Bytes  int64
+}
+
+// sliceUsage the running totals of the slices of the index, built from it on the first query then updated with each
+// entry appended to it. It is guarded by sliceIndexMutex
+var sliceUsage *sliceUsageTotals
+
+type sliceUsageTotals struct {
+	slices map[string]SliceMeta
+	files  map[string]*FileUsage
+	days   map[int64]*DayUsage // by the start of the day, in UTC
+}
+
+func (u *sliceUsageTotals) apply(meta SliceMeta) {
+	if previous, ok := u.slices[meta.SliceHash]; ok {
+		u.remove(previous)
+	}
+	if !meta.Deleted && !meta.Lost {
+		u.add(meta)
+	}
+}
+
+func (u *sliceUsageTotals) add(meta SliceMeta) {
+	u.slices[meta.SliceHash] = meta
+	file, ok := u.files[meta.FileHash]
+	if !ok {
+		file = &FileUsage{FileHash: meta.FileHash}
+		u.files[meta.FileHash] = file
+	}
+	if meta.Owner != "" {
+		file.Owner = meta.Owner
+	}
+	file.Slices++
+	file.Bytes += meta.Size
+
+	if meta.StoredTime == 0 {
+		return
+	}
+	day := time.Unix(meta.StoredTime, 0).UTC().Truncate(24 * time.Hour)
+	dayUsage, ok := u.days[day.Unix()]
+	if !ok {
+		dayUsage = &DayUsage{Day: day.Format("2006-01-02")}
+		u.days[day.Unix()] = dayUsage
+	}
+	dayUsage.Slices++
+	dayUsage.Bytes += meta.Size
+}
+
+func (u *sliceUsageTotals) remove(meta SliceMeta) {
+	delete(u.slices, meta.SliceHash)
+	if file, ok := u.files[meta.FileHash]; ok {
+		file.Slices--
+		file.Bytes -= meta.Size
+		if file.Slices <= 0 {
+			delete(u.files, meta.FileHash)
+		}
+	}
+	if meta.StoredTime == 0 {
+		return
+	}
+	day := time.Unix(meta.StoredTime, 0).UTC().Truncate(24 * time.Hour).Unix()
+	if dayUsage, ok := u.days[day]; ok {
+		dayUsage.Slices--
+		dayUsage.Bytes -= meta.Size
+		if dayUsage.Slices <= 0 {
+			delete(u.days, day)
+		}
+	}
+}
+
+// applySliceUsage updates the totals with an entry appended to the index. It must be called with sliceIndexMutex held
+func applySliceUsage(meta SliceMeta) {
+	if sliceUsage != nil {
+		sliceUsage.apply(meta)
+	}
+}
+
+func resetSliceUsage() {
+	sliceIndexMutex.Lock()
+	defer sliceIndexMutex.Unlock()
+	sliceUsage = nil
+}
+
+// readSliceUsage calls fn with the totals, building them from the index first if needed. The size and stored time of
+// the slices indexed before they were recorded are taken from the storage
+func readSliceUsage(fn func(u *sliceUsageTotals)) error {
+	// opening the store reads the index
+	store, err := getSliceStore()
+	if err != nil {
+		return err
+	}
+	sliceIndexMutex.Lock()
+	defer sliceIndexMutex.Unlock()
+	if sliceUsage == nil {
+		metas, err := loadSliceMetas()
+		if err != nil {
+			return err
+		}
+		incomplete := false
+		for _, meta := range metas {
+			incomplete = incomplete || meta.Size == 0 || meta.StoredTime == 0
+		}
+		if incomplete {
+			err = store.Walk(func(sliceHash string, size int64, modTime time.Time) error {
+				meta, ok := metas[sliceHash]
+				if !ok {
+					return nil
+				}
+				if meta.Size == 0 {
+					meta.Size = size
+				}
+				if meta.StoredTime == 0 {
+					meta.StoredTime = modTime.Unix()
+				}
+				metas[sliceHash] = meta
+				return nil
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed walking the storage")
+			}
+		}
+
+		usage := &sliceUsageTotals{
+			slices: make(map[string]SliceMeta, len(metas)),
+			files:  make(map[string]*FileUsage),
+			days:   make(map[int64]*DayUsage),
+		}
+		for _, meta := range metas {
+			usage.apply(meta)
+		}
+		sliceUsage = usage
+	}
+	fn(sliceUsage)
+	return nil
+}
+
+// GetFilesUsage returns the files with the most bytes stored, up to count
+func GetFilesUsage(count int) ([]FileUsage, error) {
+	var result []FileUsage
+	err := readSliceUsage(func(u *sliceUsageTotals) {
+		result = make([]FileUsage, 0, len(u.files))
+		for _, usage := range u.files {
+			result = append(result, *usage)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Bytes != result[j].Bytes {
+			return result[i].Bytes > result[j].Bytes
+		}
+		return result[i].FileHash < result[j].FileHash
+	})
+	if count > 0 && len(result) > count {
+		result = result[:count]
+	}
+	return result, nil
+}
+
+// GetOwnersUsage returns the bytes stored for each file owner, the most first. The slices whose owner is unknown are
+// counted under an empty owner
+func GetOwnersUsage() ([]OwnerUsage, error) {
+	files, err := GetFilesUsage(0)
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[string]*OwnerUsage)
+	for _, fileUsage := range files {
+		usage, ok := owners[fileUsage.Owner]
+		if !ok {
+			usage = &OwnerUsage{Owner: fileUsage.Owner}
+			owners[fileUsage.Owner] = usage
+		}
+		usage.Files++
+		usage.Slices += fileUsage.Slices
+		usage.Bytes += fileUsage.Bytes
+	}
+
+	result := make([]OwnerUsage, 0, len(owners))
+	for _, usage := range owners {
+		result = append(result, *usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Bytes != result[j].Bytes {
+			return result[i].Bytes > result[j].Bytes
+		}
+		return result[i].Owner < result[j].Owner
+	})
+	return result, nil
+}
+
+// GetDailyUsage returns the slices stored on each of the last days, the oldest first. The slices stored before the
+// slice index existed are counted on the day they were last written
+func GetDailyUsage(days int) ([]DayUsage, error) {
+	if days <= 0 {
+		return nil, errors.New("the number of days must be positive")
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	result := make([]DayUsage, days)
+	err := readSliceUsage(func(u *sliceUsageTotals) {
+		for i := range result {
+			day := today.AddDate(0, 0, i-days+1)
+			result[i].Day = day.Format("2006-01-02")
+			if usage, ok := u.days[day.Unix()]; ok {
+				result[i] = *usage
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestSliceUsage(t *testing.T) {
+	setting.Config = setting.DefaultConfig()
+	setting.Config.Home.StoragePath = t.TempDir()
+	defer func() { _ = CloseSliceStore() }()
+
+	slices := []struct {
+		hash, file, owner string
+		size              int
+	}{
+		{"aaaaaaaaaa01", "file1", "owner1", 100},
+		{"aaaaaaaaaa02", "file1", "owner1", 100},
+		{"bbbbbbbbbb01", "file2", "owner1", 50},
+		{"cccccccccc01", "file3", "", 300},
+	}
+	for i, s := range slices {
+		if err := SaveSliceData(make([]byte, s.size), s.hash, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := CommitSliceData(s.hash, s.file, uint64(i+1), s.owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := DeleteSlice("aaaaaaaaaa02"); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := GetFilesUsage(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].FileHash != "file3" || files[1] != (FileUsage{FileHash: "file1", Owner: "owner1", Slices: 1, Bytes: 100}) {
+		t.Fatalf("wrong top files %+v", files)
+	}
+	owners, err := GetOwnersUsage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(owners) != 2 || owners[1] != (OwnerUsage{Owner: "owner1", Files: 2, Slices: 2, Bytes: 150}) {
+		t.Fatalf("wrong owners %+v", owners)
+	}
+	days, err := GetDailyUsage(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 3 || days[0].Slices != 0 || days[2].Slices != 3 || days[2].Bytes != 450 {
+		t.Fatalf("wrong slices per day %+v", days)
+	}
+
+	// the totals follow the index, a slice stored before it existed counts on the day it was written once backfilled
+	if err = DeleteSlice("bbbbbbbbbb01"); err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(t.TempDir(), "file")
+	if err = os.WriteFile(filePath, []byte("file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fileHash, err := crypto.CalcFileHash(filePath, "", crypto.SDS_CODEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 10)
+	sliceHash, err := crypto.CalcSliceHash(data, fileHash, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = SaveSliceData(data, sliceHash, 0); err != nil {
+		t.Fatal(err)
+	}
+	yesterday := time.Now().Add(-24 * time.Hour)
+	slicePath, _ := (&dirSliceStore{path: setting.Config.Home.StoragePath}).slicePath(sliceHash)
+	if err = os.Chtimes(slicePath, yesterday, yesterday); err != nil {
+		t.Fatal(err)
+	}
+	unindexed, err := FindUnindexedSlices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := unindexed[sliceHash]
+	meta.FileHash, meta.SliceNumber = fileHash, 1
+	if err = BackfillSliceMeta(meta); err != nil {
+		t.Fatal(err)
+	}
+	if days, err = GetDailyUsage(3); err != nil {
+		t.Fatal(err)
+	}
+	if days[1].Slices != 1 || days[1].Bytes != 10 || days[2].Slices != 2 || days[2].Bytes != 400 {
+		t.Fatalf("wrong slices per day after the changes %+v", days)
+	}
+}
@@ -0,0 +1,132 @@
+package file
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const storageCheckLogInterval = time.Minute
+
+// StorageCheck the differences between the slices on disk and the slice index, which holds the slices the SP was told
+// the node stores. The slices left partial by a crash during their transfer are orphans, as they were never indexed.
+// Until the slices stored before the index existed are added to it, the slices missing from it can't be told apart
+// from orphans
+type StorageCheck struct {
+	Slices    int              // slices found on disk
+	Bytes     int64            // size of the slices found on disk
+	Orphans   map[string]int64 // slices on disk missing from the index, with their size
+	Unindexed int              // slices on disk missing from the index, while the index isn't backfilled yet
+	Missing   []SliceMeta      // slices of the index missing from the disk
+	Damaged   []SliceMeta      // slices of the index which are empty, or don't match their hash when verified
+	Lost      int              // slices already waiting to be reported as lost to the SP
+}
+
+// CheckStorage compares the slices on disk with the slice index. When verify is set, the indexed slices are read and
+// hashed again to find the ones left partial. The node must not be running
+func CheckStorage(verify bool) (*StorageCheck, error) {
+	store, err := getSliceStore()
+	if err != nil {
+		return nil, err
+	}
+	metas, err := LoadSliceMetas()
+	if err != nil {
+		return nil, err
+	}
+	lost := make(map[string]bool)
+	for _, meta := range GetLostSlices() {
+		lost[meta.SliceHash] = true
+	}
+
+	backfilled := IsSliceIndexBackfilled()
+	check := &StorageCheck{Orphans: make(map[string]int64), Lost: len(lost)}
+	found := make(map[string]bool)
+	lastLog := time.Now()
+	err = store.Walk(func(sliceHash string, size int64, _ time.Time) error {
+		check.Slices++
+		check.Bytes += size
+		found[sliceHash] = true
+		if time.Since(lastLog) >= storageCheckLogInterval {
+			utils.Logf("Checked %v slices", check.Slices)
+			lastLog = time.Now()
+		}
+
+		meta, ok := metas[sliceHash]
+		switch {
+		case !ok && !backfilled:
+			check.Unindexed++
+		case !ok:
+			check.Orphans[sliceHash] = size
+		case lost[sliceHash]:
+		case size == 0:
+			check.Damaged = append(check.Damaged, meta)
+		case verify:
+			if !verifySlice(meta) {
+				check.Damaged = append(check.Damaged, meta)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed walking the storage")
+	}
+
+	for sliceHash, meta := range metas {
+		if !found[sliceHash] && !lost[sliceHash] {
+			check.Missing = append(check.Missing, meta)
+		}
+	}
+	sort.Slice(check.Missing, func(i, j int) bool { return check.Missing[i].SliceHash < check.Missing[j].SliceHash })
+	sort.Slice(check.Damaged, func(i, j int) bool { return check.Damaged[i].SliceHash < check.Damaged[j].SliceHash })
+	return check, nil
+}
+
+func verifySlice(meta SliceMeta) bool {
+	data, err := GetSliceData(meta.SliceHash)
+	if err != nil {
+		utils.ErrorLogf("failed reading slice %v: %v", meta.SliceHash, err.Error())
+		return false
+	}
+	return crypto.VerifySliceHash(data, meta.FileHash, meta.SliceNumber, meta.SliceHash)
+}
+
+// QuarantineOrphanSlices moves slices missing from the slice index to the quarantine folder, from where they can be
+// restored. It is refused until the slices stored before the index existed are added to it
+func QuarantineOrphanSlices(sliceHashes []string) error {
+	if !IsSliceIndexBackfilled() {
+		return errors.New("the slice index is not backfilled yet, the slices missing from it may not be orphans")
+	}
+	for _, sliceHash := range sliceHashes {
+		if err := quarantineSliceData(sliceHash); err != nil {
+			return errors.Wrapf(err, "failed quarantining slice %v", sliceHash)
+		}
+	}
+	return nil
+}
+
+// MarkSlicesLost marks slices of the index lost, so the SP is asked to replicate them again when the node registers.
+// The damaged slices still on disk are moved to quarantine first
+func MarkSlicesLost(slices []SliceMeta) error {
+	store, err := getSliceStore()
+	if err != nil {
+		return err
+	}
+	for _, meta := range slices {
+		if _, err = store.Size(meta.SliceHash); err == nil {
+			if err = quarantineSliceData(meta.SliceHash); err != nil {
+				utils.ErrorLogf("failed quarantining slice %v, removing it: %v", meta.SliceHash, err.Error())
+				if err = store.Delete(meta.SliceHash); err != nil {
+					return errors.Wrapf(err, "failed removing slice %v", meta.SliceHash)
+				}
+			}
+		}
+		if err = markSliceLost(meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package file
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestCheckStorage(t *testing.T) {
+	setting.Config = setting.DefaultConfig()
+	setting.Config.Home.StoragePath = t.TempDir()
+	defer func() { _ = CloseSliceStore() }()
+
+	filePath := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(filePath, []byte("file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fileHash, err := crypto.CalcFileHash(filePath, "", crypto.SDS_CODEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var slices []string
+	for i := 1; i <= 3; i++ {
+		data := bytes.Repeat([]byte{byte(i)}, 100)
+		sliceHash, err := crypto.CalcSliceHash(data, fileHash, uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = SaveSliceData(data, sliceHash, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err = CommitSliceData(sliceHash, fileHash, uint64(i), ""); err != nil {
+			t.Fatal(err)
+		}
+		slices = append(slices, sliceHash)
+	}
+	// a slice partly written by a crash, one removed behind the back of the node, and one overwritten
+	if err := SaveSliceData([]byte("partial"), "cccccccccc01", 0); err != nil {
+		t.Fatal(err)
+	}
+	store, _ := getSliceStore()
+	if err := store.Delete(slices[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveSliceData([]byte("garbage"), slices[2], 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// no slice is an orphan until the slices stored before the index existed are added to it
+	check, err := CheckStorage(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(check.Orphans) != 0 || check.Unindexed != 1 {
+		t.Fatalf("the unindexed slice shouldn't be an orphan yet %+v", check)
+	}
+	if err = QuarantineOrphanSlices([]string{"cccccccccc01"}); err == nil {
+		t.Fatal("the orphans shouldn't be quarantined before the index is backfilled")
+	}
+	if err = MarkSliceIndexBackfilled(); err != nil {
+		t.Fatal(err)
+	}
+	if check, err = CheckStorage(true); err != nil {
+		t.Fatal(err)
+	}
+	if check.Slices != 3 || len(check.Orphans) != 1 || check.Orphans["cccccccccc01"] != 7 {
+		t.Fatalf("wrong orphan slices %+v", check)
+	}
+	if len(check.Missing) != 1 || check.Missing[0].SliceHash != slices[1] {
+		t.Fatalf("wrong missing slices %+v", check.Missing)
+	}
+	if len(check.Damaged) != 1 || check.Damaged[0].SliceHash != slices[2] {
+		t.Fatalf("wrong damaged slices %+v", check.Damaged)
+	}
+
+	if err = QuarantineOrphanSlices([]string{"cccccccccc01"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(filepath.Join(getQuarantineFolderPath(), "cccccccccc01")); err != nil {
+		t.Fatalf("the orphan slice should be in quarantine, %v", err)
+	}
+	if err = MarkSlicesLost(append(check.Missing, check.Damaged...)); err != nil {
+		t.Fatal(err)
+	}
+	// the lost slices are still known after a restart, until they are reported
+	_ = CloseSliceStore()
+	if check, err = CheckStorage(true); err != nil {
+		t.Fatal(err)
+	}
+	if check.Slices != 1 || len(check.Orphans)+len(check.Missing)+len(check.Damaged) != 0 || check.Lost != 2 {
+		t.Fatalf("the storage should be repaired %+v", check)
+	}
+	ForgetLostSlices(GetLostSlices())
+	if metas, _ := LoadSliceMetas(); len(metas) != 1 {
+		t.Fatalf("the reported slices should be removed from the index %+v", metas)
+	}
+}
@@ -0,0 +1,432 @@
+package file
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	// POOL_MARKER_FILE tells apart a disk used by the node from an empty mount point, when the disk is missing
+	POOL_MARKER_FILE = ".sds_storage_pool"
+	// POOL_LIST_FILE the disks that were used by the node, in the storage path
+	POOL_LIST_FILE = "pools.json"
+)
+
+// StorageUsage the disk usage of the storage, in bytes
+type StorageUsage struct {
+	Total uint64
+	Free  uint64
+	Used  uint64
+}
+
+func (u *StorageUsage) UsedPercent() float64 {
+	if u.Used+u.Free == 0 {
+		return 0
+	}
+	return float64(u.Used) / float64(u.Used+u.Free) * 100
+}
+
+type storagePool struct {
+	setting.StoragePoolConfig
+	store SliceStore // nil when the disk is missing
+}
+
+// usage returns the disk usage, with the total capped by the max usage of the pool
+func (p *storagePool) usage() (*StorageUsage, error) {
+	// the usage is the one of the folder containing the given path
+	stat, err := utils.GetDiskUsage(filepath.Join(p.Path, POOL_MARKER_FILE))
+	if err != nil {
+		return nil, err
+	}
+	usage := &StorageUsage{Total: stat.Total, Free: stat.Free, Used: stat.Used}
+	maxBytes := p.MaxUsage * 1024 * 1024 // MB to B
+	if maxBytes != 0 && maxBytes < usage.Total {
+		usage.Total = maxBytes
+		if usage.Used >= maxBytes {
+			usage.Free = 0
+		} else if maxBytes-usage.Used < usage.Free {
+			usage.Free = maxBytes - usage.Used
+		}
+	}
+	return usage, nil
+}
+
+// poolSliceStore spreads the slices over the disks of the storage pools. A slice is read from whichever disk holds it,
+// and the slices of a missing disk are reported as lost instead of failing the node
+type poolSliceStore struct {
+	pools []*storagePool
+	lost  []SliceMeta // slices indexed on the missing disks or marked lost, until they are reported
+	mutex sync.Mutex
+}
+
+func openPoolSliceStore(layout, storagePath string, configs []setting.StoragePoolConfig) (*poolSliceStore, error) {
+	known, err := loadKnownPools(storagePath)
+	if err != nil {
+		return nil, err
+	}
+	s := &poolSliceStore{}
+	configured := make(map[string]bool)
+	var missing []string
+	for _, config := range configs {
+		pool := &storagePool{StoragePoolConfig: config}
+		s.pools = append(s.pools, pool)
+		configured[config.Path] = true
+		if err = checkPoolDisk(config.Path, known[config.Path]); err != nil {
+			utils.ErrorLogf("storage pool %v is missing, its slices are lost: %v", config.Path, err.Error())
+			missing = append(missing, config.Path)
+			continue
+		}
+		if pool.store, err = newSliceStore(layout, config.Path); err != nil {
+			_ = s.Close()
+			return nil, errors.Wrapf(err, "failed opening storage pool %v", config.Path)
+		}
+		known[config.Path] = true
+	}
+	for path := range known {
+		if !configured[path] {
+			utils.ErrorLogf("storage pool %v is not configured anymore, its slices are lost", path)
+			missing = append(missing, path)
+			delete(known, path)
+		}
+	}
+	if err = saveKnownPools(storagePath, known); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+	if len(s.online()) == 0 {
+		return nil, errors.New("no storage pool is available")
+	}
+
+	metas, err := LoadSliceMetas()
+	if err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+	for _, meta := range metas {
+		if meta.Lost {
+			s.lost = append(s.lost, meta)
+			continue
+		}
+		for _, path := range missing {
+			if meta.Disk == path {
+				s.lost = append(s.lost, meta)
+			}
+		}
+		if s.damagedSliceMissing(meta) {
+			utils.ErrorLogf("slice %v was in a damaged segment, it is lost", meta.SliceHash)
+			if err = markSliceMetaLost(meta); err != nil {
+				_ = s.Close()
+				return nil, err
+			}
+			meta.Lost = true
+			s.lost = append(s.lost, meta)
+		}
+	}
+	return s, nil
+}
+
+// damagedSliceMissing tells whether the slice was on a pool whose store skipped damaged records when it was opened,
+// and isn't in it anymore
+func (s *poolSliceStore) damagedSliceMissing(meta SliceMeta) bool {
+	for _, pool := range s.online() {
+		if pool.Path != meta.Disk {
+			continue
+		}
+		if damaged, ok := pool.store.(interface{ Damaged() bool }); !ok || !damaged.Damaged() {
+			return false
+		}
+		_, err := pool.store.Size(meta.SliceHash)
+		return err != nil
+	}
+	return false
+}
+
+// checkPoolDisk creates the marker of a new disk, or checks it is still there for a disk used before
+func checkPoolDisk(path string, known bool) error {
+	marker := filepath.Join(path, POOL_MARKER_FILE)
+	if _, err := os.Stat(marker); err == nil {
+		return nil
+	}
+	if known {
+		return errors.New("the marker file of the disk is gone")
+	}
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(marker, nil, 0600)
+}
+
+func loadKnownPools(storagePath string) (map[string]bool, error) {
+	known := make(map[string]bool)
+	data, err := os.ReadFile(filepath.Join(storagePath, POOL_LIST_FILE))
+	if os.IsNotExist(err) {
+		return known, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading the list of storage pools")
+	}
+	var paths []string
+	if err = json.Unmarshal(data, &paths); err != nil {
+		return nil, errors.Wrap(err, "failed reading the list of storage pools")
+	}
+	for _, path := range paths {
+		known[path] = true
+	}
+	return known, nil
+}
+
+func saveKnownPools(storagePath string, known map[string]bool) error {
+	paths := make([]string, 0, len(known))
+	for path := range known {
+		paths = append(paths, path)
+	}
+	data, _ := json.Marshal(paths)
+	if err := os.MkdirAll(storagePath, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating the storage folder")
+	}
+	return errors.Wrap(os.WriteFile(filepath.Join(storagePath, POOL_LIST_FILE), data, 0600),
+		"failed writing the list of storage pools")
+}
+
+func (s *poolSliceStore) online() []*storagePool {
+	var pools []*storagePool
+	for _, pool := range s.pools {
+		if pool.store != nil {
+			pools = append(pools, pool)
+		}
+	}
+	return pools
+}
+
+// locate returns the pool holding the slice, sealed or not
+func (s *poolSliceStore) locate(sliceHash string) *storagePool {
+	for _, pool := range s.online() {
+		if _, err := pool.store.Size(sliceHash); err == nil {
+			return pool
+		}
+	}
+	return nil
+}
+
+// place chooses the pool of a new slice. The slices are spread by weight when the pools have one, and go to the pool
+// with the most free space otherwise
+func (s *poolSliceStore) place() (*storagePool, error) {
+	var candidates []*storagePool
+	var best *storagePool
+	var bestFree, totalWeight uint64
+	for _, pool := range s.online() {
+		usage, err := pool.usage()
+		if err != nil {
+			utils.ErrorLogf("failed getting the disk usage of storage pool %v: %v", pool.Path, err.Error())
+			continue
+		}
+		if usage.Free < uint64(setting.MaxSliceSize) {
+			continue
+		}
+		if pool.Weight > 0 {
+			candidates = append(candidates, pool)
+			totalWeight += pool.Weight
+		}
+		if best == nil || usage.Free > bestFree {
+			best, bestFree = pool, usage.Free
+		}
+	}
+	if best == nil {
+		return nil, errors.New("no space left in the storage pools")
+	}
+	if totalWeight == 0 {
+		return best, nil
+	}
+	n := uint64(rand.Int63n(int64(totalWeight)))
+	for _, pool := range candidates {
+		if n < pool.Weight {
+			return pool, nil
+		}
+		n -= pool.Weight
+	}
+	return best, nil
+}
+
+func (s *poolSliceStore) Save(sliceHash string, data []byte, offset uint64) error {
+	// the pieces of a slice go to the pool holding the first one
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	pool := s.locate(sliceHash)
+	if pool == nil {
+		var err error
+		if pool, err = s.place(); err != nil {
+			return err
+		}
+	}
+	return pool.store.Save(sliceHash, data, offset)
+}
+
+func (s *poolSliceStore) Seal(sliceHash string) error {
+	pool := s.locate(sliceHash)
+	if pool == nil {
+		return errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+	}
+	return pool.store.Seal(sliceHash)
+}
+
+func (s *poolSliceStore) Read(sliceHash string) (int64, [][]byte, error) {
+	pool := s.locate(sliceHash)
+	if pool == nil {
+		return 0, nil, errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+	}
+	return pool.store.Read(sliceHash)
+}
+
+func (s *poolSliceStore) Get(sliceHash string) ([]byte, error) {
+	pool := s.locate(sliceHash)
+	if pool == nil {
+		return nil, errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+	}
+	return pool.store.Get(sliceHash)
+}
+
+func (s *poolSliceStore) Size(sliceHash string) (int64, error) {
+	for _, pool := range s.online() {
+		if size, err := pool.store.Size(sliceHash); err == nil {
+			return size, nil
+		}
+	}
+	return 0, errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+}
+
+func (s *poolSliceStore) Delete(sliceHash string) error {
+	pool := s.locate(sliceHash)
+	if pool == nil {
+		return errors.Wrapf(os.ErrNotExist, "slice %v", sliceHash)
+	}
+	return pool.store.Delete(sliceHash)
+}
+
+func (s *poolSliceStore) Walk(fn func(sliceHash string, size int64, modTime time.Time) error) error {
+	for _, pool := range s.online() {
+		if err := pool.store.Walk(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *poolSliceStore) Close() error {
+	var err error
+	for _, pool := range s.online() {
+		if closeErr := pool.store.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Compact compacts the pools whose store needs it
+func (s *poolSliceStore) Compact() error {
+	for _, pool := range s.online() {
+		compactor, ok := pool.store.(interface{ Compact() error })
+		if !ok {
+			continue
+		}
+		if err := compactor.Compact(); err != nil {
+			return errors.Wrapf(err, "failed compacting storage pool %v", pool.Path)
+		}
+	}
+	return nil
+}
+
+// Disk returns the path of the pool holding the slice
+func (s *poolSliceStore) Disk(sliceHash string) string {
+	if pool := s.locate(sliceHash); pool != nil {
+		return pool.Path
+	}
+	return ""
+}
+
+// Usage returns the disk usage of all the pools together
+func (s *poolSliceStore) Usage() (*StorageUsage, error) {
+	total := &StorageUsage{}
+	var err error
+	for _, pool := range s.online() {
+		usage, usageErr := pool.usage()
+		if usageErr != nil {
+			err = usageErr
+			continue
+		}
+		total.Total += usage.Total
+		total.Free += usage.Free
+		total.Used += usage.Used
+	}
+	if total.Total == 0 && err != nil {
+		return nil, err
+	}
+	return total, nil
+}
+
+// GetStorageDiskUsage returns the disk usage of the storage pools together, capped by their max usage
+func GetStorageDiskUsage() (*StorageUsage, error) {
+	store, err := getSliceStore()
+	if err != nil {
+		return nil, err
+	}
+	return store.Usage()
+}
+
+// GetLostSlices returns the slices which were stored on a missing disk, or marked lost by a storage check
+func GetLostSlices() []SliceMeta {
+	store, err := getSliceStore()
+	if err != nil {
+		return nil
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return append([]SliceMeta(nil), store.lost...)
+}
+
+// markSliceLost marks a slice lost in the slice index, and keeps it until it is reported to the SP
+func markSliceLost(meta SliceMeta) error {
+	store, err := getSliceStore()
+	if err != nil {
+		return err
+	}
+	if err = markSliceMetaLost(meta); err != nil {
+		return err
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.lost = append(store.lost, meta)
+	return nil
+}
+
+// ForgetLostSlices removes the lost slices from the slice index once they are reported
+func ForgetLostSlices(slices []SliceMeta) {
+	store, err := getSliceStore()
+	if err != nil {
+		return
+	}
+	forgotten := make(map[string]bool)
+	for _, meta := range slices {
+		if err = removeSliceMeta(meta.SliceHash); err != nil {
+			utils.ErrorLog("failed removing slice from the index", err)
+		}
+		forgotten[meta.SliceHash] = true
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var lost []SliceMeta
+	for _, meta := range store.lost {
+		if !forgotten[meta.SliceHash] {
+			lost = append(lost, meta)
+		}
+	}
+	store.lost = lost
+}
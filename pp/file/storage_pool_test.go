@@ -0,0 +1,56 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestStoragePools(t *testing.T) {
+	setting.Config = setting.DefaultConfig()
+	setting.Config.Home.StoragePath = t.TempDir()
+	disk1, disk2 := t.TempDir(), t.TempDir()
+	setting.Config.Home.StoragePools = []setting.StoragePoolConfig{{Path: disk1}, {Path: disk2, Weight: 1}}
+	defer func() { _ = CloseSliceStore() }()
+
+	// only the weighted disk gets new slices
+	for i, sliceHash := range []string{"aaaaaaaaaa01", "aaaaaaaaaa02"} {
+		if err := SaveSliceData([]byte(sliceHash), sliceHash, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := CommitSliceData(sliceHash, "file", uint64(i+1), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if data, err := GetSliceData("aaaaaaaaaa01"); err != nil || string(data) != "aaaaaaaaaa01" {
+		t.Fatalf("wrong slice data %v, %v", string(data), err)
+	}
+	if _, err := os.Stat(filepath.Join(disk2, "aaaaaaaa", "aa", "aaaaaaaaaa02")); err != nil {
+		t.Fatal("the slice should be on the second disk", err)
+	}
+
+	// the second disk goes missing, its slices are lost
+	_ = CloseSliceStore()
+	if err := os.RemoveAll(disk2); err != nil {
+		t.Fatal(err)
+	}
+	if err := OpenSliceStore(); err != nil {
+		t.Fatal(err)
+	}
+	lost := GetLostSlices()
+	if len(lost) != 2 || lost[0].Disk != disk2 {
+		t.Fatalf("the slices of the missing disk should be lost, got %+v", lost)
+	}
+	ForgetLostSlices(lost)
+	if metas, _ := LoadSliceMetas(); len(metas) != 0 || len(GetLostSlices()) != 0 {
+		t.Fatal("the lost slices should be forgotten")
+	}
+	if err := SaveSliceData([]byte("data"), "bbbbbbbbbb01", 0); err != nil {
+		t.Fatal("the slices should go to the remaining disk", err)
+	}
+	if _, err := os.Stat(filepath.Join(disk1, "bbbbbbbb", "bb", "bbbbbbbbbb01")); err != nil {
+		t.Fatal("the slice should be on the first disk", err)
+	}
+}
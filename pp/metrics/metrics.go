@@ -61,6 +61,20 @@ var (
 		},
 		[]string{"checkpoint"})
 
+	ScrubSliceCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pp_scrub_slices_cnt",
+			Help: ": count of stored slices checked by the scrubber",
+		},
+		[]string{"result"})
+
+	ScrubLastPass = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "pp_scrub_last_pass",
+			Help: ": result of the last complete check of the stored slices",
+		},
+		[]string{"scrub_last_pass"})
+
 	IsLoggingPerformanceData bool
 
 	LogPerformanceStartTime int64
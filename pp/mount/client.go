@@ -0,0 +1,284 @@
+package mount
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/sds-msg/protos"
+	msgutils "github.com/stratosnet/sds/sds-msg/utils"
+
+	rpc_api "github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/rpc"
+)
+
+const (
+	// rpcNamespace the namespace of the public API on the IPC endpoint of the node
+	rpcNamespace = "remoterpc_"
+
+	// uploadStatusInterval how often the state of an uploaded file is polled until SP confirms it
+	uploadStatusInterval = 5 * time.Second
+
+	// downloadSignatureLifetime how long the download signature of a file is reused, below the time the node accepts
+	// a signed request for. The reads signed with the same signature share the download session on the node
+	downloadSignatureLifetime = 30 * time.Second
+)
+
+// Client requests the files of a wallet from the node it is attached to, signing every request with the wallet key
+type Client struct {
+	rpcClient     *rpc.Client
+	walletAddress string
+	privateKey    fwcryptotypes.PrivKey
+	publicKey     string
+
+	mutex              sync.Mutex
+	downloadSignatures map[string]downloadSignature // file hash -> signature of the reads
+}
+
+type downloadSignature struct {
+	signature rpc_api.Signature
+	reqTime   int64
+}
+
+// NewClient creates a client calling the node through rpcClient on behalf of the wallet
+func NewClient(rpcClient *rpc.Client, walletAddress string, privateKey fwcryptotypes.PrivKey) (*Client, error) {
+	publicKey, err := fwtypes.WalletPubKeyToBech32(privateKey.PubKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed encoding wallet public key")
+	}
+	return &Client{
+		rpcClient:     rpcClient,
+		walletAddress: walletAddress,
+		privateKey:    privateKey,
+		publicKey:     publicKey,
+
+		downloadSignatures: make(map[string]downloadSignature),
+	}, nil
+}
+
+func (c *Client) sign(message string) (rpc_api.Signature, error) {
+	sign, err := c.privateKey.Sign([]byte(message))
+	if err != nil {
+		return rpc_api.Signature{}, errors.Wrap(err, "wallet failed to sign message")
+	}
+	return rpc_api.Signature{
+		Address:   c.walletAddress,
+		Pubkey:    c.publicKey,
+		Signature: hex.EncodeToString(sign),
+	}, nil
+}
+
+func (c *Client) call(ctx context.Context, result interface{}, method string, param interface{}) error {
+	if err := c.rpcClient.CallContext(ctx, result, rpcNamespace+method, param); err != nil {
+		return errors.Wrap(err, "failed calling "+method)
+	}
+	return nil
+}
+
+func (c *Client) sequenceNumber(ctx context.Context) (string, error) {
+	var res rpc_api.GetOzoneResult
+	if err := c.call(ctx, &res, "requestGetOzone", rpc_api.ParamReqGetOzone{WalletAddr: c.walletAddress}); err != nil {
+		return "", err
+	}
+	if res.Return != rpc_api.SUCCESS {
+		return "", errors.New("failed getting the sequence number of the wallet, return " + res.Return)
+	}
+	return res.SequenceNumber, nil
+}
+
+// ListFiles returns every file of the wallet, reading all the pages of the file list
+func (c *Client) ListFiles(ctx context.Context) ([]rpc_api.FileInfo, error) {
+	var files []rpc_api.FileInfo
+	for page := uint64(0); ; page++ {
+		reqTime := time.Now().Unix()
+		signature, err := c.sign(msgutils.FindMyFileListWalletSignMessage(c.walletAddress, reqTime))
+		if err != nil {
+			return nil, err
+		}
+		var res rpc_api.FileListResult
+		err = c.call(ctx, &res, "requestList", rpc_api.ParamReqFileList{Signature: signature, PageId: page, ReqTime: reqTime})
+		if err != nil {
+			return nil, err
+		}
+		if res.Return != rpc_api.SUCCESS {
+			return nil, errors.New("failed listing the files of the wallet, return " + res.Return)
+		}
+		files = append(files, res.FileInfo...)
+		if len(res.FileInfo) == 0 || uint64(len(files)) >= res.TotalNumber {
+			return files, nil
+		}
+	}
+}
+
+// downloadSignature returns the signature of the reads of the file, signed again once too old
+func (c *Client) downloadSignature(ctx context.Context, fileHash string) (downloadSignature, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if signature, ok := c.downloadSignatures[fileHash]; ok && time.Since(time.Unix(signature.reqTime, 0)) < downloadSignatureLifetime {
+		return signature, nil
+	}
+
+	sn, err := c.sequenceNumber(ctx)
+	if err != nil {
+		return downloadSignature{}, err
+	}
+	reqTime := time.Now().Unix()
+	signature, err := c.sign(msgutils.GetFileDownloadWalletSignMessage(fileHash, c.walletAddress, sn, reqTime))
+	if err != nil {
+		return downloadSignature{}, err
+	}
+	// the signatures of the other files are too old by now as well
+	for hash, old := range c.downloadSignatures {
+		if time.Since(time.Unix(old.reqTime, 0)) >= downloadSignatureLifetime {
+			delete(c.downloadSignatures, hash)
+		}
+	}
+	c.downloadSignatures[fileHash] = downloadSignature{signature: signature, reqTime: reqTime}
+	return c.downloadSignatures[fileHash], nil
+}
+
+// ReadRange returns at most length bytes of the file starting at offset. The reads of a file share a signature, so the
+// node requests the storage info of the file and downloads each slice once for all of them
+func (c *Client) ReadRange(ctx context.Context, fileHash string, offset int64, length uint64) ([]byte, error) {
+	signature, err := c.downloadSignature(ctx, fileHash)
+	if err != nil {
+		return nil, err
+	}
+	var res rpc_api.Result
+	err = c.call(ctx, &res, "requestDownloadRange", rpc_api.ParamReqDownloadRange{
+		FileHandle: fwtypes.DataMeshId{Owner: c.walletAddress, Hash: fileHash}.String(),
+		Signature:  signature.signature,
+		ReqTime:    signature.reqTime,
+		Offset:     offset,
+		Length:     length,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Return != rpc_api.DOWNLOAD_OK {
+		// the signature may be the reason, the next read signs again
+		c.mutex.Lock()
+		delete(c.downloadSignatures, fileHash)
+		c.mutex.Unlock()
+		return nil, errors.Errorf("failed reading file %v, return %v %v", fileHash, res.Return, res.Detail)
+	}
+	return base64.StdEncoding.DecodeString(res.FileData)
+}
+
+// Upload uploads the local file to the wallet and returns its file hash once SP confirms it is stored
+func (c *Client) Upload(ctx context.Context, filePath string) (string, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return "", errors.Wrap(err, "failed getting file information")
+	}
+	fileHash, err := crypto.CalcFileHash(filePath, "", crypto.SDS_CODEC)
+	if err != nil {
+		return "", errors.Wrap(err, "failed calculating the file hash")
+	}
+
+	sn, err := c.sequenceNumber(ctx)
+	if err != nil {
+		return "", err
+	}
+	reqTime := time.Now().Unix()
+	signature, err := c.sign(msgutils.GetFileUploadWalletSignMessage(fileHash, c.walletAddress, sn, reqTime))
+	if err != nil {
+		return "", err
+	}
+	var res rpc_api.Result
+	err = c.call(ctx, &res, "requestUpload", rpc_api.ParamReqUploadFile{
+		FileName:        filepath.Base(filePath),
+		FileSize:        int(info.Size()),
+		FileHash:        fileHash,
+		Signature:       signature,
+		DesiredTier:     2,
+		AllowHigherTier: true,
+		ReqTime:         reqTime,
+		SequenceNumber:  sn,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// the node asks for the content of the file one slice at a time
+	for res.Return == rpc_api.UPLOAD_DATA {
+		data, err := file.GetFileData(filePath, &protos.SliceOffset{SliceOffsetStart: *res.OffsetStart, SliceOffsetEnd: *res.OffsetEnd})
+		if err != nil {
+			return "", errors.Wrap(err, "failed reading file data")
+		}
+		reqTime = time.Now().Unix()
+		signature, err = c.sign(msgutils.GetFileUploadWalletSignMessage(fileHash, c.walletAddress, sn, reqTime))
+		if err != nil {
+			return "", err
+		}
+		res = rpc_api.Result{}
+		err = c.call(ctx, &res, "uploadData", rpc_api.ParamUploadData{
+			FileHash:       fileHash,
+			Data:           base64.StdEncoding.EncodeToString(data),
+			Signature:      signature,
+			ReqTime:        reqTime,
+			SequenceNumber: sn,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	if res.Return != rpc_api.SUCCESS {
+		return "", errors.Errorf("failed uploading file %v, return %v %v", fileHash, res.Return, res.Detail)
+	}
+
+	if sn, err = c.sequenceNumber(ctx); err != nil {
+		return "", err
+	}
+	reqTime = time.Now().Unix()
+	if signature, err = c.sign(msgutils.GetFileUploadWalletSignMessage(fileHash, c.walletAddress, sn, reqTime)); err != nil {
+		return "", err
+	}
+	err = c.call(ctx, &res, "uploadSign", rpc_api.ParamUploadSign{
+		FileHash:       fileHash,
+		Signature:      signature,
+		SequenceNumber: sn,
+		ReqTime:        reqTime,
+	})
+	if err != nil {
+		return "", err
+	}
+	return fileHash, c.waitUploadFinished(ctx, fileHash)
+}
+
+func (c *Client) waitUploadFinished(ctx context.Context, fileHash string) error {
+	for {
+		reqTime := time.Now().Unix()
+		signature, err := c.sign(msgutils.GetFileStatusWalletSignMessage(fileHash, c.walletAddress, reqTime))
+		if err != nil {
+			return err
+		}
+		var res rpc_api.FileStatusResult
+		err = c.call(ctx, &res, "getFileStatus", rpc_api.ParamGetFileStatus{FileHash: fileHash, Signature: signature, ReqTime: reqTime})
+		if err != nil {
+			return err
+		}
+		switch res.FileUploadState {
+		case protos.FileUploadState_FINISHED:
+			return nil
+		case protos.FileUploadState_FAILED:
+			return errors.Errorf("failed uploading file %v, %v", fileHash, res.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "stopped waiting for the upload of file "+fileHash)
+		case <-time.After(uploadStatusInterval):
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package mount
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/framework/crypto/secp256k1"
+
+	rpc_api "github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/rpc"
+)
+
+// fakeNode serves the range reads of a file, counting the sequence number requests and the signatures
+type fakeNode struct {
+	data       []byte
+	snRequests int
+	signatures map[string]bool
+}
+
+func (n *fakeNode) RequestGetOzone(_ context.Context, _ rpc_api.ParamReqGetOzone) rpc_api.GetOzoneResult {
+	n.snRequests++
+	return rpc_api.GetOzoneResult{Return: rpc_api.SUCCESS, SequenceNumber: "1"}
+}
+
+func (n *fakeNode) RequestDownloadRange(_ context.Context, param rpc_api.ParamReqDownloadRange) rpc_api.Result {
+	n.signatures[param.Signature.Signature] = true
+	end := uint64(param.Offset) + param.Length
+	return rpc_api.Result{Return: rpc_api.DOWNLOAD_OK, FileData: base64.StdEncoding.EncodeToString(n.data[param.Offset:end])}
+}
+
+func TestReadRangeReusesSignature(t *testing.T) {
+	node := &fakeNode{data: []byte("0123456789"), signatures: make(map[string]bool)}
+	server := rpc.NewServer()
+	if err := server.RegisterName("remoterpc", node); err != nil {
+		t.Fatal(err)
+	}
+	key, err := secp256k1.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewClient(rpc.DialInProc(server), "wallet", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for offset := int64(0); offset < 10; offset += 2 {
+		data, err := client.ReadRange(ctx, "file1", offset, 2)
+		if err != nil || string(data) != string(node.data[offset:offset+2]) {
+			t.Fatalf("wrong range at %v: %q %v", offset, data, err)
+		}
+	}
+	if node.snRequests != 1 || len(node.signatures) != 1 {
+		t.Fatalf("the reads should share one signature, %v sequence number requests and %v signatures", node.snRequests, len(node.signatures))
+	}
+
+	// an old signature is signed again
+	signature := client.downloadSignatures["file1"]
+	signature.reqTime -= int64(downloadSignatureLifetime / time.Second)
+	client.downloadSignatures["file1"] = signature
+	if _, err = client.ReadRange(ctx, "file1", 0, 2); err != nil {
+		t.Fatal(err)
+	}
+	if node.snRequests != 2 {
+		t.Fatalf("expected the file to be signed again, %v sequence number requests", node.snRequests)
+	}
+}
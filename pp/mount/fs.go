@@ -0,0 +1,418 @@
+package mount
+
+import (
+	"context"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+
+	rpc_api "github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/pp/file"
+)
+
+const (
+	// blockSize the size of the ranges fetched from the node and cached, below the largest range returned by one RPC call
+	blockSize = 2 * 1024 * 1024
+
+	// listRefreshInterval how long the file list of the wallet is reused before it is requested again
+	listRefreshInterval = 30 * time.Second
+
+	// uploadTimeout the longest wait for a file copied into the mount to be uploaded
+	uploadTimeout = time.Hour
+)
+
+// Root the folder exposing the files of the wallet. New files can be copied into it when it is writable, they are
+// uploaded once closed
+type Root struct {
+	fs.Inode
+	client   *Client
+	writable bool
+
+	mutex    sync.Mutex
+	files    map[string]rpc_api.FileInfo
+	listTime time.Time
+	newFiles map[string]*newFile
+	blocks   sync.Map // cache file path -> *sync.Mutex, so each block is fetched once
+}
+
+var (
+	_ fs.NodeGetattrer = (*Root)(nil)
+	_ fs.NodeLookuper  = (*Root)(nil)
+	_ fs.NodeReaddirer = (*Root)(nil)
+	_ fs.NodeCreater   = (*Root)(nil)
+)
+
+// Mount mounts the files of the wallet at mountPoint. The returned server serves the mount until it is unmounted
+func Mount(mountPoint string, client *Client, writable bool) (*fuse.Server, error) {
+	root := &Root{
+		client:   client,
+		writable: writable,
+		files:    make(map[string]rpc_api.FileInfo),
+		newFiles: make(map[string]*newFile),
+	}
+	timeout := time.Second
+	server, err := fs.Mount(mountPoint, root, &fs.Options{
+		MountOptions: fuse.MountOptions{
+			FsName: "sds",
+			Name:   "sds",
+			// mount(2) directly when running as root, fusermount otherwise
+			DirectMount: true,
+		},
+		EntryTimeout: &timeout,
+		AttrTimeout:  &timeout,
+		UID:          uint32(os.Getuid()),
+		GID:          uint32(os.Getgid()),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed mounting the wallet at "+mountPoint)
+	}
+	return server, nil
+}
+
+func (r *Root) Getattr(_ context.Context, _ fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	out.Mode = syscall.S_IFDIR | 0555
+	if r.writable {
+		out.Mode |= 0200
+	}
+	return fs.OK
+}
+
+// refreshFiles requests the file list of the wallet again once the last one is too old
+func (r *Root) refreshFiles(ctx context.Context) syscall.Errno {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if time.Since(r.listTime) < listRefreshInterval {
+		return fs.OK
+	}
+	infos, err := r.client.ListFiles(ctx)
+	if err != nil {
+		utils.ErrorLog("failed listing the files of the wallet", err)
+		return syscall.EIO
+	}
+
+	files := make(map[string]rpc_api.FileInfo, len(infos))
+	for _, info := range infos {
+		name := strings.ReplaceAll(info.FileName, "/", "_")
+		if _, ok := files[name]; ok || name == "" {
+			// several files of the wallet share the name, the hash keeps them apart
+			name = info.FileHash + "_" + name
+		}
+		files[name] = info
+	}
+	r.files = files
+	r.listTime = time.Now()
+	return fs.OK
+}
+
+func (r *Root) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if errno := r.refreshFiles(ctx); errno != fs.OK {
+		return nil, errno
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if f, ok := r.newFiles[name]; ok {
+		f.fillAttr(&out.Attr)
+		return &f.Inode, fs.OK
+	}
+	info, ok := r.files[name]
+	if !ok {
+		return nil, syscall.ENOENT
+	}
+	node := &fileNode{root: r, info: info}
+	node.fillAttr(&out.Attr)
+	return r.NewInode(ctx, node, fs.StableAttr{Mode: syscall.S_IFREG, Ino: inodeNumber(info.FileHash)}), fs.OK
+}
+
+func (r *Root) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	if errno := r.refreshFiles(ctx); errno != fs.OK {
+		return nil, errno
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entries := make([]fuse.DirEntry, 0, len(r.files)+len(r.newFiles))
+	for name, info := range r.files {
+		entries = append(entries, fuse.DirEntry{Name: name, Mode: syscall.S_IFREG, Ino: inodeNumber(info.FileHash)})
+	}
+	for name := range r.newFiles {
+		if _, ok := r.files[name]; !ok {
+			entries = append(entries, fuse.DirEntry{Name: name, Mode: syscall.S_IFREG})
+		}
+	}
+	return fs.NewListDirStream(entries), fs.OK
+}
+
+func (r *Root) Create(ctx context.Context, name string, _, _ uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	if !r.writable {
+		return nil, nil, 0, syscall.EROFS
+	}
+	if errno := r.refreshFiles(ctx); errno != fs.OK {
+		return nil, nil, 0, errno
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.files[name]; ok {
+		return nil, nil, 0, syscall.EEXIST
+	}
+	if _, ok := r.newFiles[name]; ok {
+		return nil, nil, 0, syscall.EEXIST
+	}
+
+	// the file keeps its name on disk, the upload names the file of the wallet after it
+	path := filepath.Join(file.GetMountUploadFolderPath(), uuid.NewString(), name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		utils.ErrorLog("failed creating the folder of a new file", err)
+		return nil, nil, 0, syscall.EIO
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		utils.ErrorLog("failed creating a new file", err)
+		return nil, nil, 0, syscall.EIO
+	}
+
+	// the handle writes into the file, the upload starts when it is released
+	node := &newFile{root: r, name: name, path: path}
+	r.newFiles[name] = node
+	node.fillAttr(&out.Attr)
+	return r.NewInode(ctx, node, fs.StableAttr{Mode: syscall.S_IFREG}), f, fuse.FOPEN_DIRECT_IO, fs.OK
+}
+
+// uploaded removes the new file once its upload is over, the file list shows it from then on
+func (r *Root) uploaded(f *newFile) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.newFiles, f.name)
+	r.listTime = time.Time{}
+	if err := os.RemoveAll(filepath.Dir(f.path)); err != nil {
+		utils.ErrorLog("failed removing the copy of an uploaded file", err)
+	}
+}
+
+// readBlock returns a block of the file, from the cache or from the node
+func (r *Root) readBlock(ctx context.Context, info rpc_api.FileInfo, block uint64) ([]byte, error) {
+	size := info.FileSize - block*blockSize
+	if size > blockSize {
+		size = blockSize
+	}
+	path := file.GetMountCacheFilePath(info.FileHash, block)
+	lock, _ := r.blocks.LoadOrStore(path, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	if data, err := os.ReadFile(path); err == nil && uint64(len(data)) == size {
+		return data, nil
+	}
+	data, err := r.client.ReadRange(ctx, info.FileHash, int64(block*blockSize), size)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != size {
+		return nil, errors.Errorf("received %v bytes of block %v of file %v instead of %v", len(data), block, info.FileHash, size)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+		err = os.WriteFile(path+".part", data, 0600)
+	}
+	if err == nil {
+		err = os.Rename(path+".part", path)
+	}
+	if err != nil {
+		// the block is still returned, only the cache failed
+		utils.ErrorLog("failed caching a block of file "+info.FileHash, err)
+	}
+	return data, nil
+}
+
+// fileNode a file of the wallet, read from the node one block at a time
+type fileNode struct {
+	fs.Inode
+	root *Root
+	info rpc_api.FileInfo
+}
+
+var (
+	_ fs.NodeGetattrer = (*fileNode)(nil)
+	_ fs.NodeOpener    = (*fileNode)(nil)
+	_ fs.NodeReader    = (*fileNode)(nil)
+)
+
+func (n *fileNode) fillAttr(out *fuse.Attr) {
+	out.Mode = syscall.S_IFREG | 0444
+	out.Nlink = 1
+	out.Size = n.info.FileSize
+	out.Blocks = (n.info.FileSize + 511) / 512
+	out.Mtime = n.info.CreateTime
+	out.Ctime = n.info.CreateTime
+	out.Atime = n.info.CreateTime
+}
+
+func (n *fileNode) Getattr(_ context.Context, _ fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	n.fillAttr(&out.Attr)
+	return fs.OK
+}
+
+func (n *fileNode) Open(_ context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	if flags&(syscall.O_WRONLY|syscall.O_RDWR|syscall.O_APPEND|syscall.O_TRUNC) != 0 {
+		return nil, 0, syscall.EROFS
+	}
+	// the content of a file never changes, the kernel can keep it cached
+	return nil, fuse.FOPEN_KEEP_CACHE, fs.OK
+}
+
+func (n *fileNode) Read(ctx context.Context, _ fs.FileHandle, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	if off < 0 || uint64(off) >= n.info.FileSize {
+		return fuse.ReadResultData(nil), fs.OK
+	}
+	end := uint64(off) + uint64(len(dest))
+	if end > n.info.FileSize {
+		end = n.info.FileSize
+	}
+
+	read := 0
+	for pos := uint64(off); pos < end; {
+		block := pos / blockSize
+		data, err := n.root.readBlock(ctx, n.info, block)
+		if err != nil {
+			utils.ErrorLog("failed reading file "+n.info.FileHash, err)
+			return nil, syscall.EIO
+		}
+		blockEnd := (block + 1) * blockSize
+		if blockEnd > end {
+			blockEnd = end
+		}
+		read += copy(dest[read:], data[pos-block*blockSize:blockEnd-block*blockSize])
+		pos = blockEnd
+	}
+	return fuse.ReadResultData(dest[:read]), fs.OK
+}
+
+// newFile a file copied into the mount. It is kept on disk and uploaded to the wallet once the handle returned by
+// Create is closed
+type newFile struct {
+	fs.Inode
+	root *Root
+	name string
+	path string
+}
+
+var (
+	_ fs.NodeGetattrer = (*newFile)(nil)
+	_ fs.NodeSetattrer = (*newFile)(nil)
+	_ fs.NodeOpener    = (*newFile)(nil)
+	_ fs.NodeReader    = (*newFile)(nil)
+	_ fs.NodeWriter    = (*newFile)(nil)
+	_ fs.NodeReleaser  = (*newFile)(nil)
+)
+
+func (n *newFile) fillAttr(out *fuse.Attr) {
+	out.Mode = syscall.S_IFREG | 0644
+	out.Nlink = 1
+	if info, err := os.Stat(n.path); err == nil {
+		modTime := info.ModTime()
+		out.Size = uint64(info.Size())
+		out.Blocks = (out.Size + 511) / 512
+		out.SetTimes(nil, &modTime, &modTime)
+	}
+}
+
+func (n *newFile) Getattr(_ context.Context, _ fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	n.fillAttr(&out.Attr)
+	return fs.OK
+}
+
+func (n *newFile) Setattr(_ context.Context, f fs.FileHandle, in *fuse.SetAttrIn, out *fuse.AttrOut) syscall.Errno {
+	if size, ok := in.GetSize(); ok {
+		file, ok := f.(*os.File)
+		if !ok {
+			return syscall.EBUSY
+		}
+		if err := file.Truncate(int64(size)); err != nil {
+			return fs.ToErrno(err)
+		}
+	}
+	n.fillAttr(&out.Attr)
+	return fs.OK
+}
+
+// Open only reads the file, it is written through the handle returned by Create
+func (n *newFile) Open(_ context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	if flags&(syscall.O_WRONLY|syscall.O_RDWR|syscall.O_APPEND|syscall.O_TRUNC) != 0 {
+		return nil, 0, syscall.EBUSY
+	}
+	return nil, fuse.FOPEN_DIRECT_IO, fs.OK
+}
+
+func (n *newFile) Read(_ context.Context, _ fs.FileHandle, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	f, err := os.Open(n.path)
+	if err != nil {
+		return nil, fs.ToErrno(err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	read, err := f.ReadAt(dest, off)
+	if err != nil && err != io.EOF {
+		return nil, fs.ToErrno(err)
+	}
+	return fuse.ReadResultData(dest[:read]), fs.OK
+}
+
+func (n *newFile) Write(_ context.Context, f fs.FileHandle, data []byte, off int64) (uint32, syscall.Errno) {
+	file, ok := f.(*os.File)
+	if !ok {
+		return 0, syscall.EBADF
+	}
+	written, err := file.WriteAt(data, off)
+	if err != nil {
+		return uint32(written), fs.ToErrno(err)
+	}
+	return uint32(written), fs.OK
+}
+
+// Release uploads the file when the handle returned by Create is closed
+func (n *newFile) Release(_ context.Context, f fs.FileHandle) syscall.Errno {
+	file, ok := f.(*os.File)
+	if !ok {
+		return fs.OK
+	}
+	if err := file.Close(); err != nil {
+		utils.ErrorLog("failed closing new file "+n.name, err)
+		n.root.uploaded(n)
+		return fs.ToErrno(err)
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), uploadTimeout)
+		defer cancel()
+		utils.Log("Uploading " + n.name + " copied into the mount")
+		fileHash, err := n.root.client.Upload(ctx, n.path)
+		if err != nil {
+			utils.ErrorLog("failed uploading "+n.name, err)
+		} else {
+			utils.Logf("Uploaded %v, file hash %v", n.name, fileHash)
+		}
+		n.root.uploaded(n)
+	}()
+	return fs.OK
+}
+
+// inodeNumber a stable inode number for the file, so the kernel recognizes it across lookups
+func inodeNumber(fileHash string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(fileHash))
+	// below the automatic inode numbers of go-fuse, and never 1 which is the root
+	return h.Sum64()>>1 | 2
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/stratosnet/sds/framework/core"
 	"github.com/stratosnet/sds/framework/crypto"
@@ -70,6 +71,12 @@ type fileUploadOffset struct {
 type rpcPubApi struct {
 }
 
+// isReqTimeFresh checks the time of a signed client request, so that the request can't be replayed later
+func isReqTimeFresh(reqTime int64) bool {
+	diff := time.Now().Unix() - reqTime
+	return diff <= setting.SpamThresholdReqTime && diff >= -setting.SpamThresholdReqTime
+}
+
 func RpcPubApi() *rpcPubApi {
 	return &rpcPubApi{}
 }
@@ -124,6 +131,70 @@ func ResultHook(r *rpc_api.Result, fileHash string) *rpc_api.Result {
 	return r
 }
 
+// fetchRemoteFileSlicesByContent fetches the file from the remote client from beginning to end and cuts it into slices at
+// content defined boundaries. Each byte of the file is requested once
+func fetchRemoteFileSlicesByContent(fileHash, compression string, fileSize uint64) ([]*protos.SliceHashAddr, error) {
+	var slices []*protos.SliceHashAddr
+	var buf []byte
+	fetched := uint64(0)
+	sliceStart := uint64(0)
+	for sliceNumber := uint64(1); sliceStart < fileSize; sliceNumber++ {
+		// top up the buffer, so that the largest slice can be cut from it
+		if fetched < fileSize {
+			fetchEnd := sliceStart + setting.MaxSliceSize
+			if fetchEnd > fileSize {
+				fetchEnd = fileSize
+			}
+			tmpName := uuid.NewString()
+			offset := &protos.SliceOffset{SliceOffsetStart: fetched, SliceOffsetEnd: fetchEnd}
+			if err := file.CacheRemoteFileData(fileHash, offset, fileHash, tmpName, false); err != nil {
+				return nil, err
+			}
+			data, err := file.GetSliceDataFromTmp(fileHash, tmpName)
+			_ = os.Remove(file.GetTmpSlicePath(fileHash, tmpName))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed reading file data from cache")
+			}
+			buf = append(buf, data...)
+			fetched = fetchEnd
+		}
+
+		sliceSize := file.ContentDefinedCut(buf)
+		data, err := sealRemoteSliceData(buf[:sliceSize], compression)
+		if err != nil {
+			return nil, err
+		}
+		sliceHash, err := crypto.CalcSliceHash(data, fileHash, sliceNumber)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed calculating slice hash")
+		}
+		if err = file.SaveTmpSliceData(fileHash, sliceHash, data); err != nil {
+			return nil, err
+		}
+		slices = append(slices, &protos.SliceHashAddr{
+			SliceHash:   sliceHash,
+			SliceSize:   uint64(sliceSize),
+			SliceNumber: sliceNumber,
+			SliceOffset: &protos.SliceOffset{
+				SliceOffsetStart: sliceStart,
+				SliceOffsetEnd:   sliceStart + uint64(sliceSize),
+			},
+		})
+		sliceStart += uint64(sliceSize)
+		buf = buf[:copy(buf, buf[sliceSize:])]
+	}
+	return slices, nil
+}
+
+// sealRemoteSliceData wraps a slice of a file uploaded through rpc into its envelope when it is compressed
+func sealRemoteSliceData(rawData []byte, compression string) ([]byte, error) {
+	if compression == "" {
+		return rawData, nil
+	}
+	data, err := file.SealSliceData(rawData, compression)
+	return data, errors.Wrap(err, "failed compressing slice")
+}
+
 func (api *rpcPubApi) RequestUpload(ctx context.Context, param rpc_api.ParamReqUploadFile) rpc_api.Result {
 	metrics.RpcReqCount.WithLabelValues("RequestUpload").Inc()
 	fileHash := param.FileHash
@@ -139,6 +210,10 @@ func (api *rpcPubApi) RequestUpload(ctx context.Context, param rpc_api.ParamReqU
 	if !fwtypes.VerifyWalletSign(pubkey, signature, msgutils.GetFileUploadWalletSignMessage(fileHash, walletAddr, param.SequenceNumber, reqTime)) {
 		return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
 	}
+	compression, err := file.CheckCompression(param.Compression)
+	if err != nil {
+		return rpc_api.Result{Return: rpc_api.WRONG_INPUT, Detail: err.Error()}
+	}
 	if _, ok := uploadOffset.Load(fileHash); ok {
 		return rpc_api.Result{Return: rpc_api.CONFLICT_WITH_ANOTHER_SESSION}
 	}
@@ -153,8 +228,6 @@ func (api *rpcPubApi) RequestUpload(ctx context.Context, param rpc_api.ParamReqU
 		metrics.UploadPerformanceLogNow(param.FileHash + ":RCV_REQ_UPLOAD_CLIENT")
 		fileName := param.FileName
 		fileSize := uint64(param.FileSize)
-		sliceSize := uint64(setting.MaxSliceSize)
-		sliceCount := uint64(math.Ceil(float64(fileSize) / float64(sliceSize)))
 		defer func() {
 			wait <- true
 		}()
@@ -163,41 +236,64 @@ func (api *rpcPubApi) RequestUpload(ctx context.Context, param rpc_api.ParamReqU
 		}()
 		defer uploadOffset.Delete(fileHash)
 		var slices []*protos.SliceHashAddr
-		for sliceNumber := uint64(1); sliceNumber <= sliceCount; sliceNumber++ {
-			sliceOffset := requests.GetSliceOffset(sliceNumber, sliceCount, sliceSize, fileSize)
-
-			tmpSliceName := uuid.NewString()
-			var rawData []byte
+		if param.ContentDefinedChunking {
 			var err error
-			if file.CacheRemoteFileData(fileHash, sliceOffset, fileHash, tmpSliceName, false) != nil {
-				return
-			}
-
-			rawData, err = file.GetSliceDataFromTmp(fileHash, tmpSliceName)
+			slices, err = fetchRemoteFileSlicesByContent(fileHash, compression, fileSize)
 			if err != nil {
-				_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed reading slice data from cache" + err.Error()})
+				_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed fetching file slices, " + err.Error()})
 				return
 			}
-
-			sliceHash, err := crypto.CalcSliceHash(rawData, fileHash, sliceNumber)
-			if err != nil {
-				_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed calculating slice hash" + err.Error()})
-				return
-			}
-
-			SliceHashAddr := &protos.SliceHashAddr{
-				SliceHash:   sliceHash,
-				SliceSize:   sliceOffset.SliceOffsetEnd - sliceOffset.SliceOffsetStart,
-				SliceNumber: sliceNumber,
-				SliceOffset: sliceOffset,
-			}
-
-			slices = append(slices, SliceHashAddr)
-
-			err = file.RenameTmpFile(fileHash, tmpSliceName, sliceHash)
-			if err != nil {
-				_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed renaming slice cache file" + err.Error()})
-				return
+		} else {
+			sliceSize := uint64(setting.MaxSliceSize)
+			sliceCount := uint64(math.Ceil(float64(fileSize) / float64(sliceSize)))
+			for sliceNumber := uint64(1); sliceNumber <= sliceCount; sliceNumber++ {
+				sliceOffset := requests.GetSliceOffset(sliceNumber, sliceCount, sliceSize, fileSize)
+
+				tmpSliceName := uuid.NewString()
+				var rawData []byte
+				var err error
+				if file.CacheRemoteFileData(fileHash, sliceOffset, fileHash, tmpSliceName, false) != nil {
+					return
+				}
+
+				rawData, err = file.GetSliceDataFromTmp(fileHash, tmpSliceName)
+				if err != nil {
+					_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed reading slice data from cache" + err.Error()})
+					return
+				}
+				if compression != "" {
+					// the envelope replaces the raw data in the cache file
+					rawData, err = sealRemoteSliceData(rawData, compression)
+					if err == nil {
+						_ = os.Remove(file.GetTmpSlicePath(fileHash, tmpSliceName))
+						err = file.SaveTmpSliceData(fileHash, tmpSliceName, rawData)
+					}
+					if err != nil {
+						_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed compressing slice data" + err.Error()})
+						return
+					}
+				}
+
+				sliceHash, err := crypto.CalcSliceHash(rawData, fileHash, sliceNumber)
+				if err != nil {
+					_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed calculating slice hash" + err.Error()})
+					return
+				}
+
+				SliceHashAddr := &protos.SliceHashAddr{
+					SliceHash:   sliceHash,
+					SliceSize:   sliceOffset.SliceOffsetEnd - sliceOffset.SliceOffsetStart,
+					SliceNumber: sliceNumber,
+					SliceOffset: sliceOffset,
+				}
+
+				slices = append(slices, SliceHashAddr)
+
+				err = file.RenameTmpFile(fileHash, tmpSliceName, sliceHash)
+				if err != nil {
+					_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed renaming slice cache file" + err.Error()})
+					return
+				}
 			}
 		}
 		uploadOffset.Delete(fileHash)
@@ -217,7 +313,7 @@ func (api *rpcPubApi) RequestUpload(ctx context.Context, param rpc_api.ParamReqU
 
 		// start to upload file
 		p, err := requests.RequestUploadFile(ctx, fileName, fileHash, fileSize, walletAddr, pubkey, s.Signature, reqTime,
-			slices, false, param.DesiredTier, param.AllowHigherTier, 0)
+			slices, "", compression, param.DesiredTier, param.AllowHigherTier, 0)
 		if err != nil {
 			_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed request upload file" + err.Error()})
 			return
@@ -404,7 +500,7 @@ func (api *rpcPubApi) RequestUploadStream(ctx context.Context, param rpc_api.Par
 			return
 		}
 
-		fileHandler := event.GetUploadFileHandler(true)
+		fileHandler := event.GetUploadFileHandler(true, event.SliceOptions{})
 		fInfo, slices, err := fileHandler.PreUpload(ctx, tmpFilePath, "")
 		if err != nil {
 			_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed handling pre_upload" + err.Error()})
@@ -413,7 +509,7 @@ func (api *rpcPubApi) RequestUploadStream(ctx context.Context, param rpc_api.Par
 
 		// start to upload file
 		p, err := requests.RequestUploadFile(ctx, fileName, fileHash, fileSize, walletAddr, pubkey, signature, reqTime,
-			slices, false, param.DesiredTier, param.AllowHigherTier, fInfo.Duration)
+			slices, "", "", param.DesiredTier, param.AllowHigherTier, fInfo.Duration)
 		if err != nil {
 			_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE, Detail: "failed request upload file" + err.Error()})
 			return
@@ -533,7 +629,11 @@ func (api *rpcPubApi) RequestDownload(ctx context.Context, param rpc_api.ParamRe
 	ctx = core.RegisterRemoteReqId(ctx, reqId)
 	req := requests.ReqFileStorageInfoData(ctx, param.FileHandle, "", "", wallet, wpk.Bytes(), wsig, nil, param.ReqTime)
 	p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, req, header.ReqFileStorageInfo)
+	return waitFirstDownloadPacket(ctx, fileHash, reqId)
+}
 
+// waitFirstDownloadPacket waits for the storage info of a download requested over RPC, then returns its first packet
+func waitFirstDownloadPacket(ctx context.Context, fileHash, reqId string) rpc_api.Result {
 	ctx, cancel := context.WithTimeout(ctx, INIT_WAIT_TIMEOUT)
 	defer file.UnsubscribeDownloadSlice(fileHash + reqId)
 	defer cancel()
@@ -558,7 +658,6 @@ func (api *rpcPubApi) RequestDownload(ctx context.Context, param rpc_api.ParamRe
 		}
 		return *result
 	}
-
 }
 
 func (api *rpcPubApi) RequestVideoDownload(ctx context.Context, param rpc_api.ParamReqDownloadFile) rpc_api.Result {
@@ -672,12 +771,50 @@ func (api *rpcPubApi) RequestDownloadSliceData(ctx context.Context, param rpc_ap
 	}
 }
 
+// RequestDownloadRange reads a byte range of a file. Only the slices holding the range are downloaded,
+// and at most FILE_DATA_SAFE_SIZE bytes are returned: OffsetEnd tells where the next read should start.
+// The reads signed with the same signature share the storage info of the file and the last downloaded slices
+func (api *rpcPubApi) RequestDownloadRange(ctx context.Context, param rpc_api.ParamReqDownloadRange) rpc_api.Result {
+	metrics.RpcReqCount.WithLabelValues("RequestDownloadRange").Inc()
+	r, release, result := openRangeSession(ctx, param.FileHandle, param.Signature, param.ReqTime)
+	if r == nil {
+		return result
+	}
+	defer release()
+
+	start, end, ok := r.Bounds(param.Offset, param.Length, FILE_DATA_SAFE_SIZE)
+	if !ok {
+		return rpc_api.Result{Return: rpc_api.WRONG_INPUT, Detail: "range out of the file", FileSize: r.FileInfo.FileSize}
+	}
+	data := make([]byte, 0, end-start)
+	err := r.Read(ctx, start, end, func(b []byte) error {
+		data = append(data, b...)
+		return nil
+	})
+	if err != nil {
+		utils.ErrorLog("failed reading the range of the file", err)
+		return rpc_api.Result{Return: rpc_api.TIME_OUT, Detail: err.Error()}
+	}
+
+	return rpc_api.Result{
+		Return:      rpc_api.DOWNLOAD_OK,
+		ReqId:       result.ReqId,
+		OffsetStart: &start,
+		OffsetEnd:   &end,
+		FileHash:    r.FileInfo.FileHash,
+		FileName:    r.FileInfo.FileName,
+		FileSize:    r.FileInfo.FileSize,
+		FileData:    b64.StdEncoding.EncodeToString(data),
+	}
+}
+
 func (api *rpcPubApi) DownloadData(ctx context.Context, param rpc_api.ParamDownloadData) rpc_api.Result {
 
 	// download from the cached file
 	var result *rpc_api.Result
 	data, start, end, finished := file.NextRemoteDownloadPacket(param.FileHash, param.ReqId)
 	if finished {
+		task.DeleteDownloadJournal(param.FileHash, param.ReqId)
 		result = &rpc_api.Result{
 			Return: rpc_api.DL_OK_ASK_INFO,
 		}
@@ -706,6 +843,82 @@ func (api *rpcPubApi) DownloadedFileInfo(ctx context.Context, param rpc_api.Para
 	return rpc_api.Result{Return: rpc_api.SUCCESS}
 }
 
+func (api *rpcPubApi) RequestListDownloads(ctx context.Context, param rpc_api.ParamReqListDownloads) rpc_api.DownloadListResult {
+	metrics.RpcReqCount.WithLabelValues("RequestListDownloads").Inc()
+	walletAddr := param.Signature.Address
+	pubkey := param.Signature.Pubkey
+	signature := param.Signature.Signature
+
+	// verify if wallet and public key match
+	if !fwtypes.VerifyWalletAddr(pubkey, walletAddr) {
+		return rpc_api.DownloadListResult{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if !fwtypes.VerifyWalletSign(pubkey, signature, msgutils.FindMyFileListWalletSignMessage(walletAddr, param.ReqTime)) {
+		return rpc_api.DownloadListResult{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if !isReqTimeFresh(param.ReqTime) {
+		return rpc_api.DownloadListResult{Return: rpc_api.TIME_OUT}
+	}
+
+	result := rpc_api.DownloadListResult{Return: rpc_api.SUCCESS}
+	for _, summary := range event.ListDownloadTasks(walletAddr) {
+		result.Downloads = append(result.Downloads, rpc_api.DownloadInfo{
+			FileHash:       summary.FileHash,
+			ReqId:          summary.FileReqId,
+			FileName:       summary.FileName,
+			SavePath:       summary.SavePath,
+			TotalSlices:    summary.TotalSlices,
+			FinishedSlices: summary.FinishedSlices,
+			UpdateTime:     summary.UpdateTime,
+			Ongoing:        summary.Ongoing,
+		})
+	}
+	return result
+}
+
+func (api *rpcPubApi) RequestResumeDownload(ctx context.Context, param rpc_api.ParamReqResumeDownload) rpc_api.Result {
+	metrics.RpcReqCount.WithLabelValues("RequestResumeDownload").Inc()
+	walletAddr := param.Signature.Address
+	pubkey := param.Signature.Pubkey
+	signature := param.Signature.Signature
+
+	// verify if wallet and public key match
+	if !fwtypes.VerifyWalletAddr(pubkey, walletAddr) {
+		return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if !fwtypes.VerifyWalletSign(pubkey, signature, msgutils.GetFileDownloadWalletSignMessage(param.FileHash, walletAddr, "", param.ReqTime)) {
+		return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if !isReqTimeFresh(param.ReqTime) {
+		return rpc_api.Result{Return: rpc_api.TIME_OUT}
+	}
+
+	// a download requested over RPC is resumed for its client, which then fetches the data as for a new download
+	if param.ReqId != "" && param.ReqId != task.LOCAL_REQID {
+		wpk, err := fwtypes.WalletPubKeyFromBech32(pubkey)
+		if err != nil {
+			return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+		}
+		wsig, err := hex.DecodeString(signature)
+		if err != nil {
+			return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+		}
+		err = event.ResumeRpcDownloadTask(ctx, param.FileHash, param.ReqId, walletAddr, wpk.Bytes(), wsig, param.ReqTime)
+		if err != nil {
+			return rpc_api.Result{Return: rpc_api.FILE_REQ_FAILURE, Detail: err.Error(), FileHash: param.FileHash}
+		}
+		return waitFirstDownloadPacket(ctx, param.FileHash, param.ReqId)
+	}
+	if walletAddr != setting.WalletAddress {
+		return rpc_api.Result{Return: rpc_api.WRONG_WALLET_ADDRESS}
+	}
+
+	if err := event.ResumeDownloadTask(ctx, param.FileHash); err != nil {
+		return rpc_api.Result{Return: rpc_api.FILE_REQ_FAILURE, Detail: err.Error(), FileHash: param.FileHash}
+	}
+	return rpc_api.Result{Return: rpc_api.SUCCESS, FileHash: param.FileHash}
+}
+
 func (api *rpcPubApi) RequestDeleteFile(ctx context.Context, param rpc_api.ParamReqDeleteFile) rpc_api.Result {
 	fileHash := param.FileHash
 	walletAddr := param.Signature.Address
@@ -1388,6 +1601,67 @@ func (api *rpcPrivApi) RequestStatus(ctx context.Context, param rpc_api.ParamReq
 	}
 }
 
+func (api *rpcPrivApi) RequestPeerReputation(ctx context.Context, param rpc_api.ParamReqPeerReputation) rpc_api.PeerReputationResult {
+	metrics.RpcReqCount.WithLabelValues("RequestPeerReputation").Inc()
+	if param.Clear {
+		return rpc_api.PeerReputationResult{Return: rpc_api.SUCCESS, Cleared: task.ClearPeerReputation(param.P2PAddress)}
+	}
+
+	now := time.Now()
+	result := rpc_api.PeerReputationResult{Return: rpc_api.SUCCESS}
+	for _, r := range task.GetPeerReputations() {
+		info := rpc_api.PeerReputationInfo{
+			P2PAddress: r.P2PAddress,
+			Score:      r.Score,
+			Successes:  r.Successes,
+			Failures:   r.Failures,
+			LatencyMs:  r.Latency.Milliseconds(),
+		}
+		if r.Blacklisted(now) {
+			info.BlacklistedUntil = r.BlacklistedUntil.Unix()
+		}
+		result.Peers = append(result.Peers, info)
+	}
+	return result
+}
+
+func (api *rpcPrivApi) RequestStorageUsage(ctx context.Context, param rpc_api.ParamReqStorageUsage) rpc_api.StorageUsageResult {
+	metrics.RpcReqCount.WithLabelValues("RequestStorageUsage").Inc()
+	result := rpc_api.StorageUsageResult{Return: rpc_api.SUCCESS}
+	switch param.By {
+	case "file":
+		files, err := file.GetFilesUsage(param.Count)
+		if err != nil {
+			return rpc_api.StorageUsageResult{Return: rpc_api.INTERNAL_DATA_FAILURE, Message: err.Error()}
+		}
+		for _, f := range files {
+			result.Files = append(result.Files, rpc_api.FileUsageInfo{FileHash: f.FileHash, Owner: f.Owner, Slices: f.Slices, Bytes: f.Bytes})
+		}
+	case "owner":
+		owners, err := file.GetOwnersUsage()
+		if err != nil {
+			return rpc_api.StorageUsageResult{Return: rpc_api.INTERNAL_DATA_FAILURE, Message: err.Error()}
+		}
+		for _, o := range owners {
+			result.Owners = append(result.Owners, rpc_api.OwnerUsageInfo{Owner: o.Owner, Files: o.Files, Slices: o.Slices, Bytes: o.Bytes})
+		}
+	case "day":
+		if param.Count <= 0 {
+			return rpc_api.StorageUsageResult{Return: rpc_api.WRONG_INPUT, Message: "count of days must be positive"}
+		}
+		days, err := file.GetDailyUsage(param.Count)
+		if err != nil {
+			return rpc_api.StorageUsageResult{Return: rpc_api.INTERNAL_DATA_FAILURE, Message: err.Error()}
+		}
+		for _, d := range days {
+			result.Days = append(result.Days, rpc_api.DayUsageInfo{Day: d.Day, Slices: d.Slices, Bytes: d.Bytes})
+		}
+	default:
+		return rpc_api.StorageUsageResult{Return: rpc_api.WRONG_INPUT, Message: "by must be file, owner or day"}
+	}
+	return result
+}
+
 func (api *rpcPubApi) RequestServiceStatus(ctx context.Context, param rpc_api.ParamReqServiceStatus) rpc_api.ServiceStatusResult {
 	metrics.RpcReqCount.WithLabelValues("RequestServiceStatus").Inc()
 	reqId := uuid.New().String()
@@ -0,0 +1,342 @@
+package namespace
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg/header"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/sds-msg/protos"
+
+	rpc_api "github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/pp/event"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/p2pserver"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/task"
+)
+
+const (
+	// RANGE_CACHED_SLICES how many downloaded slices a download session keeps for the next reads
+	RANGE_CACHED_SLICES = 2
+
+	// RANGE_SESSION_TTL how long an unused download session of the RPC range reads is kept open
+	RANGE_SESSION_TTL = time.Duration(setting.SpamThresholdReqTime) * time.Second
+)
+
+// rangeSessions the download sessions of the RPC range reads, by file handle and wallet signature
+var rangeSessions = &sync.Map{}
+
+// RangeDownload is a download session reading byte ranges of a file. Only the slices holding a range are downloaded,
+// the last downloaded slices are kept for the next reads
+type RangeDownload struct {
+	FileInfo *protos.RspFileStorageInfo
+	fileHash string
+	wallet   string
+	reqId    string
+
+	mutex      sync.Mutex
+	slices     map[string][]byte
+	sliceOrder []string
+}
+
+// rangeSession a download session reused by the RPC range reads signed with the same signature. It is closed once
+// unused for RANGE_SESSION_TTL
+type rangeSession struct {
+	*RangeDownload
+	ready  chan struct{}
+	result rpc_api.Result
+
+	usage  sync.Mutex
+	users  int
+	closed bool
+	timer  *time.Timer
+}
+
+// acquire marks the session as used, it isn't closed until released. It returns false when the session is closed
+func (s *rangeSession) acquire() bool {
+	s.usage.Lock()
+	defer s.usage.Unlock()
+	if s.closed {
+		return false
+	}
+	s.users++
+	s.timer.Stop()
+	return true
+}
+
+func (s *rangeSession) release() {
+	s.usage.Lock()
+	defer s.usage.Unlock()
+	if s.users--; s.users == 0 {
+		s.timer.Reset(RANGE_SESSION_TTL)
+	}
+}
+
+// OpenRangeDownload requests the storage info of the file from SP. The session must be closed once the reads are done
+func OpenRangeDownload(ctx context.Context, fileHandle string, signature rpc_api.Signature, reqTime int64) (*RangeDownload, rpc_api.Result) {
+	_, ownerWalletAddress, fileHash, _, err := fwtypes.ParseFileHandle(fileHandle)
+	if err != nil {
+		return nil, rpc_api.Result{Return: rpc_api.WRONG_INPUT}
+	}
+	wallet := signature.Address
+
+	if ownerWalletAddress != wallet {
+		utils.ErrorLog("only the file owner is allowed to download via sdm url")
+		return nil, rpc_api.Result{Return: rpc_api.WRONG_WALLET_ADDRESS}
+	}
+
+	// wallet pubkey and wallet signature will be carried in sds messages in []byte format
+	wpk, err := fwtypes.WalletPubKeyFromBech32(signature.Pubkey)
+	if err != nil {
+		utils.ErrorLog("wrong wallet pubkey")
+		return nil, rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	wsig, err := hex.DecodeString(signature.Signature)
+	if err != nil {
+		utils.ErrorLog("wrong signature")
+		return nil, rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+
+	// verify if wallet and public key match
+	if !fwtypes.VerifyWalletAddrBytes(wpk.Bytes(), wallet) {
+		return nil, rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+
+	r := &RangeDownload{
+		fileHash: fileHash,
+		wallet:   wallet,
+		reqId:    uuid.New().String(),
+		slices:   make(map[string][]byte),
+	}
+	key := fileHash + r.reqId
+	events := file.SubscribeRangeDownload(key)
+
+	ctx = core.RegisterRemoteReqId(ctx, r.reqId)
+	req := requests.RequestDownloadFile(ctx, fileHash, fileHandle, wallet, r.reqId, wsig, wpk.Bytes(), nil, reqTime)
+	p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, req, header.ReqFileStorageInfo)
+
+	ctx, cancel := context.WithTimeout(ctx, INIT_WAIT_TIMEOUT)
+	defer cancel()
+	select {
+	case <-ctx.Done():
+		r.Close(ctx)
+		return nil, rpc_api.Result{Return: rpc_api.TIME_OUT}
+	case result := <-events:
+		if result.Return != rpc_api.DOWNLOAD_OK {
+			r.Close(ctx)
+			return nil, *result
+		}
+	}
+
+	f, ok := task.DownloadFileMap.Load(key)
+	if !ok {
+		r.Close(ctx)
+		return nil, rpc_api.Result{Return: rpc_api.FILE_REQ_FAILURE, Detail: "missing file storage info"}
+	}
+	r.FileInfo = f.(*protos.RspFileStorageInfo)
+	return r, rpc_api.Result{Return: rpc_api.DOWNLOAD_OK, ReqId: r.reqId}
+}
+
+// openRangeSession returns the download session opened for the signature, or opens it. The storage info of the file
+// is requested from SP once for all the reads signed with the same signature. The returned function must be called
+// once the reads are done
+func openRangeSession(ctx context.Context, fileHandle string, signature rpc_api.Signature, reqTime int64) (*RangeDownload, func(), rpc_api.Result) {
+	// the signature is reused only while its time is fresh, SP checks it when opening a new session
+	if !isReqTimeFresh(reqTime) {
+		r, result := OpenRangeDownload(ctx, fileHandle, signature, reqTime)
+		if r == nil {
+			return nil, nil, result
+		}
+		return r, func() { r.Close(ctx) }, result
+	}
+
+	key := fileHandle + signature.Signature
+	for {
+		s := &rangeSession{ready: make(chan struct{})}
+		loaded, found := rangeSessions.LoadOrStore(key, s)
+		if found {
+			s = loaded.(*rangeSession)
+			<-s.ready
+			if s.RangeDownload == nil {
+				return nil, nil, s.result
+			}
+			if !s.acquire() {
+				// the session was closed meanwhile, a new one is opened
+				continue
+			}
+			return s.RangeDownload, s.release, s.result
+		}
+
+		s.RangeDownload, s.result = OpenRangeDownload(ctx, fileHandle, signature, reqTime)
+		if s.RangeDownload == nil {
+			rangeSessions.Delete(key)
+			close(s.ready)
+			return nil, nil, s.result
+		}
+		s.users = 1
+		s.timer = time.AfterFunc(RANGE_SESSION_TTL, func() {
+			s.usage.Lock()
+			if s.users > 0 || s.closed {
+				s.usage.Unlock()
+				return
+			}
+			rangeSessions.Delete(key)
+			s.closed = true
+			s.usage.Unlock()
+			s.Close(ctx)
+		})
+		s.timer.Stop()
+		close(s.ready)
+		return s.RangeDownload, s.release, s.result
+	}
+}
+
+// Bounds returns the range [start, end) of the file read from offset, at most maxLength bytes when maxLength isn't 0.
+// A negative offset is counted from the end of the file, a zero length reads up to the end of the file
+func (r *RangeDownload) Bounds(offset int64, length, maxLength uint64) (start, end uint64, ok bool) {
+	fileSize := r.FileInfo.FileSize
+	if offset < 0 {
+		if uint64(-offset) < fileSize {
+			start = fileSize - uint64(-offset)
+		}
+	} else {
+		start = uint64(offset)
+	}
+	if start > fileSize || (start == fileSize && fileSize > 0) {
+		return 0, 0, false
+	}
+
+	end = fileSize
+	if length > 0 && length < end-start {
+		end = start + length
+	}
+	if maxLength > 0 && end-start > maxLength {
+		end = start + maxLength
+	}
+	return start, end, true
+}
+
+// Read downloads the slices holding the range [start, end) of the file, and writes the bytes of the range in order
+func (r *RangeDownload) Read(ctx context.Context, start, end uint64, write func(data []byte) error) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	ctx = core.RegisterRemoteReqId(ctx, r.reqId)
+
+	var slices []*protos.DownloadSliceInfo
+	for _, slice := range r.FileInfo.SliceInfo {
+		if task.IsParitySlice(r.FileInfo, slice) {
+			continue
+		}
+		if slice.SliceOffset.SliceOffsetEnd <= start || slice.SliceOffset.SliceOffsetStart >= end {
+			continue
+		}
+		slices = append(slices, slice)
+	}
+	sort.Slice(slices, func(i, j int) bool {
+		return slices[i].SliceOffset.SliceOffsetStart < slices[j].SliceOffset.SliceOffsetStart
+	})
+
+	position := start
+	for _, slice := range slices {
+		sliceStart := slice.SliceOffset.SliceOffsetStart
+		if sliceStart > position {
+			return errors.Errorf("no slice holds the bytes at offset %v", position)
+		}
+		data, err := r.sliceData(ctx, slice)
+		if err != nil {
+			return errors.Wrapf(err, "failed downloading slice %v", slice.SliceStorageInfo.SliceHash)
+		}
+		to := slice.SliceOffset.SliceOffsetEnd
+		if to > end {
+			to = end
+		}
+		if err = write(data[position-sliceStart : to-sliceStart]); err != nil {
+			return err
+		}
+		position = to
+	}
+	if position < end {
+		return errors.Errorf("no slice holds the bytes at offset %v", position)
+	}
+	return nil
+}
+
+// sliceData returns the data of the slice, from the last downloaded slices or from its resource node
+func (r *RangeDownload) sliceData(ctx context.Context, slice *protos.DownloadSliceInfo) ([]byte, error) {
+	sliceHash := slice.SliceStorageInfo.SliceHash
+	if data, ok := r.slices[sliceHash]; ok {
+		return data, nil
+	}
+	data, err := r.downloadSlice(ctx, slice)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.sliceOrder) == RANGE_CACHED_SLICES {
+		delete(r.slices, r.sliceOrder[0])
+		r.sliceOrder = r.sliceOrder[1:]
+	}
+	r.slices[sliceHash] = data
+	r.sliceOrder = append(r.sliceOrder, sliceHash)
+	return data, nil
+}
+
+// downloadSlice requests the slice from its resource node and collects its decrypted data
+func (r *RangeDownload) downloadSlice(ctx context.Context, slice *protos.DownloadSliceInfo) ([]byte, error) {
+	sliceStart := slice.SliceOffset.SliceOffsetStart
+	sliceSize := slice.SliceOffset.SliceOffsetEnd - sliceStart
+	key := slice.SliceStorageInfo.SliceHash + r.reqId
+	events := file.SubscribeRemoteSliceEvent(key)
+	defer file.UnsubscribeRemoteSliceEvent(key)
+
+	req := requests.ReqDownloadSliceData(ctx, r.FileInfo, slice)
+	event.SendReqDownloadSlice(ctx, r.fileHash, slice, req, r.reqId)
+
+	data := make([]byte, sliceSize)
+	downloadedSize := uint64(0)
+	for downloadedSize < sliceSize {
+		select {
+		case <-time.After(WAIT_TIMEOUT):
+			return nil, errors.New("timeout waiting for slice data")
+		case result := <-events:
+			start := *result.OffsetStart
+			end := *result.OffsetEnd
+			if start < sliceStart || end > sliceStart+sliceSize {
+				return nil, errors.Errorf("slice data out of the slice [%v, %v)", start, end)
+			}
+			decoded, err := b64.StdEncoding.DecodeString(result.FileData)
+			if err != nil {
+				return nil, err
+			}
+			copy(data[start-sliceStart:], decoded)
+			downloadedSize += end - start
+			file.SetDownloadSliceDone(key)
+		}
+	}
+	return data, nil
+}
+
+// Close ends the download session
+func (r *RangeDownload) Close(ctx context.Context) {
+	key := r.fileHash + r.reqId
+	file.UnsubscribeRangeDownload(key)
+	file.CleanFileHash(key)
+	if r.FileInfo != nil {
+		for _, slice := range r.FileInfo.SliceInfo {
+			task.DownloadEncryptedSlices.Delete(slice.SliceStorageInfo.SliceHash + r.reqId)
+		}
+	}
+	task.CleanDownloadFileAndConnMap(ctx, r.fileHash, r.reqId)
+	// the local download of the file, if any, isn't finished
+	task.DownloadTaskMap.Delete(r.fileHash + r.wallet + r.reqId)
+}
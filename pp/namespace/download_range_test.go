@@ -0,0 +1,87 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/sds-msg/protos"
+
+	rpc_api "github.com/stratosnet/sds/pp/api/rpc"
+)
+
+// newCachedRangeDownload a download session of a file of 3 slices, all of them downloaded already
+func newCachedRangeDownload() *RangeDownload {
+	// the reads register their request id
+	_ = utils.InitIdWorker(1)
+	r := &RangeDownload{
+		FileInfo: &protos.RspFileStorageInfo{FileHash: "file1", FileSize: 10},
+		fileHash: "file1",
+		slices:   make(map[string][]byte),
+	}
+	for n, data := range []string{"0123", "4567", "89"} {
+		sliceHash := string(rune('a' + n))
+		start := uint64(4 * n)
+		r.FileInfo.SliceInfo = append(r.FileInfo.SliceInfo, &protos.DownloadSliceInfo{
+			SliceStorageInfo: &protos.SliceStorageInfo{SliceHash: sliceHash},
+			SliceOffset:      &protos.SliceOffset{SliceOffsetStart: start, SliceOffsetEnd: start + uint64(len(data))},
+		})
+		r.slices[sliceHash] = []byte(data)
+	}
+	return r
+}
+
+func TestRangeDownloadRead(t *testing.T) {
+	r := newCachedRangeDownload()
+	for _, c := range []struct {
+		offset   int64
+		length   uint64
+		expected string
+	}{{0, 0, "0123456789"}, {3, 4, "3456"}, {-2, 0, "89"}, {5, 1, "5"}} {
+		start, end, ok := r.Bounds(c.offset, c.length, 0)
+		if !ok {
+			t.Fatalf("range %v %v out of the file", c.offset, c.length)
+		}
+		var read []byte
+		err := r.Read(context.Background(), start, end, func(data []byte) error {
+			read = append(read, data...)
+			return nil
+		})
+		if err != nil || string(read) != c.expected {
+			t.Fatalf("read %q instead of %q: %v", read, c.expected, err)
+		}
+	}
+	if _, _, ok := r.Bounds(10, 0, 0); ok {
+		t.Fatal("range past the end of the file")
+	}
+}
+
+func TestRangeSessionReuse(t *testing.T) {
+	r := newCachedRangeDownload()
+	s := &rangeSession{RangeDownload: r, ready: make(chan struct{}), result: rpc_api.Result{Return: rpc_api.DOWNLOAD_OK}}
+	s.timer = time.AfterFunc(time.Hour, func() {})
+	close(s.ready)
+	signature := rpc_api.Signature{Signature: "signature"}
+	rangeSessions.Store("handle"+signature.Signature, s)
+	defer rangeSessions.Delete("handle" + signature.Signature)
+
+	// the reads signed with the signature of the open session share it
+	reused, release, result := openRangeSession(context.Background(), "handle", signature, time.Now().Unix())
+	if reused != r || result.Return != rpc_api.DOWNLOAD_OK {
+		t.Fatal("the open session wasn't reused")
+	}
+	if s.users != 1 {
+		t.Fatalf("expected 1 user of the session, got %v", s.users)
+	}
+	release()
+	if s.users != 0 {
+		t.Fatalf("expected no user of the session, got %v", s.users)
+	}
+
+	// a closed session isn't reused
+	s.closed = true
+	if s.acquire() {
+		t.Fatal("closed session acquired")
+	}
+}
@@ -0,0 +1,108 @@
+package nat
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	MethodNone = "none"
+	MethodAny  = "any"
+	MethodUPnP = "upnp"
+	MethodPMP  = "pmp"
+
+	discoverTimeout = 3 * time.Second
+	mappingName     = "sds resource node"
+)
+
+// Mapper maps a port of the gateway of the local network to this host
+type Mapper interface {
+	// AddMapping maps the external port of the gateway to the internal port for the given lifetime, and returns the
+	// external port actually mapped, which a gateway can choose differently
+	AddMapping(protocol string, extPort, intPort uint16, lifetime time.Duration) (uint16, error)
+	DeleteMapping(protocol string, extPort, intPort uint16) error
+	ExternalIP() (net.IP, error)
+	String() string
+}
+
+// CheckMethod validates the NAT mapping method in the config
+func CheckMethod(method string) error {
+	switch strings.ToLower(method) {
+	case "", MethodNone, MethodAny, MethodUPnP, MethodPMP:
+		return nil
+	default:
+		return errors.Errorf("unknown NAT mapping method [%v], expected %v, %v, %v or %v", method, MethodNone, MethodAny, MethodUPnP, MethodPMP)
+	}
+}
+
+// Discover looks for a gateway supporting the method. With MethodAny, the first gateway found with either protocol is used
+func Discover(method string) (Mapper, error) {
+	var discoverers []func() (Mapper, error)
+	switch strings.ToLower(method) {
+	case MethodUPnP:
+		discoverers = append(discoverers, discoverUPnP)
+	case MethodPMP:
+		discoverers = append(discoverers, discoverPMP)
+	case MethodAny:
+		discoverers = append(discoverers, discoverUPnP, discoverPMP)
+	default:
+		return nil, errors.Errorf("NAT mapping method [%v] doesn't discover gateways", method)
+	}
+
+	found := make(chan Mapper, len(discoverers))
+	for _, discover := range discoverers {
+		go func(discover func() (Mapper, error)) {
+			mapper, err := discover()
+			if err != nil {
+				mapper = nil
+			}
+			found <- mapper
+		}(discover)
+	}
+	for range discoverers {
+		if mapper := <-found; mapper != nil {
+			return mapper, nil
+		}
+	}
+	return nil, errors.New("no gateway supporting " + method + " was found")
+}
+
+// sharedAddressSpace the addresses of the carrier-grade NATs, RFC 6598
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsPublicIP tells whether the ip can be reached from the internet. The gateway of a network behind a carrier-grade NAT
+// has an external ip that can't
+func IsPublicIP(ip net.IP) bool {
+	return ip != nil && !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsUnspecified() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// potentialGateways lists the usual addresses of the gateway in the private networks of this host
+func potentialGateways() []net.IP {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil
+	}
+	var gateways []net.IP
+	for _, addr := range addrs {
+		ipnet, ok := addr.(*net.IPNet)
+		if !ok || !ipnet.IP.IsPrivate() {
+			continue
+		}
+		ip := ipnet.IP.To4()
+		if ip == nil {
+			continue
+		}
+		network := ip.Mask(ipnet.Mask)
+		for _, last := range []byte{1, 254} {
+			gateway := net.IPv4(network[0], network[1], network[2], last)
+			if ipnet.Contains(gateway) && !gateway.Equal(ip) {
+				gateways = append(gateways, gateway)
+			}
+		}
+	}
+	return gateways
+}
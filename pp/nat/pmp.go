@@ -0,0 +1,62 @@
+package nat
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	natpmp "github.com/jackpal/go-nat-pmp"
+	"github.com/pkg/errors"
+)
+
+type pmp struct {
+	gateway net.IP
+	client  *natpmp.Client
+}
+
+func discoverPMP() (Mapper, error) {
+	gateways := potentialGateways()
+	found := make(chan *pmp, len(gateways))
+	for _, gateway := range gateways {
+		go func(gateway net.IP) {
+			client := natpmp.NewClientWithTimeout(gateway, discoverTimeout)
+			if _, err := client.GetExternalAddress(); err != nil {
+				found <- nil
+				return
+			}
+			found <- &pmp{gateway: gateway, client: client}
+		}(gateway)
+	}
+	for range gateways {
+		if p := <-found; p != nil {
+			return p, nil
+		}
+	}
+	return nil, errors.New("no NAT-PMP gateway found")
+}
+
+func (p *pmp) AddMapping(protocol string, extPort, intPort uint16, lifetime time.Duration) (uint16, error) {
+	result, err := p.client.AddPortMapping(strings.ToLower(protocol), int(intPort), int(extPort), int(lifetime/time.Second))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed mapping %v port %v", protocol, extPort)
+	}
+	return result.MappedExternalPort, nil
+}
+
+func (p *pmp) DeleteMapping(protocol string, _, intPort uint16) error {
+	// a mapping with a lifetime of 0 is deleted
+	_, err := p.client.AddPortMapping(strings.ToLower(protocol), int(intPort), 0, 0)
+	return err
+}
+
+func (p *pmp) ExternalIP() (net.IP, error) {
+	result, err := p.client.GetExternalAddress()
+	if err != nil {
+		return nil, err
+	}
+	return net.IP(result.ExternalIPAddress[:]), nil
+}
+
+func (p *pmp) String() string {
+	return "NAT-PMP " + p.gateway.String()
+}
@@ -0,0 +1,34 @@
+package nat
+
+import "sync/atomic"
+
+// Reachability whether the other nodes can connect to the network address of the node. The meta node connecting back to
+// the node during the handshake tells it
+type Reachability int32
+
+const (
+	ReachabilityUnknown Reachability = iota
+	Reachable
+	Unreachable
+)
+
+var reachability int32
+
+func SetReachability(r Reachability) {
+	atomic.StoreInt32(&reachability, int32(r))
+}
+
+func GetReachability() Reachability {
+	return Reachability(atomic.LoadInt32(&reachability))
+}
+
+func (r Reachability) String() string {
+	switch r {
+	case Reachable:
+		return "reachable"
+	case Unreachable:
+		return "unreachable"
+	default:
+		return "unknown"
+	}
+}
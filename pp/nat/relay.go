@@ -0,0 +1,443 @@
+package nat
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+// A node that is not reachable from the internet registers to a relay, over a control connection to the relay port.
+// The relay allocates it a port of its own, and the node advertises the relay ip and this port as its network address. For every connection to this port, the relay sends a token over the control connection, the node
+// connects to the relay port again to attach to the token, and the relay forwards the data between both connections.
+// The messages, the handshake and the encryption between the nodes go through the relay unchanged.
+//
+// Every connection to the relay port starts with the magic, the version and the type of the connection.
+// register: relay -> nonce(32), node -> p2p address length(1) p2p address p2p pubkey(32) signature(64) port(2),
+// relay -> status(1) followed by port(2) and relay ip(16) or error length(1) error. Then relay -> token(4) for every connection, 0 as ping.
+// attach: node -> token(4)
+const (
+	relayMagic   = "sdsrelay"
+	relayVersion = 1
+
+	relayTypeRegister byte = 1
+	relayTypeAttach   byte = 2
+
+	relayStatusOk    byte = 0
+	relayStatusError byte = 1
+
+	relayNonceSize = 32
+
+	RelayRegisterMessage = "sds_relay_register"
+
+	relayHandshakeTimeout = 10 * time.Second
+	relayAttachTimeout    = 10 * time.Second
+	relayPingInterval     = 30 * time.Second
+	relayPingTimeout      = 3 * relayPingInterval
+	relayMaxConnsPerNode  = 256
+)
+
+// RelayServer relays the connections to the nodes that are not reachable from the internet
+type RelayServer struct {
+	listener net.Listener
+	ip       net.IP // ip of the relay on the internet
+	minPort  uint16
+	maxPort  uint16
+	verify   func(p2pAddress string, p2pPubKey []byte) error
+
+	mu      sync.Mutex
+	closed  bool
+	nodes   map[string]*relayedNode // K - p2p address
+	ports   map[uint16]*relayedNode
+	pending map[uint32]chan net.Conn // K - token, V - receives the connection of the node attaching to the token
+}
+
+type relayedNode struct {
+	p2pAddress string
+	port       uint16
+	control    net.Conn
+	listener   net.Listener
+	tokens     chan uint32
+	done       chan struct{}
+	conns      int32
+	closeOnce  sync.Once
+}
+
+// ParsePortRange parses a port range of the config, like "18091-18099"
+func ParsePortRange(portRange string) (uint16, uint16, error) {
+	from, to, found := strings.Cut(portRange, "-")
+	if !found {
+		to = from
+	}
+	minPort, err := strconv.ParseUint(strings.TrimSpace(from), 10, 16)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid port range [%v]", portRange)
+	}
+	maxPort, err := strconv.ParseUint(strings.TrimSpace(to), 10, 16)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid port range [%v]", portRange)
+	}
+	if minPort == 0 || maxPort < minPort {
+		return 0, 0, errors.Errorf("invalid port range [%v]", portRange)
+	}
+	return uint16(minPort), uint16(maxPort), nil
+}
+
+// StartRelayServer listens to the relay port. Every node registering gets one of the ports of the range, on the relay ip.
+// verify checks that the P2P pubkey of the node is the registered one
+func StartRelayServer(addr string, ip net.IP, minPort, maxPort uint16, verify func(p2pAddress string, p2pPubKey []byte) error) (*RelayServer, error) {
+	if ip.To16() == nil {
+		return nil, errors.New("the relay needs its network ip")
+	}
+	listener, err := net.Listen("tcp4", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &RelayServer{
+		listener: listener,
+		ip:       ip,
+		minPort:  minPort,
+		maxPort:  maxPort,
+		verify:   verify,
+		nodes:    make(map[string]*relayedNode),
+		ports:    make(map[uint16]*relayedNode),
+		pending:  make(map[uint32]chan net.Conn),
+	}
+	go s.acceptLoop()
+	return s, nil
+}
+
+func (s *RelayServer) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// RelayedNodes returns the p2p address and the port of the nodes currently relayed
+func (s *RelayServer) RelayedNodes() map[string]uint16 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nodes := make(map[string]uint16, len(s.nodes))
+	for p2pAddress, node := range s.nodes {
+		nodes[p2pAddress] = node.port
+	}
+	return nodes
+}
+
+func (s *RelayServer) Close() {
+	s.mu.Lock()
+	s.closed = true
+	nodes := make([]*relayedNode, 0, len(s.nodes))
+	for _, node := range s.nodes {
+		nodes = append(nodes, node)
+	}
+	s.mu.Unlock()
+
+	_ = s.listener.Close()
+	for _, node := range nodes {
+		s.removeNode(node)
+	}
+}
+
+func (s *RelayServer) acceptLoop() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if !closed {
+				utils.ErrorLog("relay stopped accepting connections", err)
+			}
+			return
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *RelayServer) handleConn(conn net.Conn) {
+	_ = conn.SetDeadline(time.Now().Add(relayHandshakeTimeout))
+	hello := make([]byte, len(relayMagic)+2)
+	if _, err := io.ReadFull(conn, hello); err != nil || string(hello[:len(relayMagic)]) != relayMagic || hello[len(relayMagic)] != relayVersion {
+		_ = conn.Close()
+		return
+	}
+
+	switch hello[len(relayMagic)+1] {
+	case relayTypeRegister:
+		if err := s.register(conn); err != nil {
+			utils.DebugLogf("relay registration from %v failed: %v", conn.RemoteAddr(), err)
+			_ = conn.Close()
+		}
+	case relayTypeAttach:
+		token := make([]byte, 4)
+		if _, err := io.ReadFull(conn, token); err != nil {
+			_ = conn.Close()
+			return
+		}
+		s.mu.Lock()
+		attached, ok := s.pending[binary.BigEndian.Uint32(token)]
+		delete(s.pending, binary.BigEndian.Uint32(token))
+		s.mu.Unlock()
+		if !ok {
+			_ = conn.Close()
+			return
+		}
+		_ = conn.SetDeadline(time.Time{})
+		attached <- conn
+	default:
+		_ = conn.Close()
+	}
+}
+
+func (s *RelayServer) register(conn net.Conn) error {
+	nonce := make([]byte, relayNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	if _, err := conn.Write(nonce); err != nil {
+		return err
+	}
+
+	length := make([]byte, 1)
+	if _, err := io.ReadFull(conn, length); err != nil {
+		return err
+	}
+	body := make([]byte, int(length[0])+fwed25519.PubKeySize+fwed25519.SignatureSize+2)
+	if _, err := io.ReadFull(conn, body); err != nil {
+		return err
+	}
+	p2pAddress := string(body[:length[0]])
+	p2pPubKeyBytes := body[length[0] : int(length[0])+fwed25519.PubKeySize]
+	signature := body[int(length[0])+fwed25519.PubKeySize : len(body)-2]
+	preferredPort := binary.BigEndian.Uint16(body[len(body)-2:])
+
+	p2pPubKey := fwed25519.PubKeyFromBytes(p2pPubKeyBytes)
+	if fwtypes.P2PAddress(p2pPubKey.Address()).String() != p2pAddress {
+		return replyRelayError(conn, "P2P pubkey doesn't match the P2P address")
+	}
+	if !p2pPubKey.VerifySignature(relayRegisterPayload(nonce), signature) {
+		return replyRelayError(conn, "invalid signature")
+	}
+	if s.verify != nil {
+		if err := s.verify(p2pAddress, p2pPubKeyBytes); err != nil {
+			return replyRelayError(conn, err.Error())
+		}
+	}
+
+	node, err := s.addNode(p2pAddress, preferredPort, conn)
+	if err != nil {
+		return replyRelayError(conn, err.Error())
+	}
+	reply := append(binary.BigEndian.AppendUint16([]byte{relayStatusOk}, node.port), s.ip.To16()...)
+	if _, err = conn.Write(reply); err != nil {
+		s.removeNode(node)
+		return err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	utils.Logf("relaying the connections to %v on port %v", p2pAddress, node.port)
+
+	go s.acceptRelayed(node)
+	go s.writeTokens(node)
+	// the node doesn't send anything after registering, reading only detects when it disconnects
+	_, _ = io.Copy(io.Discard, conn)
+	s.removeNode(node)
+	return nil
+}
+
+// addNode allocates a port to the node, the one it had before if possible
+func (s *RelayServer) addNode(p2pAddress string, preferredPort uint16, control net.Conn) (*relayedNode, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, errors.New("relay is closed")
+	}
+	previous := s.nodes[p2pAddress]
+	s.mu.Unlock()
+	if previous != nil {
+		s.removeNode(previous)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ports := []uint16{preferredPort}
+	for port := s.minPort; port >= s.minPort && port <= s.maxPort; port++ {
+		ports = append(ports, port)
+	}
+	for _, port := range ports {
+		if port < s.minPort || port > s.maxPort || s.ports[port] != nil {
+			continue
+		}
+		listener, err := net.Listen("tcp4", ":"+strconv.Itoa(int(port)))
+		if err != nil {
+			continue
+		}
+		node := &relayedNode{
+			p2pAddress: p2pAddress,
+			port:       port,
+			control:    control,
+			listener:   listener,
+			tokens:     make(chan uint32, relayMaxConnsPerNode),
+			done:       make(chan struct{}),
+		}
+		s.nodes[p2pAddress] = node
+		s.ports[port] = node
+		return node, nil
+	}
+	return nil, errors.New("no relay port available")
+}
+
+func (s *RelayServer) removeNode(node *relayedNode) {
+	node.closeOnce.Do(func() {
+		s.mu.Lock()
+		if s.nodes[node.p2pAddress] == node {
+			delete(s.nodes, node.p2pAddress)
+		}
+		if s.ports[node.port] == node {
+			delete(s.ports, node.port)
+		}
+		s.mu.Unlock()
+		_ = node.listener.Close()
+		_ = node.control.Close()
+		close(node.done)
+		utils.Logf("stopped relaying the connections to %v", node.p2pAddress)
+	})
+}
+
+// acceptRelayed accepts the connections to the port of the node, and asks the node to attach to them
+func (s *RelayServer) acceptRelayed(node *relayedNode) {
+	for {
+		conn, err := node.listener.Accept()
+		if err != nil {
+			return
+		}
+		if atomic.AddInt32(&node.conns, 1) > relayMaxConnsPerNode {
+			atomic.AddInt32(&node.conns, -1)
+			_ = conn.Close()
+			continue
+		}
+		go func() {
+			defer atomic.AddInt32(&node.conns, -1)
+			s.relay(node, conn)
+		}()
+	}
+}
+
+func (s *RelayServer) relay(node *relayedNode, conn net.Conn) {
+	token, attached := s.newPending()
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, token)
+		s.mu.Unlock()
+	}()
+
+	if !s.sendToken(node, token) {
+		_ = conn.Close()
+		return
+	}
+	select {
+	case nodeConn := <-attached:
+		splice(conn, nodeConn)
+	case <-time.After(relayAttachTimeout):
+		utils.DebugLogf("relayed node %v didn't attach to the connection from %v", node.p2pAddress, conn.RemoteAddr())
+		_ = conn.Close()
+		s.mu.Lock()
+		delete(s.pending, token)
+		s.mu.Unlock()
+		select {
+		case nodeConn := <-attached: // attached just after the timeout
+			_ = nodeConn.Close()
+		default:
+		}
+	}
+}
+
+func (s *RelayServer) newPending() (uint32, chan net.Conn) {
+	attached := make(chan net.Conn, 1)
+	tokenBytes := make([]byte, 4)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		_, _ = rand.Read(tokenBytes)
+		token := binary.BigEndian.Uint32(tokenBytes)
+		if _, exists := s.pending[token]; token != 0 && !exists {
+			s.pending[token] = attached
+			return token, attached
+		}
+	}
+}
+
+// sendToken queues the token for the node, unless the node is gone
+func (s *RelayServer) sendToken(node *relayedNode, token uint32) bool {
+	select {
+	case <-node.done:
+		return false
+	default:
+	}
+	select {
+	case node.tokens <- token:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *RelayServer) writeTokens(node *relayedNode) {
+	ticker := time.NewTicker(relayPingInterval)
+	defer ticker.Stop()
+	var token uint32
+	for {
+		select {
+		case token = <-node.tokens:
+		case <-node.done:
+			return
+		case <-ticker.C:
+			token = 0
+		}
+		_ = node.control.SetWriteDeadline(time.Now().Add(relayHandshakeTimeout))
+		if _, err := node.control.Write(binary.BigEndian.AppendUint32(nil, token)); err != nil {
+			s.removeNode(node)
+			return
+		}
+	}
+}
+
+func replyRelayError(conn net.Conn, msg string) error {
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	_, _ = conn.Write(append([]byte{relayStatusError, byte(len(msg))}, msg...))
+	return errors.New(msg)
+}
+
+func relayRegisterPayload(nonce []byte) []byte {
+	return append([]byte(RelayRegisterMessage), nonce...)
+}
+
+func relayHello(connType byte) []byte {
+	return append([]byte(relayMagic), relayVersion, connType)
+}
+
+// splice forwards the data between both connections until one of them is closed
+func splice(a, b net.Conn) {
+	done := make(chan struct{}, 2)
+	forward := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go forward(a, b)
+	go forward(b, a)
+	<-done
+	_ = a.Close()
+	_ = b.Close()
+	<-done
+}
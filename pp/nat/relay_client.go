@@ -0,0 +1,197 @@
+package nat
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const relayRetryInterval = 30 * time.Second
+
+// RelayClient keeps the node registered to a relay, and accepts the connections the relay forwards to the node.
+// It is the listener of the relayed connections for the server of the node
+type RelayClient struct {
+	relayAddr  string
+	p2pAddress string
+	p2pPubKey  []byte
+	sign       func(msg []byte) ([]byte, error)
+	// onRegistered is called with the network address of the node on the relay every time the node registers
+	onRegistered func(ip net.IP, port uint16)
+
+	conns  chan net.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	addr *net.TCPAddr
+}
+
+// StartRelayClient registers to the relay at relayAddr, and registers again whenever the control connection is lost
+func StartRelayClient(relayAddr, p2pAddress string, p2pPubKey []byte, sign func(msg []byte) ([]byte, error),
+	onRegistered func(ip net.IP, port uint16)) *RelayClient {
+	c := &RelayClient{
+		relayAddr:    relayAddr,
+		p2pAddress:   p2pAddress,
+		p2pPubKey:    p2pPubKey,
+		sign:         sign,
+		onRegistered: onRegistered,
+		conns:        make(chan net.Conn),
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	go c.run()
+	return c
+}
+
+func (c *RelayClient) run() {
+	for {
+		err := c.serve()
+		if c.ctx.Err() != nil {
+			return
+		}
+		utils.ErrorLogf("lost the relay %v: %v, retrying in %v", c.relayAddr, err, relayRetryInterval)
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(relayRetryInterval):
+		}
+	}
+}
+
+// serve registers to the relay, then attaches to the connections the relay announces until the control connection fails
+func (c *RelayClient) serve() error {
+	control, err := net.DialTimeout("tcp4", c.relayAddr, relayHandshakeTimeout)
+	if err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-c.ctx.Done():
+		case <-done:
+		}
+		_ = control.Close()
+	}()
+
+	ip, port, err := c.register(control)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.addr = &net.TCPAddr{IP: ip, Port: int(port)}
+	c.mu.Unlock()
+	utils.Logf("registered to the relay %v, reachable at %v", c.relayAddr, c.Addr())
+	if c.onRegistered != nil {
+		c.onRegistered(ip, port)
+	}
+
+	token := make([]byte, 4)
+	for {
+		_ = control.SetReadDeadline(time.Now().Add(relayPingTimeout))
+		if _, err = io.ReadFull(control, token); err != nil {
+			return err
+		}
+		if t := binary.BigEndian.Uint32(token); t != 0 {
+			go c.attach(t)
+		}
+	}
+}
+
+func (c *RelayClient) register(control net.Conn) (net.IP, uint16, error) {
+	_ = control.SetDeadline(time.Now().Add(relayHandshakeTimeout))
+	if _, err := control.Write(relayHello(relayTypeRegister)); err != nil {
+		return nil, 0, err
+	}
+	nonce := make([]byte, relayNonceSize)
+	if _, err := io.ReadFull(control, nonce); err != nil {
+		return nil, 0, err
+	}
+	signature, err := c.sign(relayRegisterPayload(nonce))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c.mu.Lock()
+	var preferredPort uint16
+	if c.addr != nil {
+		preferredPort = uint16(c.addr.Port)
+	}
+	c.mu.Unlock()
+	body := append([]byte{byte(len(c.p2pAddress))}, c.p2pAddress...)
+	body = append(append(body, c.p2pPubKey...), signature...)
+	body = binary.BigEndian.AppendUint16(body, preferredPort)
+	if _, err = control.Write(body); err != nil {
+		return nil, 0, err
+	}
+
+	// status followed by the port and the ip, or by the length of the error and the error
+	reply := make([]byte, 1+2+net.IPv6len)
+	if _, err = io.ReadFull(control, reply[:2]); err != nil {
+		return nil, 0, err
+	}
+	if reply[0] != relayStatusOk {
+		msg := make([]byte, reply[1])
+		_, _ = io.ReadFull(control, msg)
+		return nil, 0, errors.Errorf("relay refused the registration: %v", string(msg))
+	}
+	if _, err = io.ReadFull(control, reply[2:]); err != nil {
+		return nil, 0, err
+	}
+	_ = control.SetDeadline(time.Time{})
+	return net.IP(reply[3:]), binary.BigEndian.Uint16(reply[1:3]), nil
+}
+
+func (c *RelayClient) attach(token uint32) {
+	conn, err := net.DialTimeout("tcp4", c.relayAddr, relayHandshakeTimeout)
+	if err != nil {
+		utils.DebugLogf("couldn't attach to a relayed connection: %v", err)
+		return
+	}
+	if _, err = conn.Write(binary.BigEndian.AppendUint32(relayHello(relayTypeAttach), token)); err != nil {
+		_ = conn.Close()
+		return
+	}
+	select {
+	case c.conns <- conn:
+	case <-c.ctx.Done():
+		_ = conn.Close()
+	}
+}
+
+func (c *RelayClient) Accept() (net.Conn, error) {
+	select {
+	case conn := <-c.conns:
+		return conn, nil
+	case <-c.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+func (c *RelayClient) Close() error {
+	c.cancel()
+	return nil
+}
+
+// Addr is the network address of the node on the relay, once registered
+func (c *RelayClient) Addr() net.Addr {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.addr == nil {
+		addr, _ := net.ResolveTCPAddr("tcp4", c.relayAddr)
+		return addr
+	}
+	return c.addr
+}
+
+// Transport the server connects back to the peers of the relayed connections over TCP
+func (c *RelayClient) Transport() core.Transport {
+	return core.TCPTransport
+}
@@ -0,0 +1,106 @@
+package nat
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+)
+
+func freePort(t *testing.T) uint16 {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = l.Close()
+	}()
+	return uint16(l.Addr().(*net.TCPAddr).Port)
+}
+
+func TestRelay(t *testing.T) {
+	port := freePort(t)
+	server, err := StartRelayServer("127.0.0.1:0", net.IPv4(127, 0, 0, 1), port, port, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	privKey := fwed25519.GenPrivKey()
+	p2pAddress := fwtypes.P2PAddress(privKey.PubKey().Address()).String()
+	registered := make(chan string, 1)
+	client := StartRelayClient(server.Addr().String(), p2pAddress, privKey.PubKey().Bytes(), privKey.Sign, func(ip net.IP, port uint16) {
+		registered <- net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+	})
+	defer func() {
+		_ = client.Close()
+	}()
+
+	var addr string
+	select {
+	case addr = <-registered:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the registration")
+	}
+	if server.RelayedNodes()[p2pAddress] != port {
+		t.Fatalf("node not relayed on port %v: %v", port, server.RelayedNodes())
+	}
+
+	// the node echoes what it receives over the relayed connection
+	go func() {
+		conn, err := client.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = io.Copy(conn, conn)
+		_ = conn.Close()
+	}()
+	conn, err := net.Dial("tcp4", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+	if _, err = conn.Write([]byte("relayed")); err != nil {
+		t.Fatal(err)
+	}
+	received := make([]byte, len("relayed"))
+	if _, err = io.ReadFull(conn, received); err != nil {
+		t.Fatal(err)
+	}
+	if string(received) != "relayed" {
+		t.Fatalf("received [%v]", string(received))
+	}
+}
+
+func TestRelayRejectsWrongKey(t *testing.T) {
+	port := freePort(t)
+	server, err := StartRelayServer("127.0.0.1:0", net.IPv4(127, 0, 0, 1), port, port, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	privKey, otherKey := fwed25519.GenPrivKey(), fwed25519.GenPrivKey()
+	control, err := net.Dial("tcp4", server.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = control.Close()
+	}()
+	c := &RelayClient{
+		p2pAddress: fwtypes.P2PAddress(otherKey.PubKey().Address()).String(),
+		p2pPubKey:  privKey.PubKey().Bytes(),
+		sign:       privKey.Sign,
+	}
+	if _, _, err = c.register(control); err == nil {
+		t.Fatal("expected the registration with the address of another node to be refused")
+	}
+}
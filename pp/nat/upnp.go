@@ -0,0 +1,104 @@
+package nat
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+	"github.com/pkg/errors"
+)
+
+// upnpClient the port mapping actions of the WANIPConnection and WANPPPConnection services
+type upnpClient interface {
+	AddPortMapping(remoteHost string, extPort uint16, protocol string, intPort uint16, intClient string, enabled bool,
+		description string, leaseDuration uint32) error
+	DeletePortMapping(remoteHost string, extPort uint16, protocol string) error
+	GetExternalIPAddress() (string, error)
+}
+
+type upnp struct {
+	client   upnpClient
+	service  *goupnp.ServiceClient
+	internal net.IP
+	name     string
+}
+
+func discoverUPnP() (Mapper, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), discoverTimeout)
+	defer cancel()
+
+	found := make(chan *upnp, 3)
+	go func() {
+		clients, _, err := internetgateway2.NewWANIPConnection2ClientsCtx(ctx)
+		if err == nil && len(clients) > 0 {
+			found <- &upnp{client: clients[0], service: &clients[0].ServiceClient, name: "UPnP IGDv2-IP"}
+			return
+		}
+		found <- nil
+	}()
+	go func() {
+		clients, _, err := internetgateway2.NewWANIPConnection1ClientsCtx(ctx)
+		if err == nil && len(clients) > 0 {
+			found <- &upnp{client: clients[0], service: &clients[0].ServiceClient, name: "UPnP IGDv1-IP"}
+			return
+		}
+		found <- nil
+	}()
+	go func() {
+		clients, _, err := internetgateway2.NewWANPPPConnection1ClientsCtx(ctx)
+		if err == nil && len(clients) > 0 {
+			found <- &upnp{client: clients[0], service: &clients[0].ServiceClient, name: "UPnP IGDv1-PPP"}
+			return
+		}
+		found <- nil
+	}()
+
+	for i := 0; i < 3; i++ {
+		if u := <-found; u != nil {
+			u.internal = u.service.LocalAddr()
+			if u.internal == nil {
+				continue
+			}
+			return u, nil
+		}
+	}
+	return nil, errors.New("no UPnP gateway found")
+}
+
+func (u *upnp) AddMapping(protocol string, extPort, intPort uint16, lifetime time.Duration) (uint16, error) {
+	protocol = strings.ToUpper(protocol)
+	// some gateways refuse to update an existing mapping
+	_ = u.client.DeletePortMapping("", extPort, protocol)
+	err := u.client.AddPortMapping("", extPort, protocol, intPort, u.internal.String(), true, mappingName, uint32(lifetime/time.Second))
+	if err != nil {
+		// some gateways only support permanent mappings
+		err = u.client.AddPortMapping("", extPort, protocol, intPort, u.internal.String(), true, mappingName, 0)
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed mapping %v port %v", protocol, extPort)
+	}
+	return extPort, nil
+}
+
+func (u *upnp) DeleteMapping(protocol string, extPort, _ uint16) error {
+	return u.client.DeletePortMapping("", extPort, strings.ToUpper(protocol))
+}
+
+func (u *upnp) ExternalIP() (net.IP, error) {
+	addr, err := u.client.GetExternalIPAddress()
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil, errors.Errorf("invalid external IP [%v]", addr)
+	}
+	return ip, nil
+}
+
+func (u *upnp) String() string {
+	return u.name + " " + u.service.RootDevice.URLBase.Host
+}
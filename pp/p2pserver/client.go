@@ -31,6 +31,8 @@ type lastReconnectRecord struct {
 func (p *P2pServer) initClient() {
 	p.offlineChan = make(chan *offline, 2)
 	p.cachedConnMap = &sync.Map{}
+	p.cachedStreamMap = &sync.Map{}
+	p.muxConnMap = &sync.Map{}
 	p.connMap = make(map[string]*cf.ClientConn)
 }
 
@@ -43,23 +45,27 @@ func (p *P2pServer) SetOptionFunctions(write func(context.Context, *msg.RelayMsg
 
 func (p *P2pServer) NewClientToMainSp(ctx context.Context, server string) error {
 	utils.DebugLog("NewClientToMainSp: to", server, " hb: true, rec: true")
-	_, err := p.newClient(ctx, server, true, false, true)
+	_, err := p.newClient(ctx, server, true, false, true, cf.HandshakeAuthOption(p.handshakeAuth(false)))
 	return err
 }
 
 func (p *P2pServer) NewClientToAlternativeSp(ctx context.Context, server string) (*cf.ClientConn, error) {
 	utils.DebugLog("NewClientToAlternativeSp: to", server)
-	return p.newClient(ctx, server, false, false, false)
+	return p.newClient(ctx, server, false, false, false, cf.HandshakeAuthOption(p.handshakeAuth(false)))
 }
 
 func (p *P2pServer) NewClientToPp(ctx context.Context, server string, heartbeat bool) (*cf.ClientConn, error) {
 	utils.DebugLog("NewClientToPp: to", server)
-	return p.newClient(ctx, server, heartbeat, false, false)
+	return p.newClient(ctx, server, heartbeat, false, false, cf.TransportOption(setting.GetP2pTransport()), cf.MuxOption(true),
+		cf.HandshakeAuthOption(p.handshakeAuth(setting.Config.Node.Connectivity.RequirePeerAuth)))
 }
 
-func (p *P2pServer) newClient(ctx context.Context, server string, heartbeat, reconnect, spconn bool) (*cf.ClientConn, error) {
+func (p *P2pServer) newClient(ctx context.Context, server string, heartbeat, reconnect, spconn bool, opts ...cf.ClientOption) (*cf.ClientConn, error) {
 	onConnect := cf.OnConnectOption(func(c core.WriteCloser) bool {
 		utils.DebugLog("on connect")
+		if spconn {
+			p.onReachability(true)
+		}
 		return true
 	})
 	onError := cf.OnErrorOption(func(c core.WriteCloser) {
@@ -77,9 +83,13 @@ func (p *P2pServer) newClient(ctx context.Context, server string, heartbeat, rec
 		}
 
 		utils.Log("on close", cc.GetName())
+		if spconn && errors.Is(cc.HandshakeError(), cf.ErrNoDialBack) {
+			p.onReachability(false)
+		}
 		p.clientMutex.Lock()
 		delete(p.connMap, cc.GetName())
 		p.clientMutex.Unlock()
+		p.deleteMuxConn(server, cc)
 
 		offlineInfo := &offline{
 			IsSp:           false,
@@ -98,9 +108,9 @@ func (p *P2pServer) newClient(ctx context.Context, server string, heartbeat, rec
 		}
 	})
 
-	serverPort, err := strconv.ParseUint(setting.Config.Node.Connectivity.NetworkPort, 10, 16)
+	serverPort, err := strconv.ParseUint(setting.GetNetworkPort(), 10, 16)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Invalid port number in config [%v]", setting.Config.Node.Connectivity.NetworkPort)
+		return nil, errors.Wrapf(err, "Invalid port number in config [%v]", setting.GetNetworkPort())
 	}
 	serverPortOpt := cf.ServerPortOption(uint16(serverPort))
 
@@ -114,6 +124,7 @@ func (p *P2pServer) newClient(ctx context.Context, server string, heartbeat, rec
 		onError,
 		onClose,
 		cf.OnWriteOption(p.onWriteFunc),
+		cf.OnReadOption(p.onReadFunc),
 		cf.OnHandleOption(p.onHandleFunc),
 		cf.BufferSizeOption(100),
 		cf.ReconnectOption(reconnect),
@@ -125,6 +136,7 @@ func (p *P2pServer) newClient(ctx context.Context, server string, heartbeat, rec
 		serverPortOpt,
 		cf.ContextKVOption(ckv),
 	}
+	options = append(options, opts...)
 	conn := cf.CreateClientConn(server, options...)
 
 	// setting p.mainSpConn earlier than calling conn.Start() to avoid race condition
@@ -186,6 +198,12 @@ func (p *P2pServer) GetSpName() string {
 	return p.mainSpConn.GetName()
 }
 
+// cachedStream a stream cached in place of a connection, when the connection to the node is multiplexed
+type cachedStream struct {
+	conn   *cf.ClientConn
+	stream *core.Stream
+}
+
 // StoreConnToCache access function for member cachedConnMap
 func (p *P2pServer) StoreConnToCache(key string, conn *cf.ClientConn) {
 	p.cachedConnMap.Store(key, conn)
@@ -200,9 +218,41 @@ func (p *P2pServer) LoadConnFromCache(key string) (*cf.ClientConn, bool) {
 	}
 }
 
-// DeleteConnFromCache access function for member cachedConnMap
+// DeleteConnFromCache access function for member cachedConnMap, also forgets the stream cached in place of a conn
 func (p *P2pServer) DeleteConnFromCache(key string) {
 	p.cachedConnMap.Delete(key)
+	p.cachedStreamMap.Delete(key)
+}
+
+// CloseCachedStreams closes the streams cached with a key starting with prefix, dropping the messages they didn't send yet
+func (p *P2pServer) CloseCachedStreams(prefix string) {
+	p.cachedStreamMap.Range(func(k, v interface{}) bool {
+		if strings.HasPrefix(k.(string), prefix) {
+			v.(*cachedStream).stream.Close()
+			p.cachedStreamMap.Delete(k)
+		}
+		return true
+	})
+}
+
+// RangeMuxConn calls rf for every connection carrying cached streams
+func (p *P2pServer) RangeMuxConn(rf func(conn *cf.ClientConn) bool) {
+	p.muxConnMap.Range(func(k, v interface{}) bool {
+		return rf(v.(*cf.ClientConn))
+	})
+}
+
+// deleteMuxConn forgets the connection to the network address and the streams it carries, once it is closed
+func (p *P2pServer) deleteMuxConn(networkAddr string, conn *cf.ClientConn) {
+	if v, ok := p.muxConnMap.Load(networkAddr); ok && v.(*cf.ClientConn) == conn {
+		p.muxConnMap.Delete(networkAddr)
+	}
+	p.cachedStreamMap.Range(func(k, v interface{}) bool {
+		if v.(*cachedStream).conn == conn {
+			p.cachedStreamMap.Delete(k)
+		}
+		return true
+	})
 }
 
 func (p *P2pServer) RangeCachedConn(prefix string, rf func(k, v interface{}) bool) {
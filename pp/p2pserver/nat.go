@@ -0,0 +1,179 @@
+package p2pserver
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/nat"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	NAT_MAPPING_LIFETIME = 20 * time.Minute
+	NAT_MAPPING_RENEWAL  = 15 * time.Minute
+)
+
+type portMapping struct {
+	protocol string
+	extPort  uint16
+	intPort  uint16
+}
+
+// startNat maps the network port on the gateway of the local network and starts relaying the connections to the nodes
+// that are not reachable, according to the config
+func (p *P2pServer) startNat() {
+	p.startPortMapping()
+	p.startRelayServer()
+}
+
+func (p *P2pServer) stopNat() {
+	p.natMutex.Lock()
+	defer p.natMutex.Unlock()
+	if p.natStop != nil {
+		close(p.natStop)
+		p.natStop = nil
+		for _, mapping := range p.natMappings {
+			if err := p.natMapper.DeleteMapping(mapping.protocol, mapping.extPort, mapping.intPort); err != nil {
+				utils.DebugLogf("couldn't delete the %v mapping of port %v on %v: %v", mapping.protocol, mapping.extPort, p.natMapper, err)
+			}
+		}
+		p.natMappings = nil
+	}
+	if p.relayServer != nil {
+		p.relayServer.Close()
+		p.relayServer = nil
+	}
+	if p.relayClient != nil {
+		_ = p.relayClient.Close()
+		p.relayClient = nil
+	}
+}
+
+func (p *P2pServer) startPortMapping() {
+	method := setting.Config.Node.Connectivity.Nat
+	if method == "" || method == nat.MethodNone || setting.Config.Node.Connectivity.Internal {
+		return
+	}
+	extPort, err := strconv.ParseUint(setting.Config.Node.Connectivity.NetworkPort, 10, 16)
+	if err != nil {
+		return
+	}
+	intPort, err := strconv.ParseUint(setting.GetP2pServerPort(), 10, 16)
+	if err != nil {
+		return
+	}
+
+	mapper, err := nat.Discover(method)
+	if err != nil {
+		utils.Log("network port not mapped:", err.Error())
+		return
+	}
+	mappings := []portMapping{{protocol: "tcp", extPort: uint16(extPort), intPort: uint16(intPort)}}
+	if setting.GetP2pTransport() == core.QUICTransport {
+		mappings = append(mappings, portMapping{protocol: "udp", extPort: uint16(extPort), intPort: uint16(intPort)})
+	}
+	if !p.addPortMappings(mapper, mappings) {
+		return
+	}
+
+	if ip, err := mapper.ExternalIP(); err == nil && !nat.IsPublicIP(ip) {
+		utils.ErrorLogf("The gateway %v has the external ip %v, it is behind another NAT, probably carrier-grade: the mapped "+
+			"port doesn't make the node reachable. Use a relay instead", mapper, ip)
+	}
+
+	p.natMutex.Lock()
+	p.natMapper = mapper
+	p.natMappings = mappings
+	p.natStop = make(chan struct{})
+	stop := p.natStop
+	p.natMutex.Unlock()
+	go func() {
+		ticker := time.NewTicker(NAT_MAPPING_RENEWAL)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				p.addPortMappings(mapper, mappings)
+			}
+		}
+	}()
+}
+
+func (p *P2pServer) addPortMappings(mapper nat.Mapper, mappings []portMapping) bool {
+	for _, mapping := range mappings {
+		mapped, err := mapper.AddMapping(mapping.protocol, mapping.extPort, mapping.intPort, NAT_MAPPING_LIFETIME)
+		if err != nil {
+			utils.ErrorLogf("couldn't map the %v network port on %v: %v", mapping.protocol, mapper, err)
+			return false
+		}
+		if mapped != mapping.extPort {
+			// the other nodes connect to the network port, the tcp and udp ports must be the same
+			utils.ErrorLogf("%v mapped the %v port %v instead of the network port %v", mapper, mapping.protocol, mapped, mapping.extPort)
+			_ = mapper.DeleteMapping(mapping.protocol, mapped, mapping.intPort)
+			return false
+		}
+	}
+	utils.Logf("network port %v mapped on %v", mappings[0].extPort, mapper)
+	return true
+}
+
+func (p *P2pServer) startRelayServer() {
+	relay := setting.Config.Node.Connectivity.Relay
+	if relay.Port == "" {
+		return
+	}
+	minPort, maxPort, err := nat.ParsePortRange(relay.Ports)
+	if err != nil {
+		utils.ErrorLog("relay not started", err)
+		return
+	}
+	server, err := nat.StartRelayServer(":"+relay.Port, setting.NetworkIP, minPort, maxPort, verifyPeerKey)
+	if err != nil {
+		utils.ErrorLog("relay not started", err)
+		return
+	}
+	utils.Logf("relaying the connections to the unreachable nodes, registering on port %v", relay.Port)
+	p.natMutex.Lock()
+	p.relayServer = server
+	p.natMutex.Unlock()
+}
+
+// onReachability is told whether the meta node managed to connect back to the node. When it didn't, the node registers
+// to the relay of the config, and advertises its address on the relay
+func (p *P2pServer) onReachability(reachable bool) {
+	if reachable {
+		if nat.GetReachability() != nat.Reachable {
+			utils.Logf("node reachable at %v", setting.NetworkAddress)
+		}
+		nat.SetReachability(nat.Reachable)
+		return
+	}
+	nat.SetReachability(nat.Unreachable)
+
+	relayAddress := setting.Config.Node.Connectivity.Relay.Address
+	if relayAddress == "" {
+		utils.ErrorLogf("The meta node couldn't connect back to this node at %v. Open the network port, set nat in the "+
+			"config to map it on the gateway, or set the address of a relay", setting.NetworkAddress)
+		return
+	}
+	p.natMutex.Lock()
+	defer p.natMutex.Unlock()
+	if p.relayClient != nil || p.server == nil {
+		return
+	}
+	p.relayClient = nat.StartRelayClient(relayAddress, p.p2pAddress.String(), p.p2pPubKey.Bytes(), p.p2pPrivKey.Sign,
+		func(ip net.IP, port uint16) {
+			setting.SetAdvertisedNetworkAddress(ip, strconv.Itoa(int(port)))
+		})
+	server, listener := p.server, p.relayClient
+	go func() {
+		if err := server.Start(listener); err != nil {
+			utils.ErrorLog("stopped accepting the relayed connections", err)
+		}
+	}()
+}
@@ -0,0 +1,100 @@
+package p2pserver
+
+import (
+	"bytes"
+	"encoding/hex"
+	"time"
+
+	sdked25519 "cosmossdk.io/api/cosmos/crypto/ed25519"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/stratosnet/sds/framework/core"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/tx-client/grpc"
+)
+
+const (
+	// PEER_KEY_CACHE_TTL how long the P2P key of a peer stays verified
+	PEER_KEY_CACHE_TTL = time.Hour
+	// PEER_KEY_FAILURE_CACHE_TTL how long a peer whose P2P key doesn't match the registered one is rejected without
+	// querying again
+	PEER_KEY_FAILURE_CACHE_TTL = time.Minute
+)
+
+var (
+	verifiedPeerKeys = utils.NewAutoCleanMap(PEER_KEY_CACHE_TTL)
+	rejectedPeerKeys = utils.NewAutoCleanMap(PEER_KEY_FAILURE_CACHE_TTL)
+)
+
+// handshakeAuth proves the P2P address of the node in the handshakes, and checks that the peers use their registered key
+func (p *P2pServer) handshakeAuth(required bool) *core.HandshakeAuth {
+	return &core.HandshakeAuth{
+		P2pPubKey: p.p2pPubKey.Bytes(),
+		Sign:      p.p2pPrivKey.Sign,
+		Verify:    verifyPeerKey,
+		Required:  required,
+	}
+}
+
+// verifyPeerKey checks that the P2P pubkey is the one registered for the P2P address, in the SP list for the meta nodes,
+// on chain for the resource nodes. The result is cached, except when the registered key couldn't be found
+func verifyPeerKey(p2pAddress string, p2pPubKey []byte) error {
+	key := p2pAddress + hex.EncodeToString(p2pPubKey)
+	if _, ok := verifiedPeerKeys.LoadWithoutPushDelete(key); ok {
+		return nil
+	}
+	if err, ok := rejectedPeerKeys.LoadWithoutPushDelete(key); ok {
+		return err.(error)
+	}
+
+	err := queryPeerKey(p2pAddress, p2pPubKey)
+	if err != nil {
+		utils.DebugLogf("P2P key of %v couldn't be verified: %v", p2pAddress, err)
+		if !errors.Is(err, core.ErrPeerUnverified) {
+			rejectedPeerKeys.Store(key, err)
+		}
+		return err
+	}
+	verifiedPeerKeys.Store(key, true)
+	return nil
+}
+
+func queryPeerKey(p2pAddress string, p2pPubKey []byte) error {
+	if value, ok := setting.SPMap.Load(p2pAddress); ok {
+		spPubKey, err := fwtypes.P2PPubKeyFromBech32(value.(setting.SPBaseInfo).P2PPublicKey)
+		if err != nil {
+			return errors.Wrap(err, "invalid P2P pubkey in the SP list")
+		}
+		if !bytes.Equal(spPubKey.Bytes(), p2pPubKey) {
+			return errors.New("P2P pubkey doesn't match the SP list")
+		}
+		return nil
+	}
+
+	resourceNode, err := grpc.QueryResourceNode(p2pAddress)
+	if err == nil && resourceNode.GetNetworkAddress() == p2pAddress {
+		return matchRegisteredKey(resourceNode.GetPubkey(), p2pPubKey)
+	}
+	metaNode, err := grpc.QueryMetaNode(p2pAddress)
+	if err == nil && metaNode.GetNetworkAddress() == p2pAddress {
+		return matchRegisteredKey(metaNode.GetPubkey(), p2pPubKey)
+	}
+	if err != nil {
+		return errors.Wrapf(core.ErrPeerUnverified, "failed querying the node: %v", err)
+	}
+	return errors.Wrap(core.ErrPeerUnverified, "not a registered node")
+}
+
+func matchRegisteredKey(registered *anypb.Any, p2pPubKey []byte) error {
+	var pubKey sdked25519.PubKey
+	if err := registered.UnmarshalTo(&pubKey); err != nil {
+		return errors.Wrap(err, "invalid registered P2P pubkey")
+	}
+	if !bytes.Equal(pubKey.GetKey(), p2pPubKey) {
+		return errors.New("P2P pubkey doesn't match the registered one")
+	}
+	return nil
+}
@@ -3,7 +3,6 @@ package p2pserver
 import (
 	"context"
 	"errors"
-	"net"
 	"os"
 	"path/filepath"
 	"sync"
@@ -16,6 +15,7 @@ import (
 	fwtypes "github.com/stratosnet/sds/framework/types"
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/nat"
 	"github.com/stratosnet/sds/sds-msg/protos"
 	"google.golang.org/protobuf/proto"
 
@@ -58,6 +58,12 @@ type P2pServer struct {
 	// cachedConnMap upload connection
 	cachedConnMap *sync.Map
 
+	// cachedStreamMap streams standing for the cached connections of the nodes that multiplex their connections
+	cachedStreamMap *sync.Map
+
+	// muxConnMap connections carrying the cached streams, K - network address, V - *cf.ClientConn
+	muxConnMap *sync.Map
+
 	// connMap client connection map
 	connMap map[string]*cf.ClientConn
 
@@ -68,6 +74,14 @@ type P2pServer struct {
 	onWriteFunc  func(context.Context, *msg.RelayMsgBuf)
 	onReadFunc   func(*msg.RelayMsgBuf)
 	onHandleFunc func(context.Context, *msg.RelayMsgBuf)
+
+	// port mapping on the gateway, and relaying of the connections to the nodes that are not reachable
+	natMutex    sync.Mutex
+	natMapper   nat.Mapper
+	natMappings []portMapping
+	natStop     chan struct{}
+	relayServer *nat.RelayServer
+	relayClient *nat.RelayClient
 }
 
 func (p *P2pServer) GetP2pServer() *core.Server {
@@ -97,7 +111,7 @@ func (p *P2pServer) Init() error {
 }
 
 func (p *P2pServer) StartListenServer(ctx context.Context, port string) {
-	netListen, err := net.Listen(setting.P2pServerType, ":"+port)
+	netListen, err := core.TCPTransport.Listen(":" + port)
 	if err != nil {
 		utils.ErrorLog("StartListenServer Error", err)
 		return
@@ -105,12 +119,35 @@ func (p *P2pServer) StartListenServer(ctx context.Context, port string) {
 	spbServer := p.newServer(ctx)
 	p.server = spbServer
 	utils.DebugLog("StartListenServer!!! ", port)
+	if !setting.Config.Node.Connectivity.RequirePeerAuth {
+		utils.Log("the nodes that don't prove their P2P address are accepted, require_peer_auth is false")
+	}
+	p.startTransportListener(spbServer, port)
 	err = spbServer.Start(netListen)
 	if err != nil {
 		utils.ErrorLog("StartListenServer Error", err)
 	}
 }
 
+// startTransportListener accepts the connections over the transport in the config, when it is not TCP. The server keeps
+// listening over TCP for the nodes that don't support this transport
+func (p *P2pServer) startTransportListener(server *core.Server, port string) {
+	transport := setting.GetP2pTransport()
+	if transport == core.TCPTransport {
+		return
+	}
+	listener, err := transport.Listen(":" + port)
+	if err != nil {
+		utils.ErrorLogf("Couldn't listen over %v, only accepting %v connections: %v", transport.Name(), core.TransportTCP, err)
+		return
+	}
+	go func() {
+		if err := server.Start(listener); err != nil {
+			utils.ErrorLogf("StartListenServer Error over %v: %v", transport.Name(), err)
+		}
+	}()
+}
+
 // newServer returns a server.
 func (p *P2pServer) newServer(ctx context.Context) *core.Server {
 	onConnectOption := core.OnConnectOption(func(conn core.WriteCloser) bool { return true })
@@ -135,6 +172,7 @@ func (p *P2pServer) newServer(ctx context.Context) *core.Server {
 		onCloseOption,
 		onBadAppVerOption,
 		core.OnWriteOption(p.onWriteFunc),
+		core.OnReadOption(p.onReadFunc),
 		core.OnHandleOption(p.onHandleFunc),
 		core.BufferSizeOption(10000),
 		core.LogOpenOption(true),
@@ -142,6 +180,8 @@ func (p *P2pServer) newServer(ctx context.Context) *core.Server {
 		core.P2pAddressOption(p.GetP2PAddress().String()),
 		core.MaxConnectionsOption(maxConnections),
 		core.ContextKVOption(ckv),
+		core.MuxOption(true),
+		core.HandshakeAuthOption(p.handshakeAuth(setting.Config.Node.Connectivity.RequirePeerAuth)),
 	)
 	server.SetVolRecOptions(
 		core.LogAllOption(PP_LOG_ALL),
@@ -163,11 +203,13 @@ func (p *P2pServer) Start(ctx context.Context) {
 
 	ctx = p.initQuitChs(ctx)
 	setting.SetMyNetworkAddress()
+	p.startNat()
 	go p.StartListenServer(ctx, setting.GetP2pServerPort())
 	p.initClient()
 }
 
 func (p *P2pServer) Stop() {
+	p.stopNat()
 	if p.server != nil {
 		// send signal to close network level goroutines
 		for _, ch := range p.quitChMap {
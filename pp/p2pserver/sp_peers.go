@@ -231,11 +231,32 @@ func (p *P2pServer) SendMessageByCachedConn(ctx context.Context, key string, net
 			return err
 		}
 	}
+	// use the cached stream, or open a stream on the connection already multiplexed to the network address
+	if cs, ok := p.cachedStreamMap.Load(key); ok {
+		if err := p.sendMessageOnStream(ctx, cs.(*cachedStream), pb, cmd, fn); err == nil {
+			return nil
+		}
+		p.cachedStreamMap.Delete(key)
+	}
+	if c, ok := p.muxConnMap.Load(netAddr); ok {
+		if err := p.openCachedStream(ctx, key, c.(*cf.ClientConn), pb, cmd, fn); err == nil {
+			return nil
+		}
+		p.muxConnMap.Delete(netAddr)
+	}
 	// not in cache, connect to the network address
 	conn, err := p.NewClientToPp(ctx, netAddr, false)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create connection with "+netAddr)
 	}
+	if conn.OpenStream(core.StreamPriorityBulk) != nil {
+		p.muxConnMap.Store(netAddr, conn)
+		err = p.openCachedStream(ctx, key, conn, pb, cmd, fn)
+		if err != nil {
+			utils.ErrorLog("Fail to send upload slice request to " + netAddr + ", " + err.Error())
+		}
+		return err
+	}
 	if fn != nil {
 		p.setWriteHook(conn, fn)
 	}
@@ -249,6 +270,32 @@ func (p *P2pServer) SendMessageByCachedConn(ctx context.Context, key string, net
 	return err
 }
 
+// openCachedStream opens a bulk stream on the multiplexed connection, caches it with the key and sends the message on it
+func (p *P2pServer) openCachedStream(ctx context.Context, key string, conn *cf.ClientConn, pb proto.Message, cmd header.MsgType, fn core.WriteHookFunc) error {
+	stream := conn.OpenStream(core.StreamPriorityBulk)
+	if stream == nil {
+		return errors.New("connection " + conn.GetName() + " is not multiplexed")
+	}
+	cs := &cachedStream{conn: conn, stream: stream}
+	if err := p.sendMessageOnStream(ctx, cs, pb, cmd, fn); err != nil {
+		stream.Close()
+		return err
+	}
+	p.cachedStreamMap.Store(key, cs)
+	return nil
+}
+
+func (p *P2pServer) sendMessageOnStream(ctx context.Context, cs *cachedStream, pb proto.Message, cmd header.MsgType, fn core.WriteHookFunc) error {
+	if fn != nil {
+		p.setWriteHook(cs.conn, fn)
+	}
+	err := p.SendMessage(core.CreateContextWithStream(ctx, cs.stream), cs.conn, pb, cmd)
+	if err == nil {
+		utils.DebugLog("SendMessage(conn, pb, header.", cmd.Name, ") on stream ", cs.stream.Id(), " ", cs.conn)
+	}
+	return err
+}
+
 // CreateNewContextPacketId used for downloading / uploading speed tracking
 func CreateNewContextPacketId(ctx context.Context) (int64, context.Context) {
 	retCtx := ctx
@@ -93,13 +93,8 @@ func ReqGetWalletOzData(walletAddr, reqId string) *protos.ReqGetWalletOz {
 
 // RequestUploadFile a file from an owner instead from a "path" belongs to PP's default wallet
 func RequestUploadFile(ctx context.Context, fileName, fileHash string, fileSize uint64, walletAddress, walletPubkey, signature string, reqTime int64,
-	slices []*protos.SliceHashAddr, isEncrypted bool, desiredTier uint32, allowHigherTier bool, duration uint64) (*protos.ReqUploadFile, error) {
+	slices []*protos.SliceHashAddr, encryptionTag, compression string, desiredTier uint32, allowHigherTier bool, duration uint64) (*protos.ReqUploadFile, error) {
 	utils.Log("fileName: ", fileName)
-	encryptionTag := ""
-	if isEncrypted {
-		encryptionTag = utils.GetRandomString(8)
-	}
-
 	utils.Log("fileHash: ", fileHash)
 
 	file.SaveRemoteFileHash(fileHash, fileName, fileSize)
@@ -125,6 +120,7 @@ func RequestUploadFile(ctx context.Context, fileName, fileHash string, fileSize
 			EncryptionTag:      encryptionTag,
 			OwnerWalletAddress: walletAddress,
 			Duration:           duration,
+			Compression:        compression,
 		},
 		Slices:    slices,
 		MyAddress: p2pserver.GetP2pServer(ctx).GetPPInfo(),
@@ -515,9 +511,23 @@ func ReqDownloadSliceData(ctx context.Context, target *protos.RspFileStorageInfo
 	}
 }
 
+// ReqClearDownloadSliceData asks the node sending a slice to stop, the slice being downloaded from another node
+func ReqClearDownloadSliceData(ctx context.Context, target *protos.RspFileStorageInfo, slice *protos.DownloadSliceInfo) *protos.ReqClearDownloadTask {
+	return &protos.ReqClearDownloadTask{
+		WalletAddress: target.WalletAddress,
+		FileHash:      target.FileHash,
+		P2PAddress:    p2pserver.GetP2pServer(ctx).GetP2PAddress().String(),
+		SliceHash:     slice.SliceStorageInfo.SliceHash,
+		TaskId:        slice.TaskId,
+	}
+}
+
 func ReqRegisterNewPPData(ctx context.Context, walletAddr string, walletPubkey, wsig []byte, reqTime int64) *protos.ReqRegisterNewPP {
 	sysInfo := utils.GetSysInfo(setting.Config.Home.StoragePath)
-	sysInfo.DiskSize = setting.GetDiskSizeSoftCap(sysInfo.DiskSize)
+	if usage, err := file.GetStorageDiskUsage(); err == nil {
+		sysInfo.DiskSize = usage.Total
+		sysInfo.FreeDisk = usage.Free
+	}
 	walletSign := &protos.Signature{
 		Address:   walletAddr,
 		Pubkey:    walletPubkey,
@@ -654,6 +664,24 @@ func ReqDownloadFileWrongData(fInfo *protos.RspFileStorageInfo, dTask *task.Down
 	}
 }
 
+// ReqLostSlicesData reports slices of a file the node lost, corrupt or on a missing disk, for the SP to replicate them
+// again from the other nodes
+func ReqLostSlicesData(ctx context.Context, fileHash string, sliceHashes []string) *protos.ReqReportLostSlices {
+	return &protos.ReqReportLostSlices{
+		P2PAddress:  p2pserver.GetP2pServer(ctx).GetP2PAddress().String(),
+		FileHash:    fileHash,
+		SliceHashes: sliceHashes,
+	}
+}
+
+// ReqSliceIndexData asks the SP the file hash and slice number of stored slices missing from the slice index
+func ReqSliceIndexData(ctx context.Context, sliceHashes []string) *protos.ReqSliceIndex {
+	return &protos.ReqSliceIndex{
+		P2PAddress:  p2pserver.GetP2pServer(ctx).GetP2PAddress().String(),
+		SliceHashes: sliceHashes,
+	}
+}
+
 func FindFileListData(fileName string, walletAddr, p2pAddress string, pageId uint64, keyword string, fileType protos.FileSortType, isUp bool, walletPubkey, wsign []byte, reqTime int64) *protos.ReqFindMyFileList {
 	walletSign := &protos.Signature{
 		Address:   walletAddr,
@@ -730,9 +758,8 @@ func RspGetHDInfoData(p2pAddress string) *protos.RspGetHDInfo {
 		WalletAddress: setting.WalletAddress,
 	}
 
-	diskStats, err := utils.GetDiskUsage(setting.Config.Home.StoragePath)
+	diskStats, err := file.GetStorageDiskUsage()
 	if err == nil {
-		diskStats.Total = setting.GetDiskSizeSoftCap(diskStats.Total)
 		rsp.DiskSize = int64(diskStats.Total)
 		rsp.DiskFree = int64(diskStats.Free)
 	} else {
@@ -857,10 +884,9 @@ func ReqNodeStatusData(p2pAddress string) *protos.ReqReportNodeStatus {
 
 	// Disk usage statistics
 	diskStat := &protos.DiskStat{}
-	info, err := utils.GetDiskUsage(setting.Config.Home.StoragePath)
+	info, err := file.GetStorageDiskUsage()
 	if err == nil {
 		diskStat.RootUsed = int64(info.Used)
-		info.Total = setting.GetDiskSizeSoftCap(info.Total)
 		diskStat.RootTotal = int64(info.Total)
 	} else {
 		utils.ErrorLog(
@@ -4,13 +4,17 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/stratosnet/sds/framework/msg"
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/pp/account"
 	"github.com/stratosnet/sds/pp/api"
 	"github.com/stratosnet/sds/pp/api/rest"
+	"github.com/stratosnet/sds/pp/api/s3"
+	"github.com/stratosnet/sds/pp/capture"
 	"github.com/stratosnet/sds/pp/event"
 	"github.com/stratosnet/sds/pp/file"
 	"github.com/stratosnet/sds/pp/metrics"
@@ -30,6 +34,7 @@ type BaseServer struct {
 	ipcServ     *namespace.IpcServer
 	httpRpcServ *namespace.HttpServer
 	monitorServ *namespace.HttpServer
+	capture     *capture.Writer
 }
 
 func (bs *BaseServer) Start() error {
@@ -46,6 +51,11 @@ func (bs *BaseServer) Start() error {
 		return err
 	}
 
+	err = file.OpenSliceStore()
+	if err != nil {
+		return err
+	}
+
 	err = bs.startP2pServer()
 	if err != nil {
 		return err
@@ -61,6 +71,11 @@ func (bs *BaseServer) Start() error {
 		return err
 	}
 
+	err = bs.startS3Gateway()
+	if err != nil {
+		return err
+	}
+
 	err = bs.startTrafficLog()
 	if err != nil {
 		return err
@@ -76,6 +91,21 @@ func (bs *BaseServer) Start() error {
 		return err
 	}
 
+	err = bs.startScrubJob()
+	if err != nil {
+		return err
+	}
+
+	err = bs.resumeUploadTasks()
+	if err != nil {
+		return err
+	}
+
+	err = bs.resumeDownloadTasks()
+	if err != nil {
+		return err
+	}
+
 	err = bs.startIPC()
 	if err != nil {
 		return err
@@ -222,13 +252,41 @@ func (bs *BaseServer) startP2pServer() error {
 	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
 	bs.p2pServ.AddConnConntextKey(types.PP_NETWORK_KEY)
 
-	bs.p2pServ.SetOptionFunctions(event.TimoutMap.OnWrite, nil, event.TimoutMap.OnHandle)
+	onWrite, onRead, err := bs.startCapture()
+	if err != nil {
+		return err
+	}
+	bs.p2pServ.SetOptionFunctions(onWrite, onRead, event.TimoutMap.OnHandle)
 	bs.p2pServ.Start(ctx)
 	_, _ = bs.p2pServ.ConnectToSP(ctx) // Ignore error if we can't connect to any SPs
 	bs.ppNetwork.StartPP(ctx)
 	return nil
 }
 
+// startCapture records the messages of the node when the capture is enabled, and returns the functions the connections
+// call when they write and read a message
+func (bs *BaseServer) startCapture() (func(context.Context, *msg.RelayMsgBuf), func(*msg.RelayMsgBuf), error) {
+	captureConfig := setting.Config.Traffic.Capture
+	if !captureConfig.Enabled {
+		return event.TimoutMap.OnWrite, nil, nil
+	}
+	writer, err := capture.NewWriter(captureConfig.Path, captureConfig.MaxSize*1024*1024, captureConfig.MaxFiles, captureConfig.Data)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed starting the capture of the messages")
+	}
+	bs.capture = writer
+	utils.Log("recording the messages into", captureConfig.Path)
+
+	onWrite := func(ctx context.Context, m *msg.RelayMsgBuf) {
+		event.TimoutMap.OnWrite(ctx, m)
+		writer.Capture(capture.DirectionOut, m)
+	}
+	onRead := func(m *msg.RelayMsgBuf) {
+		writer.Capture(capture.DirectionIn, m)
+	}
+	return onWrite, onRead, nil
+}
+
 func (bs *BaseServer) startTrafficLog() error {
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
@@ -251,6 +309,30 @@ func (bs *BaseServer) startReportTransferFailureJob() error {
 	return nil
 }
 
+func (bs *BaseServer) startScrubJob() error {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
+	event.StartScrubJob(ctx)
+	return nil
+}
+
+func (bs *BaseServer) resumeUploadTasks() error {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
+	event.ResumeUploadTasks(ctx)
+	return nil
+}
+
+func (bs *BaseServer) resumeDownloadTasks() error {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
+	event.ResumeDownloadTasks(ctx)
+	return nil
+}
+
 func (bs *BaseServer) startInternalApiServer() error {
 	if setting.Config.Keys.WalletAddress != "" && setting.Config.Streaming.InternalPort != "" {
 		ctx := context.Background()
@@ -275,6 +357,22 @@ func (bs *BaseServer) startRestServer() error {
 	return nil
 }
 
+func (bs *BaseServer) startS3Gateway() error {
+	// the S3 gateway is optional, it only starts when a port is configured
+	if setting.Config.S3Gateway.Port != "" {
+		ctx := context.Background()
+		ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
+		ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
+		go s3.StartHTTPServ(ctx)
+	}
+	return nil
+}
+
+// Drain refuses new slices and waits for the ongoing tasks to finish, at most timeout or until ctx is done
+func (bs *BaseServer) Drain(ctx context.Context, timeout time.Duration) bool {
+	return event.Drain(ctx, timeout)
+}
+
 func (bs *BaseServer) Stop() {
 	utils.DebugLogf("BaseServer.Stop ... ")
 	if bs.ipcServ != nil {
@@ -289,8 +387,13 @@ func (bs *BaseServer) Stop() {
 	if bs.p2pServ != nil {
 		bs.p2pServ.Stop()
 	}
+	if bs.capture != nil {
+		_ = bs.capture.Close()
+	}
 	StopDumpTrafficLog()
 	file.StopClearTmpFileJob()
 	event.StopReportTransferFailureJob()
+	event.StopScrubJob()
+	_ = file.CloseSliceStore()
 	// TODO: stop IPC, TrafficLog, InternalApiServer, RestServer
 }
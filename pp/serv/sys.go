@@ -13,6 +13,7 @@ import (
 
 	"github.com/stratosnet/sds/framework/client/cf"
 	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
 	"github.com/stratosnet/sds/pp/p2pserver"
 	"github.com/stratosnet/sds/pp/setting"
 )
@@ -72,7 +73,10 @@ func dumpTrafficLog(ctx context.Context) func() {
 		v, _ := mem.VirtualMemory()
 		c, _ := cpu.Info()
 		cc, _ := cpu.Percent(time.Second, false)
-		d, _ := utils.GetDiskUsage(setting.Config.Home.StoragePath)
+		d, err := file.GetStorageDiskUsage()
+		if err != nil {
+			d = &file.StorageUsage{}
+		}
 
 		//Memory
 		memTotal := v.Total
@@ -95,11 +99,10 @@ func dumpTrafficLog(ctx context.Context) func() {
 		cpuInfo := NewCpuInfo(cpuInfos, cpuUsedPercent)
 
 		//HD
-		d.Total = setting.GetDiskSizeSoftCap(d.Total)
 		hdTotal := d.Total
 		hdFree := d.Free
 		hdUsed := d.Used
-		hdUsedPercent := d.UsedPercent
+		hdUsedPercent := d.UsedPercent()
 		hdInfo := NewHdInfo(hdTotal, hdFree, hdUsed, hdUsedPercent)
 
 		//Traffic
@@ -136,6 +139,12 @@ func dumpTrafficLog(ctx context.Context) func() {
 				clientOutbound += out
 				return true
 			})
+
+			ps.RangeMuxConn(func(conn *cf.ClientConn) bool {
+				clientInbound += conn.GetInboundAndReset()
+				clientOutbound += conn.GetOutboundAndReset()
+				return true
+			})
 		}
 
 		trafficInbound := uint64(clientInbound + serverInbound)
@@ -173,9 +182,10 @@ func monitor(ctx context.Context) func() {
 		v, _ := mem.VirtualMemory()
 		c, _ := cpu.Info()
 		cc, _ := cpu.Percent(time.Second, false)
-		d, _ := utils.GetDiskUsage(setting.Config.Home.StoragePath)
-
-		d.Total = setting.GetDiskSizeSoftCap(d.Total)
+		d, err := file.GetStorageDiskUsage()
+		if err != nil {
+			d = &file.StorageUsage{}
+		}
 		// n, _ := host.Info()
 		// nv, _ := net.IOCounters(true)
 		// boottime, _ := host.BootTime()
@@ -198,7 +208,7 @@ func monitor(ctx context.Context) func() {
 		utils.Logf("        CPU Used    : %f%% ", cc[0])
 		// utils.Logf("        Network     : %v bytes / %v bytes", nv[0].BytesRxecv, nv[0].BytesSent)
 		// utils.Logf("        SystemBoot:%v", btime)
-		utils.Logf("        HD          : %v GB  Free: %v GB Usage:%f%% Path:%s", d.Total/1024/1024/1024, d.Free/1024/1024/1024, d.UsedPercent, d.Path)
+		utils.Logf("        HD          : %v GB  Free: %v GB Usage:%f%% Path:%s", d.Total/1024/1024/1024, d.Free/1024/1024/1024, d.UsedPercent(), setting.Config.Home.StoragePath)
 		// utils.Logf("        OS        : %v(%v)   %v  ", n.Platform, n.PlatformFamily, n.PlatformVersion)
 		// utils.Logf("        Hostname  : %v  ", n.Hostname)
 		r := int64(0)
@@ -231,6 +241,12 @@ func monitor(ctx context.Context) func() {
 				w += w1
 				return true
 			})
+
+			ps.RangeMuxConn(func(conn *cf.ClientConn) bool {
+				r += conn.GetSecondReadFlow()
+				w += conn.GetSecondWriteFlow()
+				return true
+			})
 		}
 
 		utils.Logf("        Upload      : %f MB/s ", float64(w)/1024/1024)
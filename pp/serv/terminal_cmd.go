@@ -453,6 +453,10 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 		return CmdResult{Msg: ""}, err
 	}
 
+	recursive := len(param) > 0 && param[0] == "-r"
+	if recursive {
+		param = param[1:]
+	}
 	if len(param) == 0 {
 		return CmdResult{}, errors.New("input upload file path")
 	}
@@ -461,8 +465,14 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 	if err = api.validateUploadPath(pathStr); err != nil {
 		return CmdResult{}, err
 	}
+	if recursive {
+		if isFile, err := file.IsFile(pathStr); err != nil || isFile {
+			return CmdResult{}, errors.New("the input path of 'put -r' must be a directory")
+		}
+	}
 
 	isEncrypted := false
+	sliceOptions := event.SliceOptions{}
 	desiredTier := uint32(DefaultDesiredUploadTier)
 	allowHigherTier := true
 
@@ -479,6 +489,21 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 				if err != nil {
 					return CmdResult{Msg: ""}, errors.Errorf("invalid param --isEncrypted. Should be true or false: %v ", err.Error())
 				}
+			case "--contentDefinedChunking":
+				sliceOptions.ContentDefinedChunking, err = strconv.ParseBool(kv[1])
+				if err != nil {
+					return CmdResult{Msg: ""}, errors.Errorf("invalid param --contentDefinedChunking. Should be true or false: %v ", err.Error())
+				}
+			case "--erasureCoding":
+				sliceOptions.ErasureDataSlices, sliceOptions.ErasureParitySlices, err = parseErasureCoding(kv[1])
+				if err != nil {
+					return CmdResult{Msg: ""}, errors.Errorf("invalid param --erasureCoding. Should be <data slices>+<parity slices>, e.g. 4+2: %v ", err.Error())
+				}
+			case "--compress":
+				sliceOptions.Compression, err = file.CheckCompression(kv[1])
+				if err != nil {
+					return CmdResult{Msg: ""}, errors.Errorf("invalid param --compress: %v ", err.Error())
+				}
 			case "--nodeTier":
 				tier, err := strconv.ParseUint(kv[1], 10, 32)
 				if err != nil {
@@ -500,11 +525,36 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 	}
 
 	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
-	event.RequestUploadFile(ctx, pathStr, isEncrypted, false, desiredTier, allowHigherTier,
+	if recursive {
+		go event.RequestUploadDirectory(ctx, pathStr, isEncrypted, sliceOptions, desiredTier, allowHigherTier,
+			setting.WalletAddress, setting.WalletPublicKey.Bytes())
+		return CmdResult{Msg: DefaultMsg}, nil
+	}
+	event.RequestUploadFile(ctx, pathStr, isEncrypted, false, sliceOptions, desiredTier, allowHigherTier,
 		setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil)
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+// parseErasureCoding parses the number of data and parity slices of a stripe, written as <data slices>+<parity slices>
+func parseErasureCoding(value string) (int, int, error) {
+	parts := strings.Split(value, "+")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("wrong format")
+	}
+	dataSlices, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	paritySlices, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	if dataSlices < 1 || dataSlices > file.ERASURE_MAX_DATA_SLICES || paritySlices < 1 || paritySlices > file.ERASURE_MAX_PARITY_SLICES {
+		return 0, 0, errors.Errorf("data and parity slices should be between 1 and %v", file.ERASURE_MAX_DATA_SLICES)
+	}
+	return dataSlices, paritySlices, nil
+}
+
 func (api *terminalCmd) UploadStream(ctx context.Context, param []string) (CmdResult, error) {
 	terminalId, param, err := getTerminalIdFromParam(param)
 	if err != nil {
@@ -552,7 +602,7 @@ func (api *terminalCmd) UploadStream(ctx context.Context, param []string) (CmdRe
 
 	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
 	ctx = core.RegisterRemoteReqId(ctx, uuid.New().String())
-	event.RequestUploadFile(ctx, pathStr, false, true, desiredTier, allowHigherTier,
+	event.RequestUploadFile(ctx, pathStr, false, true, event.SliceOptions{}, desiredTier, allowHigherTier,
 		setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil)
 	return CmdResult{Msg: DefaultMsg}, nil
 }
@@ -628,6 +678,13 @@ func (api *terminalCmd) Download(ctx context.Context, param []string) (CmdResult
 		return CmdResult{Msg: ""}, err
 	}
 
+	recursive := len(param) > 0 && param[0] == "-r"
+	if recursive {
+		param = param[1:]
+		if len(param) != 2 {
+			return CmdResult{}, errors.New("input the manifest path and the destination folder, e.g: get -r sdm://account_address/manifest_hash <dest>")
+		}
+	}
 	if len(param) == 0 {
 		return CmdResult{}, errors.New("input download path, e.g: sdm://account_address/file_hash|filename(optional)")
 	}
@@ -651,6 +708,10 @@ func (api *terminalCmd) Download(ctx context.Context, param []string) (CmdResult
 	}
 
 	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	if recursive {
+		go event.DownloadDirectory(ctx, param[0], param[1])
+		return CmdResult{Msg: DefaultMsg}, nil
+	}
 	core.RegisterReqId(ctx, task.LOCAL_REQID)
 	nowSec := time.Now().Unix()
 	if task.CheckDownloadTask(fileHash, setting.WalletAddress, task.LOCAL_REQID) {
@@ -942,6 +1003,41 @@ func (api *terminalCmd) PauseGet(ctx context.Context, param []string) (CmdResult
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+func (api *terminalCmd) ResumeGet(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	if len(param) < 1 {
+		return CmdResult{Msg: ""}, errors.New("input file hash of the download to resume")
+	}
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	if err = event.ResumeDownloadTask(ctx, param[0]); err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func (api *terminalCmd) Downloads(_ context.Context, _ []string) (CmdResult, error) {
+	summaries := event.ListDownloadTasks("")
+	if len(summaries) == 0 {
+		return CmdResult{Msg: "no download in the journal"}, nil
+	}
+
+	msg := ""
+	for _, summary := range summaries {
+		state := "paused"
+		if summary.Ongoing {
+			state = "ongoing"
+		}
+		msg += fmt.Sprintf("%v  %v  %d/%d slices  updated: %v  %v\n", summary.FileHash, state,
+			summary.FinishedSlices, summary.TotalSlices, time.Unix(summary.UpdateTime, 0).Format(time.RFC3339),
+			file.GetDownloadFilePath(summary.FileName, summary.SavePath))
+	}
+	return CmdResult{Msg: msg}, nil
+}
+
 func (api *terminalCmd) PausePut(ctx context.Context, param []string) (CmdResult, error) {
 	terminalId, param, err := getTerminalIdFromParam(param)
 	if err != nil {
@@ -956,6 +1052,41 @@ func (api *terminalCmd) PausePut(ctx context.Context, param []string) (CmdResult
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+func (api *terminalCmd) ResumePut(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	if len(param) < 1 {
+		return CmdResult{Msg: ""}, errors.New("input file hash of the upload to resume")
+	}
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	if err = event.ResumeUploadTask(ctx, param[0]); err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func (api *terminalCmd) Uploads(_ context.Context, _ []string) (CmdResult, error) {
+	summaries := event.ListUploadTasks()
+	if len(summaries) == 0 {
+		return CmdResult{Msg: "no upload in the journal"}, nil
+	}
+
+	msg := ""
+	for _, summary := range summaries {
+		state := "paused"
+		if summary.Ongoing {
+			state = "ongoing"
+		}
+		msg += fmt.Sprintf("%v  %v  %d/%d slices  retries: %d  updated: %v  %v\n", summary.FileHash, state,
+			summary.FinishedSlices, summary.TotalSlices, summary.RetryCount,
+			time.Unix(summary.UpdateTime, 0).Format(time.RFC3339), summary.FilePath)
+	}
+	return CmdResult{Msg: msg}, nil
+}
+
 func (api *terminalCmd) CancelGet(ctx context.Context, param []string) (CmdResult, error) {
 	terminalId, param, err := getTerminalIdFromParam(param)
 	if err != nil {
@@ -1059,6 +1190,204 @@ func (api *terminalCmd) DowngradeInfo(ctx context.Context, param []string) (CmdR
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+func (api *terminalCmd) Drain(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	usage := errors.New("usage: drain start <timeout> [--maintenance=<duration>] | drain stop | drain status")
+	if len(param) < 1 {
+		return CmdResult{Msg: ""}, usage
+	}
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	switch param[0] {
+	case "start":
+		if len(param) < 2 {
+			return CmdResult{Msg: ""}, errors.New("second parameter should be the drain timeout (in seconds)")
+		}
+		timeout, err := strconv.ParseUint(param[1], 10, 64)
+		if err != nil {
+			return CmdResult{Msg: ""}, errors.New("second parameter should be the drain timeout (in seconds)")
+		}
+		var then func(ctx context.Context, finished bool)
+		for _, p := range param[2:] {
+			kv := strings.SplitN(p, "=", 2)
+			if len(kv) != 2 || kv[0] != "--maintenance" {
+				return CmdResult{Msg: ""}, errors.Errorf("invalid param %v.", p)
+			}
+			duration, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				return CmdResult{Msg: ""}, errors.New("invalid param --maintenance. Should be the maintenance duration (in seconds)")
+			}
+			then = func(ctx context.Context, _ bool) {
+				if err := event.StartMaintenance(ctx, duration); err != nil {
+					pp.ErrorLog(ctx, "failed starting the maintenance after the drain,", err.Error())
+				}
+			}
+		}
+		if err = event.StartDrain(ctx, time.Duration(timeout)*time.Second, then); err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+	case "stop":
+		if err = event.StopDrain(ctx); err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+	case "status":
+		status := event.GetDrainStatus()
+		state := "not draining"
+		if status.Draining && status.Finished {
+			state = "drained"
+		} else if status.Draining {
+			state = fmt.Sprintf("draining, %v left", time.Until(status.Deadline).Round(time.Second))
+		}
+		ongoing := status.Ongoing
+		return CmdResult{Msg: fmt.Sprintf("%v  ongoing tasks: %v (upload %v, download %v, transfer %v, verify %v)", state,
+			ongoing.Total(), ongoing.Uploads, ongoing.Downloads, ongoing.Transfers, ongoing.Verifications)}, nil
+	default:
+		return CmdResult{Msg: ""}, usage
+	}
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func (api *terminalCmd) Scrub(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	action := "status"
+	if len(param) > 0 {
+		action = param[0]
+	}
+	switch action {
+	case "start":
+		if err = event.StartScrub(ctx); err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+	case "stop":
+		if err = event.StopScrub(); err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+	case "status":
+		status := event.GetScrubStatus()
+		msg := ""
+		if status.Running {
+			msg += fmt.Sprintf("running since %v: %v slices checked (%v MB), %v corrupt, %v unverifiable, %v unreadable\n",
+				status.Current.Started.Format(time.RFC3339), status.Current.Checked, status.Current.Bytes/1024/1024,
+				len(status.Current.Corrupt), status.Current.Unverifiable, status.Current.Failed)
+		}
+		if status.Last == nil {
+			return CmdResult{Msg: msg + "no complete check of the stored slices yet"}, nil
+		}
+		last := status.Last
+		msg += fmt.Sprintf("last check finished at %v in %v: %v slices checked (%v MB), %v corrupt, %v unverifiable, %v unreadable\n",
+			last.Finished.Format(time.RFC3339), last.Finished.Sub(last.Started).Round(time.Second), last.Checked,
+			last.Bytes/1024/1024, len(last.Corrupt), last.Unverifiable, last.Failed)
+		for _, sliceHash := range last.Corrupt {
+			msg += "quarantined " + sliceHash + "\n"
+		}
+		return CmdResult{Msg: msg}, nil
+	default:
+		return CmdResult{Msg: ""}, errors.New("usage: scrub [start|stop|status]")
+	}
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func (api *terminalCmd) Reputation(_ context.Context, param []string) (CmdResult, error) {
+	_, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	if len(param) > 0 {
+		if param[0] != "clear" {
+			return CmdResult{Msg: ""}, errors.New("usage: reputation [clear [p2pAddress]]")
+		}
+		p2pAddress := ""
+		if len(param) > 1 {
+			p2pAddress = param[1]
+		}
+		return CmdResult{Msg: fmt.Sprintf("cleared the reputation of %d nodes", task.ClearPeerReputation(p2pAddress))}, nil
+	}
+
+	reputations := task.GetPeerReputations()
+	if len(reputations) == 0 {
+		return CmdResult{Msg: "no slice transferred with other nodes yet"}, nil
+	}
+	now := time.Now()
+	msg := ""
+	for _, r := range reputations {
+		state := ""
+		if r.Blacklisted(now) {
+			state = "  blacklisted until " + r.BlacklistedUntil.Format(time.RFC3339)
+		}
+		msg += fmt.Sprintf("%v  score: %.2f  successes: %.1f  failures: %.1f  latency: %v%v\n", r.P2PAddress,
+			r.Score, r.Successes, r.Failures, r.Latency.Round(time.Millisecond), state)
+	}
+	return CmdResult{Msg: msg}, nil
+}
+
+func (api *terminalCmd) Usage(_ context.Context, param []string) (CmdResult, error) {
+	_, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	by := "files"
+	if len(param) > 0 {
+		by = param[0]
+	}
+	count := 10
+	if len(param) > 1 {
+		if count, err = strconv.Atoi(param[1]); err != nil || count <= 0 {
+			return CmdResult{Msg: ""}, errors.New("count must be a positive number")
+		}
+	}
+	msg := ""
+	switch by {
+	case "files":
+		files, err := file.GetFilesUsage(count)
+		if err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+		for _, f := range files {
+			msg += fmt.Sprintf("%v  %v MB in %v slices  owner: %v\n", f.FileHash, f.Bytes/1024/1024, f.Slices, usageOwner(f.Owner))
+		}
+	case "owners":
+		owners, err := file.GetOwnersUsage()
+		if err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+		for _, o := range owners {
+			msg += fmt.Sprintf("%v  %v MB in %v files, %v slices\n", usageOwner(o.Owner), o.Bytes/1024/1024, o.Files, o.Slices)
+		}
+	case "days":
+		days, err := file.GetDailyUsage(count)
+		if err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+		for _, d := range days {
+			msg += fmt.Sprintf("%v  %v slices, %v MB\n", d.Day, d.Slices, d.Bytes/1024/1024)
+		}
+	default:
+		return CmdResult{Msg: ""}, errors.New("usage: usage [files [count]|owners|days [count]]")
+	}
+	if msg == "" {
+		return CmdResult{Msg: "no slice stored"}, nil
+	}
+	return CmdResult{Msg: msg}, nil
+}
+
+// usageOwner the slices transferred from other nodes don't have the wallet of the file owner
+func usageOwner(owner string) string {
+	if owner == "" {
+		return "unknown"
+	}
+	return owner
+}
+
 func (api *terminalCmd) PerformanceMeasure(_ context.Context, _ []string) (CmdResult, error) {
 	// Parse params
 	metrics.StartLoggingPerformanceData()
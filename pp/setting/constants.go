@@ -15,7 +15,6 @@ const (
 	HDPath          = "m/44'/606'/0'/0/0"
 	HDPathP2p       = "m/44'/606'/0/0"
 	Bip39Passphrase = ""
-	P2pServerType   = "tcp4"
 
 	NodeReportIntervalSec         = 5 * 60       // Interval of node stat report, in seconds
 	PpLatencyCheckInterval        = 60 * 60 * 24 // interval for checking the latency peer PPs, in seconds
@@ -23,18 +22,23 @@ const (
 
 	MaxData            = 1024 * 1024 * 3 // max size of a piece in a slice
 	MaxSliceSize       = 1024 * 1024 * 32
+	CdcMinSliceSize    = MaxSliceSize / 16 // smallest slice cut by content defined chunking, except the last one
+	CdcAvgSliceSize    = MaxSliceSize / 4  // targeted slice size of content defined chunking
 	ImagePath          = "./images/"
 	VideoPath          = "./videos"
 	DownloadPathMinLen = 88
 
 	StreamCacheMaxSlice = 2
 
+	MaxDownloadSlicesPerPeer = 4 // slices requested from a resource node at the same time by a download
+
 	DefaultMaxConnections = 1000
 
 	DefaultMinUnsuspendDeposit = "1stos" // 1 stos
 
 	SpamThresholdSpSignLatency   = 60 // in second
 	SpamThresholdSliceOperations = 6 * time.Hour
+	SpamThresholdReqTime         = 60 // in second, how far the time of a signed client request can be from now
 
 	SoftRamLimit          = int64(15 * units.GiB)
 	SoftRamLimitDev       = int64(1500 * units.MiB)
@@ -6,6 +6,7 @@ import (
 
 	externalip "github.com/glendc/go-external-ip"
 
+	"github.com/stratosnet/sds/framework/core"
 	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
 	"github.com/stratosnet/sds/framework/utils"
 	msgtypes "github.com/stratosnet/sds/sds-msg/types"
@@ -31,6 +32,9 @@ var NetworkAddress string
 
 var NetworkIP net.IP
 
+// NetworkPort the port of the NetworkAddress, other than the network_port when the node is reached through a relay
+var NetworkPort string
+
 var RestAddress string
 
 var MonitorInitialToken string
@@ -84,13 +88,41 @@ func SetMyNetworkAddress() {
 		}
 		NetworkIP = ipList[0]
 	}
-	NetworkAddress = NetworkIP.String() + ":" + Config.Node.Connectivity.NetworkPort
+	NetworkPort = Config.Node.Connectivity.NetworkPort
+	NetworkAddress = NetworkIP.String() + ":" + NetworkPort
 	RestAddress = NetworkIP.String() + ":" + Config.Streaming.RestPort
 }
 
+// SetAdvertisedNetworkAddress replaces the NetworkAddress the other nodes connect to, when the node is reached through
+// the gateway of its network or through a relay
+func SetAdvertisedNetworkAddress(ip net.IP, port string) {
+	NetworkIP = ip
+	NetworkPort = port
+	NetworkAddress = net.JoinHostPort(ip.String(), port)
+	utils.Log("setting.NetworkAddress", NetworkAddress)
+}
+
+// GetNetworkPort returns the port the other nodes connect to
+func GetNetworkPort() string {
+	if NetworkPort == "" {
+		return Config.Node.Connectivity.NetworkPort
+	}
+	return NetworkPort
+}
+
 func GetP2pServerPort() string {
 	if Config.Node.Connectivity.LocalPort == "" {
 		return Config.Node.Connectivity.NetworkPort
 	}
 	return Config.Node.Connectivity.LocalPort
 }
+
+// GetP2pTransport returns the transport of the connections with the other resource nodes. The meta nodes are always
+// connected to over TCP
+func GetP2pTransport() core.Transport {
+	transport, err := core.GetTransport(Config.Node.Connectivity.Transport)
+	if err != nil {
+		return core.TCPTransport
+	}
+	return transport
+}
@@ -11,8 +11,9 @@ import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
-	"github.com/stratosnet/sds/framework/client/cf"
+	"github.com/stratosnet/sds/framework/core"
 	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/nat"
 	"github.com/stratosnet/sds/tx-client/grpc"
 )
 
@@ -76,10 +77,18 @@ type BlockchainConfig struct {
 }
 
 type HomeConfig struct {
-	AccountsPath string `toml:"accounts_path" comment:"Key files (wallet and P2P key). Eg: \"./accounts\""`
-	DownloadPath string `toml:"download_path" comment:"Where downloaded files will go. Eg: \"./download\""`
-	PeersPath    string `toml:"peers_path" comment:"The list of peers (other sds nodes). Eg: \"./peers\""`
-	StoragePath  string `toml:"storage_path" comment:"Where files are stored. Eg: \"./storage\""`
+	AccountsPath  string              `toml:"accounts_path" comment:"Key files (wallet and P2P key). Eg: \"./accounts\""`
+	DownloadPath  string              `toml:"download_path" comment:"Where downloaded files will go. Eg: \"./download\""`
+	PeersPath     string              `toml:"peers_path" comment:"The list of peers (other sds nodes). Eg: \"./peers\""`
+	StoragePath   string              `toml:"storage_path" comment:"Where files are stored. Eg: \"./storage\""`
+	StorageLayout string              `toml:"storage_layout" comment:"How the slices are laid out in storage_path: \"dir\" keeps one file per slice, \"pack\" appends the slices to large segment files, which suits nodes storing millions of slices. Switching to \"pack\" moves the slices already stored to the segments when the node starts, there is no way back. Eg: \"dir\""`
+	StoragePools  []StoragePoolConfig `toml:"storage_pools" comment:"(Optional)Disks the slices are stored on, instead of storage_path alone. storage_path still keeps the slice index, it should be on a reliable disk. Eg: [{path = \"/mnt/disk1/sds\", max_usage = 7629394, weight = 1}]"`
+}
+
+type StoragePoolConfig struct {
+	Path     string `toml:"path" comment:"Folder of the disk where slices are stored"`
+	MaxUsage uint64 `toml:"max_usage" comment:"When not 0, limit disk usage to this amount (in megabytes)"`
+	Weight   uint64 `toml:"weight" comment:"Share of the new slices placed on this disk, relative to the other disks. When all weights are 0, new slices go to the disk with the most free space"`
 }
 
 type KeysConfig struct {
@@ -91,21 +100,38 @@ type KeysConfig struct {
 }
 
 type ConnectivityConfig struct {
-	SeedMetaNode   SPBaseInfo `toml:"seed_meta_node" comment:"The first meta node to connect to when starting the node"`
-	Internal       bool       `toml:"internal" comment:"Is the node running on an internal network? Eg: false"`
-	NetworkAddress string     `toml:"network_address" comment:"Domain name or IP address of the node. Eg: \"127.0.0.1\""`
-	NetworkPort    string     `toml:"network_port" comment:"Main port for communication on the network. Must be open to the internet. Eg: \"18081\""`
-	LocalPort      string     `toml:"local_port" comment:"(Optional)If not empty, the node will listen to this port locally, but other nodes will still use the network_port to connect to this node"`
-	MetricsPort    string     `toml:"metrics_port" comment:"Port for prometheus metrics"`
-	RpcPort        string     `toml:"rpc_port" comment:"Port for the JSON-RPC api. See https://docs.thestratos.org/docs-resource-node/sds-rpc-for-file-operation/"`
-	RpcNamespaces  string     `toml:"rpc_namespaces" comment:"Namespaces enabled in the RPC API. Eg: \"user,owner\""`
-	RpcVhosts      string     `toml:"rpc_allowed_hosts" comment:"RPC server vhosts settings."`
+	SeedMetaNode    SPBaseInfo  `toml:"seed_meta_node" comment:"The first meta node to connect to when starting the node"`
+	Internal        bool        `toml:"internal" comment:"Is the node running on an internal network? Eg: false"`
+	NetworkAddress  string      `toml:"network_address" comment:"Domain name or IP address of the node. Eg: \"127.0.0.1\""`
+	NetworkPort     string      `toml:"network_port" comment:"Main port for communication on the network. Must be open to the internet. Eg: \"18081\""`
+	LocalPort       string      `toml:"local_port" comment:"(Optional)If not empty, the node will listen to this port locally, but other nodes will still use the network_port to connect to this node"`
+	MetricsPort     string      `toml:"metrics_port" comment:"Port for prometheus metrics"`
+	RpcPort         string      `toml:"rpc_port" comment:"Port for the JSON-RPC api. See https://docs.thestratos.org/docs-resource-node/sds-rpc-for-file-operation/"`
+	RpcNamespaces   string      `toml:"rpc_namespaces" comment:"Namespaces enabled in the RPC API. Eg: \"user,owner\""`
+	RpcVhosts       string      `toml:"rpc_allowed_hosts" comment:"RPC server vhosts settings."`
+	Transport       string      `toml:"transport" comment:"Transport of the connections with the other resource nodes, \"tcp\" or \"quic\". With quic, the node also listens to the network_port over UDP, and connections to the nodes without QUIC fall back to tcp. Eg: \"tcp\""`
+	Nat             string      `toml:"nat" comment:"Map the network_port on the gateway of the local network on start, \"upnp\", \"pmp\", \"any\" or \"none\". Eg: \"any\""`
+	Relay           RelayConfig `toml:"relay" comment:"Relaying of the connections to the nodes that are not reachable from the internet"`
+	RequirePeerAuth bool        `toml:"require_peer_auth" comment:"Reject the nodes that don't prove they own their P2P address during the handshake. The nodes that support it always prove it, and are checked against their registered P2P key. The meta nodes are connected to without it. Only the authenticated nodes can stop a slice being sent to them, or affect the reputation of a node. Eg: true"`
+}
+
+type RelayConfig struct {
+	Address string `toml:"address" comment:"(Optional)Network address and relay port of a resource node relaying the connections to this node, used when the meta node can't connect back to this node. Eg: \"1.2.3.4:18091\""`
+	Port    string `toml:"port" comment:"(Optional)If not empty, relay the connections to the nodes that are not reachable from the internet. They register to this port. Eg: \"18091\""`
+	Ports   string `toml:"ports" comment:"Ports the relay listens to, one for each relayed node. They must be open to the internet. Eg: \"18092-18099\""`
 }
 
 type NodeConfig struct {
 	Debug        bool               `toml:"debug" comment:"Should debug info be printed out in logs? Eg: false"`
 	MaxDiskUsage uint64             `toml:"max_disk_usage" comment:"When not 0, limit disk usage to this amount (in megabytes) Eg: 7629394 = 8 * 1000 * 1000 * 1000 * 1000 / 1024 / 1024  (8TB) "`
+	DrainTimeout uint64             `toml:"drain_timeout" comment:"When stopped with SIGTERM, the node refuses new slices and waits this long for the ongoing tasks to finish before exiting (in seconds). 0 exits at once. Eg: 300"`
 	Connectivity ConnectivityConfig `toml:"connectivity"`
+	Scrub        ScrubConfig        `toml:"scrub" comment:"Background check of the stored slices. The corrupt ones are moved to the quarantine folder of the storage and reported to the meta node"`
+}
+
+type ScrubConfig struct {
+	Interval uint64 `toml:"interval" comment:"Interval between two checks of all the stored slices (in hours). 0 disables the check, it can still be started with the scrub command. Eg: 168"`
+	Rate     uint64 `toml:"rate" comment:"Max speed at which the slices are read (in KB/s). 0 means unlimited. Eg: 10240"`
 }
 
 type MonitorConfig struct {
@@ -117,10 +143,29 @@ type MonitorConfig struct {
 }
 
 type TrafficConfig struct {
-	LogInterval     uint64 `toml:"log_interval" comment:"Interval at which traffic is logged (in seconds) Eg: 10"`
-	MaxConnections  int    `toml:"max_connections" comment:"Max number of concurrent network connections. Eg: 1000"`
-	MaxDownloadRate uint64 `toml:"max_download_rate" comment:"Max number of download messages received per second (per connection). 0 Means unlimited. 1000 ≈ 1MB/sec. Eg: 1000"`
-	MaxUploadRate   uint64 `toml:"max_upload_rate" comment:"Max number of upload messages sent per second (per connection). 0 Means unlimited. 1000 ≈ 1MB/sec. Eg: 1000"`
+	LogInterval    uint64        `toml:"log_interval" comment:"Interval at which traffic is logged (in seconds) Eg: 10"`
+	MaxConnections int           `toml:"max_connections" comment:"Max number of concurrent network connections. Eg: 1000"`
+	Shaping        ShapingConfig `toml:"shaping" comment:"Bandwidth used by the slices sent to (served) and received from (fetched) the other nodes. It can be changed while the node runs with the config command, eg: config traffic.shaping.max_serve_rate 2048"`
+	Capture        CaptureConfig `toml:"capture" comment:"Recording of the messages exchanged with the other nodes, for debugging. The messages received can be replayed with ppd replay"`
+	// MaxDownloadRate and MaxUploadRate are the limits of the older versions, moved to Shaping when the config is loaded
+	MaxDownloadRate uint64 `toml:"max_download_rate,omitempty"`
+	MaxUploadRate   uint64 `toml:"max_upload_rate,omitempty"`
+}
+
+type CaptureConfig struct {
+	Enabled  bool   `toml:"enabled" comment:"Record the messages from the start of the node. Eg: false"`
+	Path     string `toml:"path" comment:"File the messages are recorded into. Eg: \"./tmp/capture/messages.capture\""`
+	MaxSize  int64  `toml:"max_size" comment:"Size of the file (in megabytes) before it is rotated. Eg: 100"`
+	MaxFiles int    `toml:"max_files" comment:"Number of rotated files kept. Eg: 5"`
+	Data     bool   `toml:"data" comment:"Also record the slices, which the replay of the slice transfers needs. Eg: false"`
+}
+
+type ShapingConfig struct {
+	MaxServeRate     uint64 `toml:"max_serve_rate" comment:"Max bandwidth used to send slices, all nodes together (in KB/s). 0 means unlimited. Eg: 0"`
+	MaxFetchRate     uint64 `toml:"max_fetch_rate" comment:"Max bandwidth used to receive slices, all nodes together (in KB/s). 0 means unlimited. Eg: 0"`
+	MaxPeerServeRate uint64 `toml:"max_peer_serve_rate" comment:"Max bandwidth used to send slices to each node (in KB/s). 0 means unlimited. Eg: 0"`
+	MaxPeerFetchRate uint64 `toml:"max_peer_fetch_rate" comment:"Max bandwidth used to receive slices from each node (in KB/s). 0 means unlimited. Eg: 0"`
+	Schedule         string `toml:"schedule" comment:"Times of the day (local time) when the limits apply, full speed the rest of the time. Empty means always. Eg: \"08:00-12:00,13:00-19:00\""`
 }
 
 type StreamingConfig struct {
@@ -128,6 +173,14 @@ type StreamingConfig struct {
 	RestPort     string `toml:"rest_port" comment:"Port for the REST server"`
 }
 
+type S3GatewayConfig struct {
+	Port      string `toml:"port" comment:"(Optional)If not empty, an S3 compatible gateway storing the objects as files of the node wallet listens to this port. Eg: \"18781\""`
+	AccessKey string `toml:"access_key" comment:"Access key id the S3 clients sign their requests with (AWS signature V4). When empty, requests are not authenticated and the gateway only listens on 127.0.0.1"`
+	SecretKey string `toml:"secret_key" comment:"Secret access key of the S3 clients"`
+	Region    string `toml:"region" comment:"Region the S3 clients sign their requests for. Eg: \"us-east-1\""`
+	IndexPath string `toml:"index_path" comment:"Where the gateway keeps the index of the buckets and objects, and the parts of multipart uploads. Eg: \"./s3\""`
+}
+
 type WebServerConfig struct {
 	Path           string `toml:"path" comment:"Location of the web server files Eg: \"./web\""`
 	Port           string `toml:"port" comment:"Port where the web server is hosted with sdsweb. If the port is opened and token_on_startup is true, anybody who loads the monitor UI will have full access to the monitor"`
@@ -143,6 +196,7 @@ type config struct {
 	Monitor    MonitorConfig    `toml:"monitor" comment:"Configuration for the monitor server"`
 	Streaming  StreamingConfig  `toml:"streaming" comment:"Configuration for video streaming"`
 	Traffic    TrafficConfig    `toml:"traffic"`
+	S3Gateway  S3GatewayConfig  `toml:"s3_gateway" comment:"Configuration for the S3 compatible gateway"`
 	WebServer  WebServerConfig  `toml:"web_server" comment:"Configuration for the web server (when running sdsweb)"`
 }
 
@@ -178,8 +232,24 @@ func LoadConfig(configPath string) error {
 		IsWindows = false
 	}
 
-	cf.SetMaxDownloadRate(Config.Traffic.MaxDownloadRate)
-	cf.SetMaxUploadRate(Config.Traffic.MaxUploadRate)
+	if _, err = core.GetTransport(Config.Node.Connectivity.Transport); err != nil {
+		return err
+	}
+	if err = nat.CheckMethod(Config.Node.Connectivity.Nat); err != nil {
+		return err
+	}
+	if Config.Node.Connectivity.Relay.Port != "" {
+		if _, _, err = nat.ParsePortRange(Config.Node.Connectivity.Relay.Ports); err != nil {
+			return errors.Wrap(err, "invalid relay ports")
+		}
+	}
+
+	if err = migrateTrafficRates(); err != nil {
+		return err
+	}
+	if err = setShaping(Config.Traffic.Shaping); err != nil {
+		return err
+	}
 
 	// todo: we shouldn't call grpc package to setup a global variable
 	grpc.SERVER = Config.Blockchain.GrpcServer
@@ -202,8 +272,8 @@ func SetConfig(key string, value interface{}) error {
 		return err
 	}
 
-	// Read existing value
-	if !tomlTree.Has(key) {
+	// Read existing value. The keys added after the config file was generated are added to the file
+	if !tomlTree.Has(key) && !hasConfigKey(key) {
 		return errors.Errorf("Key [%v] doesn't exist", key)
 	}
 	existingValue := tomlTree.Get(key)
@@ -240,6 +310,55 @@ func SetConfig(key string, value interface{}) error {
 	return LoadConfig(ConfigPath)
 }
 
+func hasConfigKey(key string) bool {
+	data, err := toml.Marshal(Config)
+	if err != nil {
+		return false
+	}
+	tomlTree, err := toml.LoadBytes(data)
+	if err != nil {
+		return false
+	}
+	return tomlTree.Has(key)
+}
+
+// migrateTrafficRates moves the message rates of a config generated by an older version to the per node limits of the
+// shaping. A message was about 1 KB
+func migrateTrafficRates() error {
+	traffic := &Config.Traffic
+	if traffic.MaxDownloadRate == 0 && traffic.MaxUploadRate == 0 {
+		return nil
+	}
+	if traffic.Shaping.MaxPeerFetchRate == 0 {
+		traffic.Shaping.MaxPeerFetchRate = traffic.MaxDownloadRate
+	}
+	if traffic.Shaping.MaxPeerServeRate == 0 {
+		traffic.Shaping.MaxPeerServeRate = traffic.MaxUploadRate
+	}
+	utils.Logf("traffic.max_download_rate and traffic.max_upload_rate are replaced by traffic.shaping.max_peer_fetch_rate (%v KB/s) and traffic.shaping.max_peer_serve_rate (%v KB/s)",
+		traffic.Shaping.MaxPeerFetchRate, traffic.Shaping.MaxPeerServeRate)
+	traffic.MaxDownloadRate, traffic.MaxUploadRate = 0, 0
+	if err := FlushConfig(); err != nil {
+		return errors.Wrap(err, "failed saving the migrated traffic config")
+	}
+	return nil
+}
+
+func setShaping(shaping ShapingConfig) error {
+	schedule, err := core.ParseShapingSchedule(shaping.Schedule)
+	if err != nil {
+		return errors.Wrap(err, "invalid shaping schedule")
+	}
+	core.SetShaping(core.ShapingLimits{
+		Serve:     shaping.MaxServeRate * 1024,
+		Fetch:     shaping.MaxFetchRate * 1024,
+		PeerServe: shaping.MaxPeerServeRate * 1024,
+		PeerFetch: shaping.MaxPeerFetchRate * 1024,
+		Schedule:  schedule,
+	})
+	return nil
+}
+
 func FlushConfig() error {
 	return utils.WriteTomlConfig(Config, ConfigPath)
 }
@@ -254,10 +373,11 @@ func DefaultConfig() *config {
 			GrpcServer:    "grpc.thestratos.org:443",
 		},
 		Home: HomeConfig{
-			AccountsPath: "./accounts",
-			DownloadPath: "./download",
-			PeersPath:    "./peers",
-			StoragePath:  "./storage",
+			AccountsPath:  "./accounts",
+			DownloadPath:  "./download",
+			PeersPath:     "./peers",
+			StoragePath:   "./storage",
+			StorageLayout: "dir",
 		},
 		Keys: KeysConfig{
 			P2PAddress:         "",
@@ -269,19 +389,27 @@ func DefaultConfig() *config {
 		Node: NodeConfig{
 			Debug:        false,
 			MaxDiskUsage: 8 * 1000 * 1000 * 1000 * 1000 / 1024 / 1024, // 8TB,
+			DrainTimeout: 300,
 			Connectivity: ConnectivityConfig{
 				SeedMetaNode: SPBaseInfo{
 					P2PAddress:     meta_p2p,
 					P2PPublicKey:   meta_pubkey,
 					NetworkAddress: meta_net,
 				},
-				Internal:       false,
-				NetworkAddress: "127.0.0.1",
-				NetworkPort:    "18081",
-				LocalPort:      "",
-				MetricsPort:    "18181",
-				RpcPort:        "18281",
-				RpcNamespaces:  "user",
+				Internal:        false,
+				NetworkAddress:  "127.0.0.1",
+				NetworkPort:     "18081",
+				LocalPort:       "",
+				MetricsPort:     "18181",
+				RpcPort:         "18281",
+				RpcNamespaces:   "user",
+				Transport:       core.TransportTCP,
+				Nat:             nat.MethodAny,
+				RequirePeerAuth: true,
+			},
+			Scrub: ScrubConfig{
+				Interval: 168,
+				Rate:     10240,
 			},
 		},
 		Monitor: MonitorConfig{
@@ -296,10 +424,22 @@ func DefaultConfig() *config {
 			RestPort:     "18581",
 		},
 		Traffic: TrafficConfig{
-			LogInterval:     10,
-			MaxConnections:  DefaultMaxConnections,
-			MaxDownloadRate: 0,
-			MaxUploadRate:   0,
+			LogInterval:    10,
+			MaxConnections: DefaultMaxConnections,
+			Capture: CaptureConfig{
+				Enabled:  false,
+				Path:     "./tmp/capture/messages.capture",
+				MaxSize:  100,
+				MaxFiles: 5,
+				Data:     false,
+			},
+		},
+		S3Gateway: S3GatewayConfig{
+			Port:      "",
+			AccessKey: "",
+			SecretKey: "",
+			Region:    "us-east-1",
+			IndexPath: "./s3",
 		},
 		WebServer: WebServerConfig{
 			Path:           "./web",
@@ -338,11 +478,31 @@ func formalizePaths() (err error) {
 		return err
 	}
 
+	for i := range Config.Home.StoragePools {
+		if Config.Home.StoragePools[i].Path == "" {
+			return errors.New("the path of a storage pool is not configured")
+		}
+		Config.Home.StoragePools[i].Path, err = formalizePath(Config.Home.StoragePools[i].Path, "")
+		if err != nil {
+			return err
+		}
+	}
+
 	Config.WebServer.Path, err = formalizePath(Config.WebServer.Path, defaultValues.WebServer.Path)
 	if err != nil {
 		return err
 	}
 
+	Config.S3Gateway.IndexPath, err = formalizePath(Config.S3Gateway.IndexPath, defaultValues.S3Gateway.IndexPath)
+	if err != nil {
+		return err
+	}
+
+	Config.Traffic.Capture.Path, err = formalizePath(Config.Traffic.Capture.Path, defaultValues.Traffic.Capture.Path)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -359,12 +519,13 @@ func formalizePath(path, defaultValue string) (newPath string, err error) {
 	return newPath, err
 }
 
-func GetDiskSizeSoftCap(actualTotal uint64) uint64 {
-	maxDiskBytes := Config.Node.MaxDiskUsage * 1024 * 1024 // MB to B
-	if maxDiskBytes != 0 && maxDiskBytes < actualTotal {
-		return maxDiskBytes
+// GetStoragePools returns the disks the slices are stored on. Without storage_pools, it is storage_path capped by
+// max_disk_usage
+func GetStoragePools() []StoragePoolConfig {
+	if len(Config.Home.StoragePools) > 0 {
+		return Config.Home.StoragePools
 	}
-	return actualTotal
+	return []StoragePoolConfig{{Path: Config.Home.StoragePath, MaxUsage: Config.Node.MaxDiskUsage}}
 }
 
 func GetDataBufferSize() int {
@@ -0,0 +1,37 @@
+package setting
+
+import (
+	"sync"
+)
+
+// optional features an SP announces when the node registers. The node only relies on them with an SP announcing them,
+// and falls back to the previous behaviour otherwise
+const (
+	SpFeatureErasureLayout = "erasure_layout"       // keeps the erasure layout of the files
+	SpFeatureCompression   = "compression"          // keeps the compression of the files
+	SpFeatureClearSlice    = "clear_download_slice" // nodes stop sending a single slice on ReqClearDownloadTask
+	SpFeatureLostSlices    = "lost_slices"          // answers ReqReportLostSlices
+	SpFeatureSliceIndex    = "slice_index"          // answers ReqSliceIndex
+)
+
+var spFeatures = struct {
+	features map[string]bool
+	mutex    sync.RWMutex
+}{}
+
+// SetSpFeatures records the features announced by the SP the node registered to
+func SetSpFeatures(features []string) {
+	spFeatures.mutex.Lock()
+	defer spFeatures.mutex.Unlock()
+	spFeatures.features = make(map[string]bool, len(features))
+	for _, feature := range features {
+		spFeatures.features[feature] = true
+	}
+}
+
+// SpSupports tells whether the SP the node registered to announced the feature
+func SpSupports(feature string) bool {
+	spFeatures.mutex.RLock()
+	defer spFeatures.mutex.RUnlock()
+	return spFeatures.features[feature]
+}
@@ -0,0 +1,155 @@
+package task
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+var downloadJournalMutex sync.Mutex
+
+// DownloadJournal is the on-disk record of a file download task, so that the task survives a restart of the node. The
+// slices already downloaded are the ones recorded in the download tmp folder, next to their data
+type DownloadJournal struct {
+	FileHash      string   `json:"file_hash"`
+	FileReqId     string   `json:"file_req_id"`
+	WalletAddress string   `json:"wallet_address"`
+	FileName      string   `json:"file_name"`
+	FileSize      uint64   `json:"file_size"`
+	SavePath      string   `json:"save_path"`
+	EncryptionTag string   `json:"encryption_tag"`
+	ShareKeyword  string   `json:"share_keyword,omitempty"` // set when the file is downloaded through a share link
+	Slices        []string `json:"slices"`
+	UpdateTime    int64    `json:"update_time"`
+}
+
+// DownloadJournalSummary is a human-readable view of a journaled download
+type DownloadJournalSummary struct {
+	FileHash       string
+	FileReqId      string
+	FileName       string
+	SavePath       string
+	TotalSlices    int
+	FinishedSlices int
+	UpdateTime     int64
+	Ongoing        bool
+}
+
+func getDownloadJournalPath(fileHash, fileReqId string) string {
+	return filepath.Join(file.GetDownloadJournalFolderPath(), fileHash+fileReqId+journalSuffix)
+}
+
+func readDownloadJournal(journalPath string) (*DownloadJournal, error) {
+	data, err := os.ReadFile(journalPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading download journal")
+	}
+	journal := &DownloadJournal{}
+	if err = json.Unmarshal(data, journal); err != nil {
+		return nil, errors.Wrap(err, "failed parsing download journal")
+	}
+	return journal, nil
+}
+
+// writeDownloadJournal saves the journal to disk. The caller must hold downloadJournalMutex
+func writeDownloadJournal(journal *DownloadJournal) error {
+	journal.UpdateTime = time.Now().Unix()
+	data, err := json.Marshal(journal)
+	if err != nil {
+		return errors.Wrap(err, "failed marshalling download journal")
+	}
+	return writeJournalFile(getDownloadJournalPath(journal.FileHash, journal.FileReqId), data)
+}
+
+// SaveDownloadJournal records the slice list of a download from the storage info sent by the SP
+func SaveDownloadJournal(target *protos.RspFileStorageInfo) error {
+	downloadJournalMutex.Lock()
+	defer downloadJournalMutex.Unlock()
+
+	journal := &DownloadJournal{
+		FileHash:      target.FileHash,
+		FileReqId:     target.ReqId,
+		WalletAddress: target.WalletAddress,
+		FileName:      target.FileName,
+		FileSize:      target.FileSize,
+		SavePath:      target.SavePath,
+		EncryptionTag: target.EncryptionTag,
+		ShareKeyword:  target.KeyWord,
+	}
+	for _, slice := range target.SliceInfo {
+		journal.Slices = append(journal.Slices, slice.SliceStorageInfo.SliceHash)
+	}
+	return writeDownloadJournal(journal)
+}
+
+// DeleteDownloadJournal removes the journal of a download task which has ended
+func DeleteDownloadJournal(fileHash, fileReqId string) {
+	downloadJournalMutex.Lock()
+	defer downloadJournalMutex.Unlock()
+
+	err := os.Remove(getDownloadJournalPath(fileHash, fileReqId))
+	if err != nil && !os.IsNotExist(err) {
+		utils.ErrorLog("failed deleting download journal", fileHash, err)
+	}
+}
+
+// LoadDownloadJournal reads the journal of the download of the given file
+func LoadDownloadJournal(fileHash, fileReqId string) (*DownloadJournal, error) {
+	downloadJournalMutex.Lock()
+	defer downloadJournalMutex.Unlock()
+	return readDownloadJournal(getDownloadJournalPath(fileHash, fileReqId))
+}
+
+// LoadDownloadJournals reads all the download journals found on disk
+func LoadDownloadJournals() []*DownloadJournal {
+	downloadJournalMutex.Lock()
+	defer downloadJournalMutex.Unlock()
+
+	entries, err := os.ReadDir(file.GetDownloadJournalFolderPath())
+	if err != nil {
+		return nil
+	}
+	var journals []*DownloadJournal
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), journalSuffix) {
+			continue
+		}
+		journal, err := readDownloadJournal(filepath.Join(file.GetDownloadJournalFolderPath(), entry.Name()))
+		if err != nil {
+			utils.ErrorLog(err)
+			continue
+		}
+		journals = append(journals, journal)
+	}
+	return journals
+}
+
+// Summary returns a short description of the journaled download
+func (j *DownloadJournal) Summary() DownloadJournalSummary {
+	downloaded := file.GetDownloadedSlices(j.FileHash, j.FileName)
+	finished := 0
+	for _, sliceHash := range j.Slices {
+		if downloaded[sliceHash] {
+			finished++
+		}
+	}
+	return DownloadJournalSummary{
+		FileHash:       j.FileHash,
+		FileReqId:      j.FileReqId,
+		FileName:       j.FileName,
+		SavePath:       j.SavePath,
+		TotalSlices:    len(j.Slices),
+		FinishedSlices: finished,
+		UpdateTime:     j.UpdateTime,
+		Ongoing:        CheckDownloadTask(j.FileHash, j.WalletAddress, j.FileReqId),
+	}
+}
@@ -0,0 +1,52 @@
+package task
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestDownloadJournal(t *testing.T) {
+	setting.SetupRoot(t.TempDir())
+	target := &protos.RspFileStorageInfo{FileHash: "file1", ReqId: "req1", WalletAddress: "wallet1", FileName: "name1"}
+	for _, sliceHash := range []string{"slice1", "slice2", "slice3"} {
+		target.SliceInfo = append(target.SliceInfo, &protos.DownloadSliceInfo{
+			SliceStorageInfo: &protos.SliceStorageInfo{SliceHash: sliceHash},
+		})
+	}
+	if err := SaveDownloadJournal(target); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := os.ReadFile(getDownloadJournalPath("file1", "req1"))
+
+	// the downloaded slices are read from the download progress, the journal is not written again
+	csvPath := file.GetDownloadTmpCsvPath("file1", "name1")
+	if err := os.MkdirAll(filepath.Dir(csvPath), 0700); err != nil {
+		t.Fatal(err)
+	}
+	file.SaveDownloadProgress(context.Background(), "slice2", "name1", "file1", "", "req1")
+	file.SaveDownloadProgress(context.Background(), "other", "name1", "file1", "", "req1")
+	after, _ := os.ReadFile(getDownloadJournalPath("file1", "req1"))
+	if string(after) != string(before) {
+		t.Fatal("the journal should not be written for each slice")
+	}
+
+	journals := LoadDownloadJournals()
+	if len(journals) != 1 {
+		t.Fatalf("wrong journals %+v", journals)
+	}
+	summary := journals[0].Summary()
+	if summary.FileReqId != "req1" || summary.TotalSlices != 3 || summary.FinishedSlices != 1 {
+		t.Fatalf("wrong journal %+v", summary)
+	}
+
+	DeleteDownloadJournal("file1", "req1")
+	if journals = LoadDownloadJournals(); len(journals) != 0 {
+		t.Fatalf("the journal should be deleted %+v", journals)
+	}
+}
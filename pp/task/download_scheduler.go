@@ -0,0 +1,474 @@
+package task
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alex023/clock"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	// DownloadSliceStallTimeout a slice receiving no data for this long is moved to another resource node
+	DownloadSliceStallTimeout = 30 * time.Second
+
+	// weight of the latest measurement in the moving averages of the peer stats
+	peerStatsWeight = 0.3
+	// a slice is stolen by an idle peer when it would finish the slice this many times faster
+	stealSpeedup = 2
+)
+
+var (
+	// DownloadSchedulerMap the slices being downloaded for every file  make(map[string]*DownloadScheduler)
+	DownloadSchedulerMap   = utils.NewAutoCleanMap(1 * time.Hour)
+	downloadSchedulerMutex sync.Mutex
+)
+
+var downloadPeerStats = struct {
+	peers map[string]*PeerStat
+	mutex sync.Mutex
+}{peers: make(map[string]*PeerStat)}
+
+// PeerStat what was measured while downloading slices from a resource node
+type PeerStat struct {
+	P2PAddress string
+	Throughput float64 // bytes per millisecond, moving average
+	Latency    float64 // milliseconds until the first piece of a slice is received, moving average
+	InFlight   int     // slices being downloaded from the node
+	Completed  uint64
+	Failed     uint64
+}
+
+// score the higher the sooner a new slice is expected to be received from the node. Nodes never measured come
+// first, so that every node gets a chance
+func (p *PeerStat) score() float64 {
+	if p.Completed == 0 {
+		return math.MaxFloat64 / float64(p.InFlight+1)
+	}
+	return p.Throughput / float64(p.InFlight+1)
+}
+
+// GetDownloadPeerStats returns the stats of the resource nodes slices were downloaded from, fastest first
+func GetDownloadPeerStats() []PeerStat {
+	downloadPeerStats.mutex.Lock()
+	defer downloadPeerStats.mutex.Unlock()
+	stats := make([]PeerStat, 0, len(downloadPeerStats.peers))
+	for _, p := range downloadPeerStats.peers {
+		stats = append(stats, *p)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Throughput > stats[j].Throughput
+	})
+	return stats
+}
+
+// getPeerStat must be called with downloadPeerStats.mutex held
+func getPeerStat(p2pAddress string) *PeerStat {
+	p, ok := downloadPeerStats.peers[p2pAddress]
+	if !ok {
+		p = &PeerStat{P2PAddress: p2pAddress}
+		downloadPeerStats.peers[p2pAddress] = p
+	}
+	return p
+}
+
+func movingAverage(average, value float64, count uint64) float64 {
+	if count == 0 {
+		return value
+	}
+	return average*(1-peerStatsWeight) + value*peerStatsWeight
+}
+
+// SliceAssignment a slice to request from a resource node
+type SliceAssignment struct {
+	FileInfo *protos.RspFileStorageInfo
+	Slice    *protos.DownloadSliceInfo
+	Peer     *protos.PPBaseInfo
+	// Stolen the slice was being downloaded from a slower node, the data already received from it must be dropped
+	Stolen bool
+	// PreviousPeer the node the stolen slice was requested from, which is told to stop sending it
+	PreviousPeer *protos.PPBaseInfo
+}
+
+type scheduledSlice struct {
+	fInfo *protos.RspFileStorageInfo
+	slice *protos.DownloadSliceInfo
+	peers []*protos.PPBaseInfo // the storage PP followed by the backup PP
+	tried map[string]bool
+
+	peer         *protos.PPBaseInfo // nil while waiting for a peer
+	start        time.Time
+	lastReceived time.Time
+	received     uint64
+}
+
+func (s *scheduledSlice) size() uint64 {
+	return s.slice.SliceOffset.SliceOffsetEnd - s.slice.SliceOffset.SliceOffsetStart
+}
+
+// DownloadScheduler spreads the slices of a file over the resource nodes storing them. Every node gets at most
+// setting.MaxDownloadSlicesPerPeer slices at the same time, the fastest ones first, and a slice downloaded slowly is
+// moved to an idle node which is expected to finish it sooner
+type DownloadScheduler struct {
+	FileHash  string
+	FileReqId string
+	slices    map[string]*scheduledSlice
+	pending   []string        // slices waiting for a peer
+	peers     map[string]bool // the nodes slices were requested from
+	job       clock.Job       // the job checking the slow slices
+	mutex     sync.Mutex
+}
+
+func AddDownloadScheduler(fileHash, fileReqId string) *DownloadScheduler {
+	downloadSchedulerMutex.Lock()
+	defer downloadSchedulerMutex.Unlock()
+	if s, ok := GetDownloadScheduler(fileHash, fileReqId); ok {
+		return s
+	}
+	s := &DownloadScheduler{
+		FileHash:  fileHash,
+		FileReqId: fileReqId,
+		slices:    make(map[string]*scheduledSlice),
+		peers:     make(map[string]bool),
+	}
+	DownloadSchedulerMap.Store(fileHash+fileReqId, s)
+	return s
+}
+
+func GetDownloadScheduler(fileHash, fileReqId string) (*DownloadScheduler, bool) {
+	s, ok := DownloadSchedulerMap.Load(fileHash + fileReqId)
+	if !ok {
+		return nil, false
+	}
+	return s.(*DownloadScheduler), true
+}
+
+// DeleteDownloadScheduler stops the job of the scheduler and frees the peers of the slices still being downloaded.
+// It returns the scheduler deleted
+func DeleteDownloadScheduler(fileHash, fileReqId string) (*DownloadScheduler, bool) {
+	downloadSchedulerMutex.Lock()
+	s, ok := GetDownloadScheduler(fileHash, fileReqId)
+	if ok {
+		DownloadSchedulerMap.Delete(fileHash + fileReqId)
+	}
+	downloadSchedulerMutex.Unlock()
+	if !ok {
+		return nil, false
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.job != nil {
+		s.job.Cancel()
+		s.job = nil
+	}
+	for _, slice := range s.slices {
+		s.release(slice)
+	}
+	s.slices = make(map[string]*scheduledSlice)
+	s.pending = nil
+	return s, true
+}
+
+// SetJob records the job checking the slow slices. It returns false when the scheduler has a job already
+func (s *DownloadScheduler) SetJob(job clock.Job) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.job != nil {
+		return false
+	}
+	s.job = job
+	return true
+}
+
+// StopJob stops the job checking the slow slices, a new one can be set afterwards
+func (s *DownloadScheduler) StopJob() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.job != nil {
+		s.job.Cancel()
+		s.job = nil
+	}
+}
+
+// Peers returns the addresses of all the nodes slices of the file were requested from
+func (s *DownloadScheduler) Peers() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	peers := make([]string, 0, len(s.peers))
+	for p2pAddress := range s.peers {
+		peers = append(peers, p2pAddress)
+	}
+	return peers
+}
+
+// AddSlices queues slices to download. A slice queued already is replaced, with the locations given by fInfo
+func (s *DownloadScheduler) AddSlices(fInfo *protos.RspFileStorageInfo, slices []*protos.DownloadSliceInfo) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, slice := range slices {
+		sliceHash := slice.SliceStorageInfo.SliceHash
+		if existing, ok := s.slices[sliceHash]; ok {
+			s.release(existing)
+			s.removePending(sliceHash)
+		}
+		entry := &scheduledSlice{
+			fInfo: fInfo,
+			slice: slice,
+			tried: make(map[string]bool),
+		}
+		for _, peer := range []*protos.PPBaseInfo{slice.StoragePpInfo, slice.BackupsPpInfo} {
+			if peer == nil || peer.P2PAddress == "" || peer.NetworkAddress == "" {
+				continue
+			}
+			duplicate := false
+			for _, p := range entry.peers {
+				duplicate = duplicate || p.P2PAddress == peer.P2PAddress
+			}
+			if !duplicate {
+				entry.peers = append(entry.peers, peer)
+			}
+		}
+		s.slices[sliceHash] = entry
+		s.pending = append(s.pending, sliceHash)
+	}
+}
+
+// Next assigns the waiting slices to the nodes having a free download slot
+func (s *DownloadScheduler) Next() []SliceAssignment {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	downloadPeerStats.mutex.Lock()
+	defer downloadPeerStats.mutex.Unlock()
+
+	var assignments []SliceAssignment
+	var waiting []string
+	for _, sliceHash := range s.pending {
+		slice, ok := s.slices[sliceHash]
+		if !ok {
+			continue
+		}
+		peer := s.bestPeer(slice)
+		if peer == nil {
+			waiting = append(waiting, sliceHash)
+			continue
+		}
+		s.assign(slice, peer)
+		assignments = append(assignments, SliceAssignment{FileInfo: slice.fInfo, Slice: slice.slice, Peer: peer})
+	}
+	s.pending = waiting
+	return assignments
+}
+
+// Received records a piece of a slice received from a node. It returns false when the piece comes from a node the
+// slice isn't assigned to anymore, and must be dropped
+func (s *DownloadScheduler) Received(sliceHash, p2pAddress string, size uint64) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	slice, ok := s.slices[sliceHash]
+	if !ok || slice.peer == nil {
+		return true
+	}
+	if p2pAddress != "" && p2pAddress != slice.peer.P2PAddress {
+		return false
+	}
+
+	now := time.Now()
+	if slice.received == 0 {
+		downloadPeerStats.mutex.Lock()
+		p := getPeerStat(slice.peer.P2PAddress)
+		p.Latency = movingAverage(p.Latency, float64(now.Sub(slice.start).Milliseconds()), p.Completed)
+		downloadPeerStats.mutex.Unlock()
+	}
+	slice.received += size
+	slice.lastReceived = now
+	return true
+}
+
+// Done records a slice fully received and frees its download slot
+func (s *DownloadScheduler) Done(sliceHash string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	slice, ok := s.slices[sliceHash]
+	if !ok {
+		return
+	}
+	delete(s.slices, sliceHash)
+	s.removePending(sliceHash)
+	if slice.peer == nil {
+		return
+	}
+
+	downloadPeerStats.mutex.Lock()
+	defer downloadPeerStats.mutex.Unlock()
+	p := getPeerStat(slice.peer.P2PAddress)
+	elapsed := math.Max(float64(time.Since(slice.start).Milliseconds()), 1)
+	p.Throughput = movingAverage(p.Throughput, float64(slice.size())/elapsed, p.Completed)
+	p.Completed++
+	RecordPeerSuccess(slice.peer.P2PAddress, time.Since(slice.start))
+	if p.InFlight > 0 {
+		p.InFlight--
+	}
+	slice.peer = nil
+}
+
+// Failed records a slice the node couldn't send. It returns false when no other node can provide the slice
+func (s *DownloadScheduler) Failed(sliceHash string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	slice, ok := s.slices[sliceHash]
+	if !ok {
+		return false
+	}
+	if slice.peer != nil {
+		downloadPeerStats.mutex.Lock()
+		getPeerStat(slice.peer.P2PAddress).Failed++
+		downloadPeerStats.mutex.Unlock()
+		RecordPeerFailure(slice.peer.P2PAddress)
+		s.release(slice)
+	}
+
+	for _, peer := range slice.peers {
+		if !slice.tried[peer.P2PAddress] {
+			s.removePending(sliceHash)
+			s.pending = append([]string{sliceHash}, s.pending...)
+			return true
+		}
+	}
+	delete(s.slices, sliceHash)
+	s.removePending(sliceHash)
+	return false
+}
+
+// Steal moves the slices stalled, or downloaded much slower than an idle node would do it, to another node
+func (s *DownloadScheduler) Steal() []SliceAssignment {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	downloadPeerStats.mutex.Lock()
+	defer downloadPeerStats.mutex.Unlock()
+
+	now := time.Now()
+	var assignments []SliceAssignment
+	for _, slice := range s.slices {
+		if slice.peer == nil {
+			continue
+		}
+		candidate := s.bestPeer(slice)
+		if candidate == nil {
+			continue
+		}
+
+		lastReceived := slice.lastReceived
+		if lastReceived.IsZero() {
+			lastReceived = slice.start
+		}
+		steal := now.Sub(lastReceived) > DownloadSliceStallTimeout
+		if !steal && len(s.pending) == 0 && slice.received > 0 && slice.received < slice.size() {
+			c := getPeerStat(candidate.P2PAddress)
+			elapsed := math.Max(float64(now.Sub(slice.start).Milliseconds()), 1)
+			remaining := float64(slice.size()-slice.received) / (float64(slice.received) / elapsed)
+			steal = c.InFlight == 0 && c.Completed > 0 && remaining > stealSpeedup*float64(slice.size())/c.Throughput
+		}
+		if !steal {
+			continue
+		}
+
+		utils.DebugLogf("slice %v is moved from %v to %v", slice.slice.SliceStorageInfo.SliceHash,
+			slice.peer.P2PAddress, candidate.P2PAddress)
+		if slice.received > 0 {
+			// the node was slow, not failing, it only gets a lower throughput
+			p := getPeerStat(slice.peer.P2PAddress)
+			elapsed := math.Max(float64(now.Sub(slice.start).Milliseconds()), 1)
+			p.Throughput = movingAverage(p.Throughput, float64(slice.received)/elapsed, p.Completed)
+		} else {
+			getPeerStat(slice.peer.P2PAddress).Failed++
+			RecordPeerFailure(slice.peer.P2PAddress)
+		}
+		previous := slice.peer
+		s.releaseLocked(slice)
+		s.assign(slice, candidate)
+		assignments = append(assignments, SliceAssignment{FileInfo: slice.fInfo, Slice: slice.slice, Peer: candidate, Stolen: true,
+			PreviousPeer: previous})
+	}
+	return assignments
+}
+
+// Finished tells whether all the slices are received or given up
+func (s *DownloadScheduler) Finished() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.slices) == 0
+}
+
+// bestPeer must be called with both mutexes held. It returns nil when no untried node has a free slot. A blacklisted
+// node is only picked when it is the last one able to provide the slice
+func (s *DownloadScheduler) bestPeer(slice *scheduledSlice) *protos.PPBaseInfo {
+	var best, blacklisted *protos.PPBaseInfo
+	bestScore, blacklistedScore := -1.0, -1.0
+	trusted := false
+	for _, peer := range slice.peers {
+		if slice.tried[peer.P2PAddress] {
+			continue
+		}
+		isBlacklisted := IsPeerBlacklisted(peer.P2PAddress)
+		trusted = trusted || !isBlacklisted
+		p := getPeerStat(peer.P2PAddress)
+		if p.InFlight >= setting.MaxDownloadSlicesPerPeer {
+			continue
+		}
+		if score := p.score(); isBlacklisted && score > blacklistedScore {
+			blacklisted, blacklistedScore = peer, score
+		} else if !isBlacklisted && score > bestScore {
+			best, bestScore = peer, score
+		}
+	}
+	if trusted {
+		return best
+	}
+	return blacklisted
+}
+
+// assign must be called with both mutexes held
+func (s *DownloadScheduler) assign(slice *scheduledSlice, peer *protos.PPBaseInfo) {
+	getPeerStat(peer.P2PAddress).InFlight++
+	s.peers[peer.P2PAddress] = true
+	slice.peer = peer
+	slice.tried[peer.P2PAddress] = true
+	slice.start = time.Now()
+	slice.lastReceived = time.Time{}
+	slice.received = 0
+}
+
+// release must be called with s.mutex held
+func (s *DownloadScheduler) release(slice *scheduledSlice) {
+	if slice.peer == nil {
+		return
+	}
+	downloadPeerStats.mutex.Lock()
+	defer downloadPeerStats.mutex.Unlock()
+	s.releaseLocked(slice)
+}
+
+// releaseLocked must be called with both mutexes held
+func (s *DownloadScheduler) releaseLocked(slice *scheduledSlice) {
+	if slice.peer == nil {
+		return
+	}
+	if p := getPeerStat(slice.peer.P2PAddress); p.InFlight > 0 {
+		p.InFlight--
+	}
+	slice.peer = nil
+}
+
+func (s *DownloadScheduler) removePending(sliceHash string) {
+	for i, hash := range s.pending {
+		if hash == sliceHash {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package task
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func resetDownloadPeerStats() {
+	downloadPeerStats.mutex.Lock()
+	defer downloadPeerStats.mutex.Unlock()
+	downloadPeerStats.peers = make(map[string]*PeerStat)
+}
+
+func schedulerSlice(hash string, storage, backup string) *protos.DownloadSliceInfo {
+	slice := &protos.DownloadSliceInfo{
+		SliceStorageInfo: &protos.SliceStorageInfo{SliceHash: hash},
+		SliceOffset:      &protos.SliceOffset{SliceOffsetEnd: 100},
+		StoragePpInfo:    &protos.PPBaseInfo{P2PAddress: storage, NetworkAddress: storage + ":1"},
+	}
+	if backup != "" {
+		slice.BackupsPpInfo = &protos.PPBaseInfo{P2PAddress: backup, NetworkAddress: backup + ":1"}
+	}
+	return slice
+}
+
+func TestDownloadSchedulerAssignment(t *testing.T) {
+	resetDownloadPeerStats()
+	defer resetDownloadPeerStats()
+	s := AddDownloadScheduler("file", "req")
+	defer DeleteDownloadScheduler("file", "req")
+
+	var slices []*protos.DownloadSliceInfo
+	for i := 0; i < setting.MaxDownloadSlicesPerPeer+2; i++ {
+		slices = append(slices, schedulerSlice(fmt.Sprint(i), "pp1", ""))
+	}
+	s.AddSlices(nil, slices)
+
+	// a node only gets MaxDownloadSlicesPerPeer slices at the same time
+	if assignments := s.Next(); len(assignments) != setting.MaxDownloadSlicesPerPeer {
+		t.Fatalf("%v slices assigned", len(assignments))
+	}
+	if assignments := s.Next(); len(assignments) != 0 {
+		t.Fatal("no slot should be free")
+	}
+	s.Done("0")
+	if assignments := s.Next(); len(assignments) != 1 || assignments[0].Slice.SliceStorageInfo.SliceHash != fmt.Sprint(setting.MaxDownloadSlicesPerPeer) {
+		t.Fatal("the freed slot should be given to the next slice")
+	}
+	if s.Finished() {
+		t.Fatal("the download isn't finished")
+	}
+}
+
+func TestDownloadSchedulerBackupFallback(t *testing.T) {
+	resetDownloadPeerStats()
+	defer resetDownloadPeerStats()
+	defer ClearPeerReputation("")
+	s := AddDownloadScheduler("file", "req")
+	defer DeleteDownloadScheduler("file", "req")
+
+	s.AddSlices(nil, []*protos.DownloadSliceInfo{schedulerSlice("a", "storage", "backup")})
+	assignments := s.Next()
+	if len(assignments) != 1 || assignments[0].Peer.P2PAddress != "storage" {
+		t.Fatal("the slice should be requested from the storage PP first")
+	}
+
+	// the backup PP is tried once the storage PP fails
+	if !s.Failed("a") {
+		t.Fatal("the backup PP should be tried")
+	}
+	assignments = s.Next()
+	if len(assignments) != 1 || assignments[0].Peer.P2PAddress != "backup" {
+		t.Fatal("the slice should be requested from the backup PP")
+	}
+	if s.Failed("a") || !s.Finished() {
+		t.Fatal("the slice should be given up once every node failed")
+	}
+}
+
+func TestDownloadSchedulerSteal(t *testing.T) {
+	resetDownloadPeerStats()
+	defer resetDownloadPeerStats()
+	defer ClearPeerReputation("")
+	s := AddDownloadScheduler("file", "req")
+	defer DeleteDownloadScheduler("file", "req")
+
+	s.AddSlices(nil, []*protos.DownloadSliceInfo{schedulerSlice("a", "slow", "fast")})
+	if assignments := s.Next(); len(assignments) != 1 || assignments[0].Peer.P2PAddress != "slow" {
+		t.Fatal("wrong first assignment")
+	}
+	if len(s.Steal()) != 0 {
+		t.Fatal("a slice just requested shouldn't be stolen")
+	}
+
+	// the slice stalls, it is moved to the other node and the first node is told to stop
+	s.mutex.Lock()
+	s.slices["a"].start = time.Now().Add(-2 * DownloadSliceStallTimeout)
+	s.mutex.Unlock()
+	assignments := s.Steal()
+	if len(assignments) != 1 || !assignments[0].Stolen || assignments[0].Peer.P2PAddress != "fast" ||
+		assignments[0].PreviousPeer == nil || assignments[0].PreviousPeer.P2PAddress != "slow" {
+		t.Fatalf("wrong steal %+v", assignments)
+	}
+	if s.Received("a", "slow", 10) || !s.Received("a", "fast", 10) {
+		t.Fatal("only the data of the new node should be kept")
+	}
+	s.Done("a")
+	if !s.Finished() {
+		t.Fatal("the download should be finished")
+	}
+}
@@ -12,7 +12,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"google.golang.org/protobuf/proto"
+	"github.com/pkg/errors"
 
 	"github.com/stratosnet/sds/framework/crypto"
 	"github.com/stratosnet/sds/framework/utils"
@@ -105,6 +105,25 @@ func (task *DownloadTask) SetSliceSuccess(sliceHash string) {
 	task.SuccessSlice[sliceHash] = true
 }
 
+func (task *DownloadTask) IsSliceSuccess(sliceHash string) bool {
+	task.taskMutex.Lock()
+	defer task.taskMutex.Unlock()
+	return task.SuccessSlice[sliceHash]
+}
+
+// SkipSlice removes a slice which is not downloaded with the others, e.g. the parity slices of an erasure coded file
+func (task *DownloadTask) SkipSlice(sliceHash string) {
+	task.taskMutex.Lock()
+	defer task.taskMutex.Unlock()
+
+	if _, ok := task.sliceInfo[sliceHash]; !ok {
+		return
+	}
+	delete(task.sliceInfo, sliceHash)
+	delete(task.FailedSlice, sliceHash)
+	task.SliceCount--
+}
+
 func (task *DownloadTask) AddFailedSlice(sliceHash string) {
 	task.taskMutex.Lock()
 	defer task.taskMutex.Unlock()
@@ -232,7 +251,14 @@ func CleanDownloadFileAndConnMap(ctx context.Context, fileHash, fileReqId string
 			p2pserver.GetP2pServer(ctx).DeleteConnFromCache("download#" + fileHash + slice.StoragePpInfo.P2PAddress + fileReqId)
 		}
 	}
+	if s, ok := DeleteDownloadScheduler(fileHash, fileReqId); ok {
+		// the connections to the backup PPs
+		for _, p2pAddress := range s.Peers() {
+			p2pserver.GetP2pServer(ctx).DeleteConnFromCache("download#" + fileHash + p2pAddress + fileReqId)
+		}
+	}
 	DownloadFileMap.Delete(fileHash + fileReqId)
+	DeleteErasureDownload(fileHash, fileReqId)
 }
 
 func CancelDownloadTask(fileHash string) {
@@ -247,15 +273,15 @@ func GetDownloadSlice(target *protos.ReqDownloadSlice, slice *protos.DownloadSli
 	}
 	rawSize := uint64(size)
 
-	if target.RspFileStorageInfo.EncryptionTag != "" {
-		encryptedSlice := protos.EncryptedSlice{}
+	if file.HasSliceEnvelope(target.RspFileStorageInfo.EncryptionTag, target.RspFileStorageInfo.Compression) {
 		var data = []byte{}
 
 		for _, buffer := range buffers {
 			data = append(data, buffer...)
 		}
-		err = proto.Unmarshal(data, &encryptedSlice)
-		if err == nil {
+		// a compressed slice is sent with its stored size, the downloader finds its raw size when opening the envelope
+		encryptedSlice, compression, encrypted, err := file.UnmarshalSliceEnvelope(data)
+		if err == nil && compression == "" && encrypted {
 			rawSize = encryptedSlice.RawSize
 		}
 	}
@@ -271,6 +297,9 @@ func GetDownloadSlice(target *protos.ReqDownloadSlice, slice *protos.DownloadSli
 func SaveDownloadFile(ctx context.Context, target *protos.RspDownloadSlice, fInfo *protos.RspFileStorageInfo) error {
 	metrics.DownloadPerformanceLogNow(target.FileHash + ":RCV_SLICE_DATA:" + strconv.FormatInt(int64(target.SliceInfo.SliceOffset.SliceOffsetStart+(target.SliceNumber-1)*33554432), 10) + ":")
 	defer metrics.DownloadPerformanceLogNow(target.FileHash + ":RCV_SAVE_DATA:" + strconv.FormatInt(int64(target.SliceInfo.SliceOffset.SliceOffsetStart+(target.SliceNumber-1)*33554432), 10) + ":")
+	if e, ok := GetErasureDownload(target.FileHash, fInfo.ReqId); ok && file.IsFileRpcRemote(target.FileHash+fInfo.ReqId) {
+		e.KeepDataPiece(target.SliceInfo.SliceHash, target.SliceInfo.SliceOffset.SliceOffsetStart, target.Data)
+	}
 	return file.SaveDownloadedFileData(target.Data, int64(target.SliceInfo.SliceOffset.SliceOffsetStart), target.SliceInfo.SliceHash, fInfo.FileName, target.FileHash, fInfo.SavePath, fInfo.ReqId)
 }
 
@@ -284,10 +313,15 @@ func DownloadResult(ctx context.Context, filehash string, success bool, reason s
 		pp.Log(ctx, "* has failed, ", reason)
 		pp.Log(ctx, "*")
 		pp.Log(ctx, "* Another task to the same file could be started by ")
-		pp.Log(ctx, "* 'get' or 'getsharefile' command. New task will resume")
-		pp.Log(ctx, "* downloading from slices already downloaded.")
+		pp.Log(ctx, "* 'get', 'getsharefile' or 'resumeget' command. New task will")
+		pp.Log(ctx, "* resume downloading from slices already downloaded.")
 	}
 	pp.Log(ctx, "******************************************************")
+	if success {
+		SetDownloadResult(filehash, nil)
+	} else {
+		SetDownloadResult(filehash, errors.New("download failed, "+reason))
+	}
 }
 
 // DoneDownload
@@ -371,13 +405,14 @@ func CheckDownloadOver(ctx context.Context, fileHash string) (bool, float32) {
 					fName = fileHash
 				}
 
-				if file.CheckDownloadCache(fileHash) != nil {
+				if file.CheckDownloadCache(fileHash, fInfo.EncryptionTag, fInfo.Compression) != nil {
 					DownloadResult(ctx, fileHash, false, "")
 					return false, 0
 				}
 
 				DoneDownload(ctx, fileHash, fName, fInfo.SavePath)
 				CleanDownloadFileAndConnMap(ctx, fileHash, LOCAL_REQID)
+				DeleteDownloadJournal(fileHash, LOCAL_REQID)
 				DownloadResult(ctx, fileHash, true, "")
 				return true, 1.0
 			}
@@ -439,3 +474,21 @@ func addSeqNum2FileName(filePath string, seq int) string {
 
 	return addSeqNum2FileName(filePath, seq+1)
 }
+
+// downloadResults the channels waiting for the end of a local download
+var downloadResults = newTransferResults()
+
+// SubscribeDownloadResult returns a channel receiving the result of the local download of the file, nil on success.
+// Each caller waiting for the same file subscribes with its own reqId
+func SubscribeDownloadResult(fileHash, reqId string) chan error {
+	return downloadResults.subscribe(fileHash, reqId)
+}
+
+func UnsubscribeDownloadResult(fileHash, reqId string) {
+	downloadResults.unsubscribe(fileHash, reqId)
+}
+
+// SetDownloadResult notifies the subscribers, if any, that the local download of the file has ended
+func SetDownloadResult(fileHash string, err error) {
+	downloadResults.notify(fileHash, err)
+}
@@ -0,0 +1,291 @@
+package task
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+// ErasureDownloadMap erasure coded files being downloaded  make(map[string]*ErasureDownload)
+var ErasureDownloadMap = utils.NewAutoCleanMap(1 * time.Hour)
+
+// ErasureStripe the data slices encoded together and their parity slices
+type ErasureStripe struct {
+	DataSlices   []*protos.DownloadSliceInfo
+	ParitySlices []*protos.DownloadSliceInfo
+
+	failed     map[string]bool
+	dataData   map[string][]byte // data slices kept for the downloads sent to a remote client, which can't be read back
+	parityData map[string][]byte // parity slices fully received
+	partial    map[string][]byte // parity slices being received
+	received   map[string]uint64
+	recovering bool
+	recovered  bool
+}
+
+// ErasureDownload recovers the lost data slices of an erasure coded file from the parity slices of their stripe.
+// The parity slices are only downloaded once a data slice of their stripe is lost
+type ErasureDownload struct {
+	FileHash  string
+	FileReqId string
+	stripes   map[string]*ErasureStripe // slice hash -> stripe
+	mutex     sync.Mutex
+}
+
+// IsParitySlice tells the parity slices of an erasure coded file apart from its data slices, from the erasure layout
+// of the file. Without a layout, from the SPs which don't keep it, the parity slices are those placed after the end of
+// the file
+func IsParitySlice(target *protos.RspFileStorageInfo, slice *protos.DownloadSliceInfo) bool {
+	position, ok := erasureStripeOf(target, slice)
+	if !ok {
+		return target.FileSize > 0 && slice.SliceOffset.SliceOffsetStart >= target.FileSize
+	}
+	return position.parity
+}
+
+type erasureStripePosition struct {
+	index  uint64 // the stripe of the slice
+	parity bool
+}
+
+// erasureStripeOf finds the stripe of the slice from the erasure layout. The stripes are made of consecutive slice
+// numbers, the last one may hold less data slices
+func erasureStripeOf(target *protos.RspFileStorageInfo, slice *protos.DownloadSliceInfo) (erasureStripePosition, bool) {
+	layout := target.GetErasureLayout()
+	if layout == nil || layout.DataSlices == 0 || layout.ParitySlices == 0 || slice.SliceNumber == 0 {
+		return erasureStripePosition{}, false
+	}
+	stripeSize := uint64(layout.DataSlices + layout.ParitySlices)
+	sliceCount := uint64(len(target.SliceInfo))
+	position := erasureStripePosition{index: (slice.SliceNumber - 1) / stripeSize}
+	stripeStart := position.index * stripeSize
+	dataSlices := uint64(layout.DataSlices)
+	if stripeStart+stripeSize > sliceCount {
+		if sliceCount < stripeStart+uint64(layout.ParitySlices) {
+			return erasureStripePosition{}, false
+		}
+		dataSlices = sliceCount - stripeStart - uint64(layout.ParitySlices)
+	}
+	position.parity = slice.SliceNumber-1-stripeStart >= dataSlices
+	return position, true
+}
+
+// AddErasureDownload groups the slices of the file into stripes. Nothing is added for a file without parity slices
+func AddErasureDownload(target *protos.RspFileStorageInfo, fileReqId string) (*ErasureDownload, bool) {
+	slices := make([]*protos.DownloadSliceInfo, len(target.SliceInfo))
+	copy(slices, target.SliceInfo)
+	sort.Slice(slices, func(i, j int) bool {
+		return slices[i].SliceNumber < slices[j].SliceNumber
+	})
+
+	// every stripe is made of data slices followed by parity slices
+	e := &ErasureDownload{
+		FileHash:  target.FileHash,
+		FileReqId: fileReqId,
+		stripes:   make(map[string]*ErasureStripe),
+	}
+	var stripe *ErasureStripe
+	stripeIndex := uint64(0)
+	hasParity := false
+	for _, slice := range slices {
+		isParity := IsParitySlice(target, slice)
+		newStripe := stripe == nil || (!isParity && len(stripe.ParitySlices) > 0)
+		if position, ok := erasureStripeOf(target, slice); ok {
+			newStripe = stripe == nil || position.index != stripeIndex
+			stripeIndex = position.index
+		}
+		if newStripe {
+			stripe = &ErasureStripe{
+				failed:     make(map[string]bool),
+				dataData:   make(map[string][]byte),
+				parityData: make(map[string][]byte),
+				partial:    make(map[string][]byte),
+				received:   make(map[string]uint64),
+			}
+		}
+		if isParity {
+			stripe.ParitySlices = append(stripe.ParitySlices, slice)
+			hasParity = true
+		} else {
+			stripe.DataSlices = append(stripe.DataSlices, slice)
+		}
+		e.stripes[slice.SliceStorageInfo.SliceHash] = stripe
+	}
+	if !hasParity {
+		return nil, false
+	}
+	ErasureDownloadMap.Store(target.FileHash+fileReqId, e)
+	return e, true
+}
+
+func GetErasureDownload(fileHash, fileReqId string) (*ErasureDownload, bool) {
+	e, ok := ErasureDownloadMap.Load(fileHash + fileReqId)
+	if !ok {
+		return nil, false
+	}
+	return e.(*ErasureDownload), true
+}
+
+func (e *ErasureDownload) IsParity(sliceHash string) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	stripe, ok := e.stripes[sliceHash]
+	if !ok {
+		return false
+	}
+	for _, slice := range stripe.ParitySlices {
+		if slice.SliceStorageInfo.SliceHash == sliceHash {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSliceFailed records a lost slice. It returns whether its stripe can still be recovered, and the parity slices to
+// download when the recovery of the stripe starts
+func (e *ErasureDownload) SetSliceFailed(sliceHash string) (recoverable bool, toDownload []*protos.DownloadSliceInfo) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	stripe, ok := e.stripes[sliceHash]
+	if !ok {
+		return false, nil
+	}
+	stripe.failed[sliceHash] = true
+	if len(stripe.failed) > len(stripe.ParitySlices) {
+		return false, nil
+	}
+	if !stripe.recovering {
+		stripe.recovering = true
+		toDownload = stripe.ParitySlices
+	}
+	return true, toDownload
+}
+
+// AddParityPiece stores a piece of a parity slice. The whole slice is returned once all its pieces are received
+func (e *ErasureDownload) AddParityPiece(sliceHash string, sliceSize, offset uint64, data []byte) ([]byte, bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	stripe, ok := e.stripes[sliceHash]
+	if !ok || offset+uint64(len(data)) > sliceSize {
+		return nil, false
+	}
+	if _, ok = stripe.parityData[sliceHash]; ok {
+		return nil, false
+	}
+	buffer, ok := stripe.partial[sliceHash]
+	if !ok {
+		buffer = make([]byte, sliceSize)
+		stripe.partial[sliceHash] = buffer
+	}
+	copy(buffer[offset:], data)
+	stripe.received[sliceHash] += uint64(len(data))
+	if stripe.received[sliceHash] < sliceSize {
+		return nil, false
+	}
+	delete(stripe.partial, sliceHash)
+	delete(stripe.received, sliceHash)
+	return buffer, true
+}
+
+// KeepDataPiece keeps a piece of a data slice in memory, for a download whose file is sent to a remote client and
+// can't be read back to decode the stripe. offset is the position of the piece in the file
+func (e *ErasureDownload) KeepDataPiece(sliceHash string, offset uint64, data []byte) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	stripe, ok := e.stripes[sliceHash]
+	if !ok || stripe.recovered {
+		return
+	}
+	for _, slice := range stripe.DataSlices {
+		if slice.SliceStorageInfo.SliceHash != sliceHash {
+			continue
+		}
+		start, end := slice.SliceOffset.SliceOffsetStart, slice.SliceOffset.SliceOffsetEnd
+		if offset < start || offset+uint64(len(data)) > end {
+			return
+		}
+		buffer, ok := stripe.dataData[sliceHash]
+		if !ok {
+			buffer = make([]byte, end-start)
+			stripe.dataData[sliceHash] = buffer
+		}
+		copy(buffer[offset-start:], data)
+		return
+	}
+}
+
+// GetDataSlice returns a data slice kept in memory by KeepDataPiece
+func (e *ErasureDownload) GetDataSlice(sliceHash string) ([]byte, bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	stripe, ok := e.stripes[sliceHash]
+	if !ok {
+		return nil, false
+	}
+	data, ok := stripe.dataData[sliceHash]
+	return data, ok
+}
+
+// ReleaseStripeData drops the data slices of the stripe kept in memory, once all of them are downloaded or recovered.
+// succeeded tells whether a data slice has been downloaded
+func (e *ErasureDownload) ReleaseStripeData(sliceHash string, succeeded func(sliceHash string) bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	stripe, ok := e.stripes[sliceHash]
+	if !ok || len(stripe.dataData) == 0 {
+		return
+	}
+	if !stripe.recovered {
+		for _, slice := range stripe.DataSlices {
+			if !succeeded(slice.SliceStorageInfo.SliceHash) {
+				return
+			}
+		}
+	}
+	stripe.dataData = make(map[string][]byte)
+}
+
+// SetParityData stores a received parity slice, after its decryption if the file is encrypted
+func (e *ErasureDownload) SetParityData(sliceHash string, data []byte) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if stripe, ok := e.stripes[sliceHash]; ok {
+		stripe.parityData[sliceHash] = data
+	}
+}
+
+// GetStripeToRecover returns the stripe of the slice when it is being recovered and enough of its slices are available,
+// together with the parity slices received. succeeded tells whether a data slice has been downloaded. The stripe is
+// only returned once
+func (e *ErasureDownload) GetStripeToRecover(sliceHash string, succeeded func(sliceHash string) bool) (*ErasureStripe, [][]byte, bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	stripe, ok := e.stripes[sliceHash]
+	if !ok || !stripe.recovering || stripe.recovered {
+		return nil, nil, false
+	}
+
+	available := len(stripe.parityData)
+	for _, slice := range stripe.DataSlices {
+		if succeeded(slice.SliceStorageInfo.SliceHash) {
+			available++
+		}
+	}
+	if available < len(stripe.DataSlices) {
+		return nil, nil, false
+	}
+
+	parity := make([][]byte, len(stripe.ParitySlices))
+	for i, slice := range stripe.ParitySlices {
+		parity[i] = stripe.parityData[slice.SliceStorageInfo.SliceHash]
+	}
+	stripe.recovered = true
+	return stripe, parity, true
+}
+
+func DeleteErasureDownload(fileHash, fileReqId string) {
+	ErasureDownloadMap.Delete(fileHash + fileReqId)
+}
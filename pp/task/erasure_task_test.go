@@ -0,0 +1,64 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestErasureStripes(t *testing.T) {
+	// 5 data slices in stripes of 3+2: the second stripe holds 2 data slices
+	target := &protos.RspFileStorageInfo{FileHash: "file1", FileSize: 500, ErasureLayout: &protos.ErasureLayout{DataSlices: 3, ParitySlices: 2}}
+	parity := map[uint64]bool{4: true, 5: true, 8: true, 9: true}
+	for number := uint64(1); number <= 9; number++ {
+		target.SliceInfo = append(target.SliceInfo, &protos.DownloadSliceInfo{
+			SliceStorageInfo: &protos.SliceStorageInfo{SliceHash: string(rune('a' + number))},
+			SliceNumber:      number,
+			// a data slice placed after the end of the file is still told apart by the layout
+			SliceOffset: &protos.SliceOffset{SliceOffsetStart: 100 * number, SliceOffsetEnd: 100 * (number + 1)},
+		})
+	}
+	for _, slice := range target.SliceInfo {
+		if IsParitySlice(target, slice) != parity[slice.SliceNumber] {
+			t.Fatalf("wrong kind for slice %v", slice.SliceNumber)
+		}
+	}
+
+	e, ok := AddErasureDownload(target, "req1")
+	if !ok {
+		t.Fatal("the file should be erasure coded")
+	}
+	defer DeleteErasureDownload("file1", "req1")
+	first, second := e.stripes[target.SliceInfo[0].SliceStorageInfo.SliceHash], e.stripes[target.SliceInfo[5].SliceStorageInfo.SliceHash]
+	if first == second || len(first.DataSlices) != 3 || len(first.ParitySlices) != 2 || len(second.DataSlices) != 2 ||
+		len(second.ParitySlices) != 2 {
+		t.Fatal("wrong stripes")
+	}
+
+	// the data slices of a download sent to a remote client are kept until their stripe is complete
+	hash := target.SliceInfo[0].SliceStorageInfo.SliceHash
+	e.KeepDataPiece(hash, 150, []byte{2})
+	e.KeepDataPiece(hash, 100, []byte{1})
+	if data, ok := e.GetDataSlice(hash); !ok || len(data) != 100 || data[0] != 1 || data[50] != 2 {
+		t.Fatal("the data slice should be kept")
+	}
+	succeeded := map[string]bool{hash: true}
+	isSuccess := func(sliceHash string) bool { return succeeded[sliceHash] }
+	e.ReleaseStripeData(hash, isSuccess)
+	if _, ok := e.GetDataSlice(hash); !ok {
+		t.Fatal("the data slice should be kept until the stripe is complete")
+	}
+	for _, slice := range first.DataSlices {
+		succeeded[slice.SliceStorageInfo.SliceHash] = true
+	}
+	e.ReleaseStripeData(hash, isSuccess)
+	if _, ok := e.GetDataSlice(hash); ok {
+		t.Fatal("the data slices of a complete stripe should be released")
+	}
+
+	// without a layout the parity slices are those after the end of the file
+	target.ErasureLayout = nil
+	if !IsParitySlice(target, target.SliceInfo[6]) || IsParitySlice(target, target.SliceInfo[3]) {
+		t.Fatal("wrong kind without a layout")
+	}
+}
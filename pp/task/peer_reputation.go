@@ -0,0 +1,162 @@
+package task
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	// ReputationHalfLife the successes and failures of a peer count half as much after this long
+	ReputationHalfLife = 30 * time.Minute
+	// BlacklistDuration how long a peer with a bad reputation is avoided
+	BlacklistDuration = 15 * time.Minute
+
+	// a peer is blacklisted once its decayed failures reach blacklistMinFailures while its score is below
+	// blacklistMaxScore, i.e. after three recent failures not outweighed by successes
+	blacklistMinFailures = 2.5
+	blacklistMaxScore    = 0.5
+	// weight of the latest transfer in the moving average of the latency
+	reputationLatencyWeight = 0.3
+)
+
+var peerReputations = struct {
+	peers map[string]*PeerReputation
+	mutex sync.Mutex
+}{peers: make(map[string]*PeerReputation)}
+
+// PeerReputation the outcome of the slice transfers with a resource node, uploads, downloads and backups alike.
+// Successes and Failures decay with ReputationHalfLife, so that a node recovering from an outage is trusted again
+type PeerReputation struct {
+	P2PAddress       string
+	Successes        float64
+	Failures         float64
+	Latency          time.Duration // moving average over the successful transfers
+	Score            float64       // between 0 and 1, 0.5 for a node never seen
+	LastUpdate       time.Time
+	BlacklistedUntil time.Time
+}
+
+// Blacklisted tells whether the node must be avoided at the given time
+func (r *PeerReputation) Blacklisted(now time.Time) bool {
+	return now.Before(r.BlacklistedUntil)
+}
+
+func (r *PeerReputation) decay(now time.Time) {
+	if !r.LastUpdate.IsZero() {
+		factor := math.Pow(0.5, float64(now.Sub(r.LastUpdate))/float64(ReputationHalfLife))
+		r.Successes *= factor
+		r.Failures *= factor
+	}
+	r.LastUpdate = now
+	r.Score = (r.Successes + 1) / (r.Successes + r.Failures + 2)
+}
+
+// getPeerReputation must be called with peerReputations.mutex held
+func getPeerReputation(p2pAddress string, now time.Time) *PeerReputation {
+	r, ok := peerReputations.peers[p2pAddress]
+	if !ok {
+		r = &PeerReputation{P2PAddress: p2pAddress}
+		peerReputations.peers[p2pAddress] = r
+	}
+	r.decay(now)
+	return r
+}
+
+// RecordPeerSuccess records a slice transferred with the node, and the time it took
+func RecordPeerSuccess(p2pAddress string, latency time.Duration) {
+	if p2pAddress == "" {
+		return
+	}
+	peerReputations.mutex.Lock()
+	defer peerReputations.mutex.Unlock()
+	r := getPeerReputation(p2pAddress, time.Now())
+	if r.Latency == 0 {
+		r.Latency = latency
+	} else {
+		r.Latency = time.Duration(float64(r.Latency)*(1-reputationLatencyWeight) + float64(latency)*reputationLatencyWeight)
+	}
+	r.Successes++
+	r.decay(r.LastUpdate)
+}
+
+// RecordPeerFailure records a slice transfer with the node which failed or timed out. The node is blacklisted for
+// BlacklistDuration when it fails too often
+func RecordPeerFailure(p2pAddress string) {
+	if p2pAddress == "" {
+		return
+	}
+	peerReputations.mutex.Lock()
+	defer peerReputations.mutex.Unlock()
+	now := time.Now()
+	r := getPeerReputation(p2pAddress, now)
+	r.Failures++
+	r.decay(now)
+	if r.Failures >= blacklistMinFailures && r.Score < blacklistMaxScore && !r.Blacklisted(now) {
+		r.BlacklistedUntil = now.Add(BlacklistDuration)
+		utils.Logf("resource node %v is blacklisted until %v, score %.2f", p2pAddress,
+			r.BlacklistedUntil.Format(time.RFC3339), r.Score)
+	}
+}
+
+// IsPeerBlacklisted tells whether slices must not be transferred with the node for now
+func IsPeerBlacklisted(p2pAddress string) bool {
+	peerReputations.mutex.Lock()
+	defer peerReputations.mutex.Unlock()
+	r, ok := peerReputations.peers[p2pAddress]
+	return ok && r.Blacklisted(time.Now())
+}
+
+// GetBlacklistedPeers returns the addresses of the nodes blacklisted for now
+func GetBlacklistedPeers() []string {
+	peerReputations.mutex.Lock()
+	defer peerReputations.mutex.Unlock()
+	now := time.Now()
+	var peers []string
+	for p2pAddress, r := range peerReputations.peers {
+		if r.Blacklisted(now) {
+			peers = append(peers, p2pAddress)
+		}
+	}
+	sort.Strings(peers)
+	return peers
+}
+
+// GetPeerReputations returns the reputation of every node slices were transferred with, worst first
+func GetPeerReputations() []PeerReputation {
+	peerReputations.mutex.Lock()
+	defer peerReputations.mutex.Unlock()
+	now := time.Now()
+	reputations := make([]PeerReputation, 0, len(peerReputations.peers))
+	for _, r := range peerReputations.peers {
+		r.decay(now)
+		reputations = append(reputations, *r)
+	}
+	sort.Slice(reputations, func(i, j int) bool {
+		if reputations[i].Score != reputations[j].Score {
+			return reputations[i].Score < reputations[j].Score
+		}
+		return reputations[i].P2PAddress < reputations[j].P2PAddress
+	})
+	return reputations
+}
+
+// ClearPeerReputation forgets the reputation of the node, lifting its blacklisting. An empty address clears every node.
+// It returns the number of nodes cleared
+func ClearPeerReputation(p2pAddress string) int {
+	peerReputations.mutex.Lock()
+	defer peerReputations.mutex.Unlock()
+	if p2pAddress == "" {
+		cleared := len(peerReputations.peers)
+		peerReputations.peers = make(map[string]*PeerReputation)
+		return cleared
+	}
+	if _, ok := peerReputations.peers[p2pAddress]; !ok {
+		return 0
+	}
+	delete(peerReputations.peers, p2pAddress)
+	return 1
+}
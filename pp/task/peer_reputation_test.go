@@ -0,0 +1,49 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestPeerBlacklisting(t *testing.T) {
+	defer ClearPeerReputation("")
+	RecordPeerSuccess("good", 20*time.Millisecond)
+	for i := 0; i < 3; i++ {
+		RecordPeerFailure("bad")
+	}
+	if IsPeerBlacklisted("good") || !IsPeerBlacklisted("bad") {
+		t.Fatalf("wrong blacklist %v", GetBlacklistedPeers())
+	}
+	reputations := GetPeerReputations()
+	if len(reputations) != 2 || reputations[0].P2PAddress != "bad" || reputations[1].Latency != 20*time.Millisecond {
+		t.Fatalf("wrong reputations %+v", reputations)
+	}
+
+	// a slice only stored by blacklisted nodes can still be downloaded
+	s := AddDownloadScheduler("file", "req")
+	defer DeleteDownloadScheduler("file", "req")
+	peer := func(p2pAddress string) *protos.PPBaseInfo {
+		return &protos.PPBaseInfo{P2PAddress: p2pAddress, NetworkAddress: p2pAddress + ":1"}
+	}
+	slice := func(hash string, storage, backup *protos.PPBaseInfo) *protos.DownloadSliceInfo {
+		return &protos.DownloadSliceInfo{
+			SliceStorageInfo: &protos.SliceStorageInfo{SliceHash: hash},
+			SliceOffset:      &protos.SliceOffset{SliceOffsetEnd: 1},
+			StoragePpInfo:    storage,
+			BackupsPpInfo:    backup,
+		}
+	}
+	s.AddSlices(nil, []*protos.DownloadSliceInfo{slice("a", peer("bad"), peer("good")), slice("b", peer("bad"), nil)})
+	for _, assignment := range s.Next() {
+		expected := map[string]string{"a": "good", "b": "bad"}[assignment.Slice.SliceStorageInfo.SliceHash]
+		if assignment.Peer.P2PAddress != expected {
+			t.Fatalf("slice %v assigned to %v", assignment.Slice.SliceStorageInfo.SliceHash, assignment.Peer.P2PAddress)
+		}
+	}
+
+	if ClearPeerReputation("bad") != 1 || IsPeerBlacklisted("bad") {
+		t.Fatal("the blacklisting was not lifted")
+	}
+}
@@ -0,0 +1,50 @@
+package task
+
+import "sync"
+
+// transferResults the channels waiting for the end of a transfer, indexed by file hash then by the reqId of the
+// subscriber, so that callers waiting for the same file don't replace each other
+type transferResults struct {
+	mutex sync.Mutex
+	chans map[string]map[string]chan error
+}
+
+func newTransferResults() *transferResults {
+	return &transferResults{chans: make(map[string]map[string]chan error)}
+}
+
+func (r *transferResults) subscribe(fileHash, reqId string) chan error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	subscribers, ok := r.chans[fileHash]
+	if !ok {
+		subscribers = make(map[string]chan error)
+		r.chans[fileHash] = subscribers
+	}
+	result := make(chan error, 1)
+	subscribers[reqId] = result
+	return result
+}
+
+func (r *transferResults) unsubscribe(fileHash, reqId string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.chans[fileHash], reqId)
+	if len(r.chans[fileHash]) == 0 {
+		delete(r.chans, fileHash)
+	}
+}
+
+// notify sends the result to every subscriber of the file, once
+func (r *transferResults) notify(fileHash string, err error) {
+	r.mutex.Lock()
+	subscribers := r.chans[fileHash]
+	delete(r.chans, fileHash)
+	r.mutex.Unlock()
+
+	for _, result := range subscribers {
+		result <- err
+	}
+}
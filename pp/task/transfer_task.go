@@ -132,13 +132,13 @@ func SaveTransferData(target *protos.RspTransferDownload) (bool, error) {
 	if err != nil {
 		return false, errors.Wrap(err, "Failed getting slice data")
 	}
-	sliceHash, err := crypto.CalcSliceHash(sliceData, tTask.FileHash, tTask.SliceNum)
-	if err != nil {
-		return false, err
-	}
-	if tTask.SliceStorageInfo.SliceHash != sliceHash {
+	sliceHash := tTask.SliceStorageInfo.SliceHash
+	if !crypto.VerifySliceHash(sliceData, tTask.FileHash, tTask.SliceNum, sliceHash) {
 		return false, errors.New("whole slice received, but slice hash doesn't match")
 	}
+	if err = file.CommitSliceData(sliceHash, tTask.FileHash, tTask.SliceNum, ""); err != nil {
+		utils.ErrorLog("failed committing the slice", err)
+	}
 	utils.DebugLogf("whole slice received, sliceHash=%v", tTask.SliceStorageInfo.SliceHash)
 	return true, nil
 
@@ -0,0 +1,356 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/metrics"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const journalSuffix = ".json"
+
+var journalMutex sync.Mutex
+
+// UploadJournal is the on-disk record of a file upload task, so that the task survives a restart of the node. The
+// journal file holds the task on its first line, followed by the status changes of its slices
+type UploadJournal struct {
+	FileHash      string                `json:"file_hash"`
+	FilePath      string                `json:"file_path"`
+	RetryCount    int                   `json:"retry_count"`
+	RspUploadFile map[int][]byte        `json:"rsp_upload_file"` // marshalled protos.RspUploadFile, indexed by retry count
+	Slices        []*UploadJournalSlice `json:"slices"`
+	UpdateTime    int64                 `json:"update_time"`
+}
+
+// UploadJournalSlice is the journaled state of one slice of an upload task
+type UploadJournalSlice struct {
+	Slice     []byte `json:"slice"` // marshalled protos.SliceHashAddr, including the destination
+	SliceHash string `json:"slice_hash,omitempty"`
+	Status    int    `json:"status"`
+}
+
+// uploadJournalUpdate is appended to the journal when a slice changes status, instead of writing the whole task again
+type uploadJournalUpdate struct {
+	SliceHash string `json:"slice_hash"`
+	Status    int    `json:"status"`
+}
+
+// UploadJournalSummary is a human-readable view of a journaled upload
+type UploadJournalSummary struct {
+	FileHash       string
+	FilePath       string
+	TotalSlices    int
+	FinishedSlices int
+	RetryCount     int
+	UpdateTime     int64
+	Ongoing        bool
+}
+
+func getUploadJournalPath(fileHash string) string {
+	return filepath.Join(file.GetUploadJournalFolderPath(), fileHash+journalSuffix)
+}
+
+// snapshot builds the journal of an upload task. The caller must hold the task mutex
+func (u *UploadFileTask) snapshot() (*UploadJournal, error) {
+	journal := &UploadJournal{
+		RetryCount:    u.retryCount,
+		RspUploadFile: make(map[int][]byte),
+		UpdateTime:    time.Now().Unix(),
+	}
+	for i, rsp := range u.rspUploadFile {
+		if rsp == nil {
+			continue
+		}
+		data, err := proto.Marshal(rsp)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed marshalling RspUploadFile")
+		}
+		journal.RspUploadFile[i] = data
+	}
+	if rsp, ok := u.rspUploadFile[0]; ok && rsp != nil {
+		journal.FileHash = rsp.FileHash
+		journal.FilePath = file.GetFilePath(rsp.FileHash)
+	}
+
+	for _, destination := range u.destinations {
+		for _, slice := range destination.slices {
+			data, err := proto.Marshal(slice.slice)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed marshalling slice")
+			}
+			journal.Slices = append(journal.Slices, &UploadJournalSlice{Slice: data, SliceHash: slice.slice.SliceHash, Status: slice.Status})
+		}
+	}
+	return journal, nil
+}
+
+// isUploadJournaled backup tasks are not journaled, nor the uploads over RPC, whose data is not in a local file
+func isUploadJournaled(u *UploadFileTask) bool {
+	return u != nil && u.GetUploadType() == protos.UploadType_NEW_UPLOAD && !file.IsFileRpcRemote(u.GetUploadFileHash())
+}
+
+// SaveUploadJournal writes the current state of a new upload task to disk
+func SaveUploadJournal(u *UploadFileTask) error {
+	if !isUploadJournaled(u) {
+		return nil
+	}
+
+	u.mutex.RLock()
+	journal, err := u.snapshot()
+	u.mutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	if journal.FileHash == "" {
+		return errors.New("upload task has no file hash")
+	}
+
+	data, err := json.Marshal(journal)
+	if err != nil {
+		return errors.Wrap(err, "failed marshalling upload journal")
+	}
+
+	journalMutex.Lock()
+	defer journalMutex.Unlock()
+	return writeJournalFile(getUploadJournalPath(journal.FileHash), append(data, '\n'))
+}
+
+// SaveUploadJournalSlice records the new status of a slice of an upload task
+func SaveUploadJournalSlice(u *UploadFileTask, sliceHash string, status int) error {
+	if !isUploadJournaled(u) {
+		return nil
+	}
+	data, err := json.Marshal(uploadJournalUpdate{SliceHash: sliceHash, Status: status})
+	if err != nil {
+		return errors.Wrap(err, "failed marshalling upload journal update")
+	}
+
+	journalMutex.Lock()
+	f, err := os.OpenFile(getUploadJournalPath(u.GetUploadFileHash()), os.O_WRONLY|os.O_APPEND, 0600)
+	if os.IsNotExist(err) {
+		journalMutex.Unlock()
+		return SaveUploadJournal(u)
+	}
+	defer journalMutex.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "failed opening upload journal")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	_, err = f.Write(append(data, '\n'))
+	return errors.Wrap(err, "failed writing upload journal")
+}
+
+// writeJournalFile writes to a temporary file first, so a crash never leaves a truncated journal behind
+func writeJournalFile(journalPath string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(journalPath), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating journal folder")
+	}
+	if err := os.WriteFile(journalPath+".tmp", data, 0600); err != nil {
+		return errors.Wrap(err, "failed writing journal")
+	}
+	return os.Rename(journalPath+".tmp", journalPath)
+}
+
+// DeleteUploadJournal removes the journal of an upload task which has ended
+func DeleteUploadJournal(fileHash string) {
+	journalMutex.Lock()
+	defer journalMutex.Unlock()
+	err := os.Remove(getUploadJournalPath(fileHash))
+	if err != nil && !os.IsNotExist(err) {
+		utils.ErrorLog("failed deleting upload journal", fileHash, err)
+	}
+}
+
+// LoadUploadJournal reads the journal of the upload of the given file
+func LoadUploadJournal(fileHash string) (*UploadJournal, error) {
+	journalMutex.Lock()
+	defer journalMutex.Unlock()
+	data, err := os.ReadFile(getUploadJournalPath(fileHash))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading upload journal")
+	}
+	lines := bytes.Split(data, []byte{'\n'})
+	journal := &UploadJournal{}
+	if err = json.Unmarshal(lines[0], journal); err != nil {
+		return nil, errors.Wrap(err, "failed parsing upload journal")
+	}
+	for _, line := range lines[1:] {
+		update := uploadJournalUpdate{}
+		if err = json.Unmarshal(line, &update); err != nil {
+			// an empty line, or a line cut by a crash
+			continue
+		}
+		journal.applyUpdate(update)
+	}
+	return journal, nil
+}
+
+// applyUpdate sets the status of the slice the same way as UploadFileTask.SetUploadSliceStatus
+func (j *UploadJournal) applyUpdate(update uploadJournalUpdate) {
+	for _, slice := range j.Slices {
+		if slice.SliceHash == update.SliceHash && slice.Status != SLICE_STATUS_REPLACED {
+			slice.Status = update.Status
+			return
+		}
+	}
+}
+
+// LoadUploadJournals reads all the upload journals found on disk
+func LoadUploadJournals() []*UploadJournal {
+	entries, err := os.ReadDir(file.GetUploadJournalFolderPath())
+	if err != nil {
+		return nil
+	}
+	var journals []*UploadJournal
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), journalSuffix) {
+			continue
+		}
+		journal, err := LoadUploadJournal(strings.TrimSuffix(entry.Name(), journalSuffix))
+		if err != nil {
+			utils.ErrorLog(err)
+			continue
+		}
+		journals = append(journals, journal)
+	}
+	return journals
+}
+
+// Summary returns a short description of the journaled upload
+func (j *UploadJournal) Summary() UploadJournalSummary {
+	summary := UploadJournalSummary{
+		FileHash:   j.FileHash,
+		FilePath:   j.FilePath,
+		RetryCount: j.RetryCount,
+		UpdateTime: j.UpdateTime,
+	}
+	for _, slice := range j.Slices {
+		if slice.Status == SLICE_STATUS_REPLACED {
+			continue
+		}
+		summary.TotalSlices++
+		if slice.Status == SLICE_STATUS_FINISHED {
+			summary.FinishedSlices++
+		}
+	}
+	_, summary.Ongoing = UploadFileTaskMap.Load(j.FileHash)
+	return summary
+}
+
+// RestoreUploadFileTask rebuilds an upload task from its journal. Slices which were not finished are marked as waiting for
+// the SP, so that the first run of the task asks the SP for fresh destinations instead of reusing expired ones
+func RestoreUploadFileTask(journal *UploadJournal, fn func(ctx context.Context, fileHash string)) (*UploadFileTask, error) {
+	if len(journal.RspUploadFile) == 0 {
+		return nil, errors.New("no RspUploadFile in the upload journal")
+	}
+	if strings.HasPrefix(journal.FilePath, "rpc:") {
+		return nil, errors.Wrap(UploadErrFatalError, "the file was uploaded over RPC, it has to be uploaded again")
+	}
+
+	task := &UploadFileTask{
+		rspUploadFile: make(map[int]*protos.RspUploadFile),
+		uploadType:    protos.UploadType_NEW_UPLOAD,
+		destinations:  make(map[string]*SlicesPerDestination),
+		retryCount:    journal.RetryCount,
+		mutex:         sync.RWMutex{},
+		state:         STATE_PAUSED,
+		lastTouch:     time.Now(),
+		helper:        fn,
+	}
+	for i, data := range journal.RspUploadFile {
+		rsp := &protos.RspUploadFile{}
+		if err := proto.Unmarshal(data, rsp); err != nil {
+			return nil, errors.Wrap(err, "failed parsing RspUploadFile in the upload journal")
+		}
+		task.rspUploadFile[i] = rsp
+	}
+	if task.rspUploadFile[0] == nil {
+		return nil, errors.New("the original RspUploadFile is missing in the upload journal")
+	}
+	// keep the latest response at the index of the current retry
+	if task.rspUploadFile[task.retryCount] == nil {
+		for i := task.retryCount - 1; i >= 0; i-- {
+			if rsp, ok := task.rspUploadFile[i]; ok {
+				task.rspUploadFile[task.retryCount] = rsp
+				break
+			}
+		}
+	}
+
+	for _, journalSlice := range journal.Slices {
+		slice := &protos.SliceHashAddr{}
+		if err := proto.Unmarshal(journalSlice.Slice, slice); err != nil {
+			return nil, errors.Wrap(err, "failed parsing slice in the upload journal")
+		}
+		if slice.PpInfo == nil {
+			return nil, errors.New("slice without destination in the upload journal")
+		}
+		if _, err := os.Stat(file.GetTmpSlicePath(journal.FileHash, slice.SliceHash)); err != nil &&
+			journalSlice.Status != SLICE_STATUS_FINISHED && journalSlice.Status != SLICE_STATUS_REPLACED {
+			return nil, errors.Errorf("tmp data of slice %v is missing, the file has to be uploaded again", slice.SliceNumber)
+		}
+
+		status := journalSlice.Status
+		if status != SLICE_STATUS_FINISHED && status != SLICE_STATUS_REPLACED {
+			status = SLICE_STATUS_WAITING_FOR_SP
+		}
+		destination, ok := task.destinations[slice.PpInfo.P2PAddress]
+		if !ok {
+			destination = &SlicesPerDestination{ppInfo: slice.PpInfo}
+			task.destinations[slice.PpInfo.P2PAddress] = destination
+		}
+		destination.slices = append(destination.slices, &SliceWithStatus{slice: slice, Status: status})
+	}
+
+	if journal.FilePath != "" {
+		file.SetFilePath(journal.FileHash, journal.FilePath)
+		fileCRC, err := crypto.CalcFileCRC32(journal.FilePath)
+		if err != nil {
+			utils.ErrorLog(err)
+		}
+		task.fileCRC = fileCRC
+	}
+
+	// a restart counts as one retry, which bounds the number of times a broken upload can be resumed
+	task.retryCount++
+	if !task.CanRetry() {
+		return nil, UploadErrMaxRetries
+	}
+	task.rspUploadFile[task.retryCount] = task.rspUploadFile[task.retryCount-1]
+
+	UploadProgressMap.Store(journal.FileHash, task.restoredProgress())
+	metrics.TaskCount.WithLabelValues("upload").Inc()
+	return task, nil
+}
+
+// restoredProgress computes the upload progress of a task restored from a journal
+func (u *UploadFileTask) restoredProgress() *UploadProgress {
+	progress := &UploadProgress{}
+	for _, destination := range u.destinations {
+		for _, slice := range destination.slices {
+			if slice.Status == SLICE_STATUS_REPLACED {
+				continue
+			}
+			progress.Total += int64(slice.slice.SliceSize)
+			if slice.Status == SLICE_STATUS_FINISHED {
+				progress.HasUpload += int64(slice.slice.SliceSize)
+			}
+		}
+	}
+	return progress
+}
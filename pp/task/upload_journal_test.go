@@ -0,0 +1,64 @@
+package task
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestUploadJournal(t *testing.T) {
+	setting.SetupRoot(t.TempDir())
+	pp := &protos.PPBaseInfo{P2PAddress: "pp1"}
+	u := &UploadFileTask{
+		rspUploadFile: map[int]*protos.RspUploadFile{0: {FileHash: "file1", TaskId: "task1"}},
+		uploadType:    protos.UploadType_NEW_UPLOAD,
+		destinations: map[string]*SlicesPerDestination{"pp1": {ppInfo: pp, slices: []*SliceWithStatus{
+			{slice: &protos.SliceHashAddr{SliceHash: "slice1", SliceNumber: 1, PpInfo: pp}, Status: SLICE_STATUS_REPLACED},
+			{slice: &protos.SliceHashAddr{SliceHash: "slice1", SliceNumber: 1, PpInfo: pp}},
+			{slice: &protos.SliceHashAddr{SliceHash: "slice2", SliceNumber: 2, PpInfo: pp}},
+		}}},
+	}
+	file.SetFilePath("file1", "/data/file1")
+	defer file.ClearFileMap("file1")
+	if err := SaveUploadJournal(u); err != nil {
+		t.Fatal(err)
+	}
+
+	// the finished slices are appended, the task is not written again
+	before, _ := os.ReadFile(getUploadJournalPath("file1"))
+	if err := SaveUploadJournalSlice(u, "slice1", SLICE_STATUS_FINISHED); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := os.ReadFile(getUploadJournalPath("file1"))
+	if !bytes.HasPrefix(after, before) {
+		t.Fatal("the journal should only be appended to")
+	}
+	journal, err := LoadUploadJournal("file1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	summary := journal.Summary()
+	if summary.TotalSlices != 2 || summary.FinishedSlices != 1 || journal.Slices[0].Status != SLICE_STATUS_REPLACED {
+		t.Fatalf("wrong journal %+v", summary)
+	}
+
+	// uploads over RPC are not journaled, and their old journals can't be restored
+	file.SetFilePath("file1", "rpc:file1")
+	DeleteUploadJournal("file1")
+	if err = SaveUploadJournal(u); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(getUploadJournalPath("file1")); !os.IsNotExist(err) {
+		t.Fatal("the RPC upload should not be journaled")
+	}
+	journal.FilePath = "rpc:file1"
+	if _, err = RestoreUploadFileTask(journal, nil); !errors.Is(err, UploadErrFatalError) {
+		t.Fatalf("the RPC upload should not be restored, %v", err)
+	}
+}
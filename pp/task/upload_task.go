@@ -301,7 +301,11 @@ func (u *UploadFileTask) SliceFailuresToReport() ([]*protos.SliceHashAddr, []boo
 	var failedSlices []bool
 	for _, slicesPerDestination := range u.destinations {
 		failure := false
+		transferFailed := false
 		for _, slice := range slicesPerDestination.slices {
+			if slice.Status == SLICE_STATUS_FAILED || slice.Status == SLICE_STATUS_STARTED {
+				transferFailed = true
+			}
 			if slice.Status == SLICE_STATUS_FAILED || slice.Status == SLICE_STATUS_STARTED || slice.Status == SLICE_STATUS_WAITING_FOR_SP {
 				slicesToReDownload = append(slicesToReDownload, slice.slice)
 				failedSlices = append(failedSlices, slice.Status == SLICE_STATUS_FAILED)
@@ -309,6 +313,9 @@ func (u *UploadFileTask) SliceFailuresToReport() ([]*protos.SliceHashAddr, []boo
 				failure = true
 			}
 		}
+		if transferFailed && slicesPerDestination.ppInfo != nil {
+			RecordPeerFailure(slicesPerDestination.ppInfo.P2PAddress)
+		}
 		if failure {
 			// stop the destination, and it will be re-started when failure is handled
 			slicesPerDestination.started = false
@@ -328,19 +335,29 @@ func (u *UploadFileTask) UpdateRetryCount() {
 	u.retryCount++
 }
 
+// GetExcludedDestinations returns the destinations the slices failed to be uploaded to, and the blacklisted nodes
 func (u *UploadFileTask) GetExcludedDestinations() []*protos.PPBaseInfo {
 	u.mutex.RLock()
 	defer u.mutex.RUnlock()
 
 	var destinations []*protos.PPBaseInfo
+	excluded := make(map[string]bool)
 	for _, destination := range u.destinations {
 		for _, slice := range destination.slices {
 			if slice.Status == SLICE_STATUS_FAILED || slice.Status == SLICE_STATUS_WAITING_FOR_SP || slice.Status == SLICE_STATUS_REPLACED {
 				destinations = append(destinations, destination.ppInfo)
+				if destination.ppInfo != nil {
+					excluded[destination.ppInfo.P2PAddress] = true
+				}
 				break
 			}
 		}
 	}
+	for _, p2pAddress := range GetBlacklistedPeers() {
+		if !excluded[p2pAddress] {
+			destinations = append(destinations, &protos.PPBaseInfo{P2PAddress: p2pAddress})
+		}
+	}
 
 	return destinations
 }
@@ -592,5 +609,25 @@ func StopRepeatedUploadTaskJob(fileHash string) {
 		return
 	}
 	uploadTask := value.(*UploadFileTask)
-	uploadTask.scheduledJob.Cancel()
+	if uploadTask.scheduledJob != nil {
+		uploadTask.scheduledJob.Cancel()
+	}
+}
+
+// uploadResults the channels waiting for the end of an upload
+var uploadResults = newTransferResults()
+
+// SubscribeUploadResult returns a channel receiving the result of the upload of the file, nil on success. Each caller
+// waiting for the same file subscribes with its own reqId
+func SubscribeUploadResult(fileHash, reqId string) chan error {
+	return uploadResults.subscribe(fileHash, reqId)
+}
+
+func UnsubscribeUploadResult(fileHash, reqId string) {
+	uploadResults.unsubscribe(fileHash, reqId)
+}
+
+// SetUploadResult notifies the subscribers, if any, that the upload of the file has ended
+func SetUploadResult(fileHash string, err error) {
+	uploadResults.notify(fileHash, err)
 }
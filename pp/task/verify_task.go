@@ -37,6 +37,26 @@ func AddVerifyTask(taskId, sliceHash string, tTask VerifyTask) {
 	mu.Unlock()
 }
 
+// GetOngoingVerifyTaskCnt returns the number of verifications which made progress recently
+func GetOngoingVerifyTaskCnt() int {
+	mu.RLock()
+	defer mu.RUnlock()
+	count := 0
+	now := time.Now().Unix()
+	for _, tTask := range verifyTaskMap {
+		if tTask.LastTouchTime+TRANSFER_TASK_TIMEOUT_THRESHOLD >= now {
+			count++
+		}
+	}
+	return count
+}
+
+func CleanVerifyTask(taskId, sliceHash string) {
+	mu.Lock()
+	delete(verifyTaskMap, taskId+sliceHash)
+	mu.Unlock()
+}
+
 func GetVerifyTask(taskId, sliceHash string) (tTask VerifyTask, ok bool) {
 	mu.RLock()
 	tTask, ok = verifyTaskMap[taskId+sliceHash]
@@ -81,14 +101,10 @@ func SaveVerifyData(target *protos.RspVerifyDownload) (bool, error) {
 			return false, errors.Wrap(err, "Failed getting slice data")
 		}
 
-		sliceHash, err := crypto.CalcSliceHash(sliceData, tTask.FileHash, tTask.SliceNum)
 		_ = file.DeleteVerifySlice(tTask.SliceStorageInfo.SliceHash)
-		if tTask.SliceStorageInfo.SliceHash != sliceHash {
+		if !crypto.VerifySliceHash(sliceData, tTask.FileHash, tTask.SliceNum, tTask.SliceStorageInfo.SliceHash) {
 			return false, errors.New("verify: the whole slice is received, but the content doesn't pass the validation.")
 		}
-		if err != nil {
-			return false, err
-		}
 	}
 
 	utils.DebugLog("verify: the whole slice is received, and it passes slice hash validation.")
@@ -97,8 +113,8 @@ func SaveVerifyData(target *protos.RspVerifyDownload) (bool, error) {
 }
 
 func AddAlreadySizeToVerifyTask(taskId, sliceHash string, alreadySizeDelta uint64) (tTask VerifyTask, ok bool) {
-	rwmutex.Lock()
-	defer rwmutex.Unlock()
+	mu.Lock()
+	defer mu.Unlock()
 	tTask, ok = verifyTaskMap[taskId+sliceHash]
 	if !ok {
 		return
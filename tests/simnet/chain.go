@@ -0,0 +1,131 @@
+package simnet
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	sdked25519 "cosmossdk.io/api/cosmos/crypto/ed25519"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"github.com/pkg/errors"
+	registerv1 "github.com/stratosnet/stratos-chain/api/stratos/register/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const chainTokens = "1000000000000000000"
+
+// Chain stands for the stratos-chain grpc server. It only answers the queries of the register module about the nodes
+// of the network, which the nodes make to verify each other
+type Chain struct {
+	registerv1.UnimplementedQueryServer
+
+	mu            sync.RWMutex
+	resourceNodes map[string]*registerv1.ResourceNode
+	metaNodes     map[string]*registerv1.MetaNode
+
+	listener net.Listener
+	server   *grpc.Server
+}
+
+func NewChain() (*Chain, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed listening for the chain grpc")
+	}
+	c := &Chain{
+		resourceNodes: make(map[string]*registerv1.ResourceNode),
+		metaNodes:     make(map[string]*registerv1.MetaNode),
+		listener:      listener,
+		server:        grpc.NewServer(),
+	}
+	registerv1.RegisterQueryServer(c.server, c)
+	go func() {
+		_ = c.server.Serve(listener)
+	}()
+	return c, nil
+}
+
+// Address is the address of the grpc server, for the blockchain.grpc_server setting of the nodes
+func (c *Chain) Address() string {
+	return c.listener.Addr().String()
+}
+
+// AddResourceNode registers a resource node on chain, bonded when active
+func (c *Chain) AddResourceNode(p2pAddress string, p2pPubKey []byte, ownerAddress string, active bool) error {
+	pubKey, err := anypb.New(&sdked25519.PubKey{Key: p2pPubKey})
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resourceNodes[p2pAddress] = &registerv1.ResourceNode{
+		NetworkAddress:     p2pAddress,
+		Pubkey:             pubKey,
+		Status:             bondStatus(active),
+		Tokens:             chainTokens,
+		OwnerAddress:       ownerAddress,
+		EffectiveTokens:    chainTokens,
+		BeneficiaryAddress: ownerAddress,
+	}
+	return nil
+}
+
+// SetResourceNodeActive bonds or unbonds a resource node
+func (c *Chain) SetResourceNodeActive(p2pAddress string, active bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if node, ok := c.resourceNodes[p2pAddress]; ok {
+		node.Status = bondStatus(active)
+	}
+}
+
+// AddMetaNode registers a bonded meta node on chain
+func (c *Chain) AddMetaNode(p2pAddress string, p2pPubKey []byte) error {
+	pubKey, err := anypb.New(&sdked25519.PubKey{Key: p2pPubKey})
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metaNodes[p2pAddress] = &registerv1.MetaNode{
+		NetworkAddress: p2pAddress,
+		Pubkey:         pubKey,
+		Status:         stakingv1beta1.BondStatus_BOND_STATUS_BONDED,
+		Tokens:         chainTokens,
+	}
+	return nil
+}
+
+func (c *Chain) ResourceNode(_ context.Context, req *registerv1.QueryResourceNodeRequest) (*registerv1.QueryResourceNodeResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	node, ok := c.resourceNodes[req.GetNetworkAddr()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "resource node %v not found", req.GetNetworkAddr())
+	}
+	return &registerv1.QueryResourceNodeResponse{Node: node}, nil
+}
+
+func (c *Chain) MetaNode(_ context.Context, req *registerv1.QueryMetaNodeRequest) (*registerv1.QueryMetaNodeResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	node, ok := c.metaNodes[req.GetNetworkAddr()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "meta node %v not found", req.GetNetworkAddr())
+	}
+	return &registerv1.QueryMetaNodeResponse{Node: node}, nil
+}
+
+func (c *Chain) Stop() {
+	c.server.Stop()
+}
+
+func bondStatus(active bool) stakingv1beta1.BondStatus {
+	if active {
+		return stakingv1beta1.BondStatus_BOND_STATUS_BONDED
+	}
+	return stakingv1beta1.BondStatus_BOND_STATUS_UNBONDED
+}
@@ -0,0 +1,200 @@
+package simnet
+
+import (
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	linkChunkSize = 32 * 1024
+	// linkDialTimeout is how long the link retries reaching a node still binding its port, as a SYN would be retransmitted
+	linkDialTimeout = 3 * time.Second
+	linkDialRetry   = 50 * time.Millisecond
+)
+
+// Link is a loopback TCP proxy standing for the network path to a node. The other nodes connect to the node through its
+// link, so the faults set on the link apply to all the traffic of the node with its peers. The traffic is encrypted
+// end to end, so the faults apply to the connections rather than to single messages
+type Link struct {
+	listener net.Listener
+	target   string
+
+	mu       sync.Mutex
+	delay    time.Duration
+	dropRate float64
+	cut      bool
+	conns    map[net.Conn]struct{}
+}
+
+// NewLink listens on a free loopback port and forwards the connections to target
+func NewLink(target string) (*Link, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed listening for the link")
+	}
+	l := &Link{
+		listener: listener,
+		target:   target,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	go l.accept()
+	return l, nil
+}
+
+// Address is the address the other nodes reach the node at
+func (l *Link) Address() string {
+	return l.listener.Addr().String()
+}
+
+func (l *Link) Port() string {
+	return strconv.Itoa(l.listener.Addr().(*net.TCPAddr).Port)
+}
+
+// SetDelay adds a latency to the data sent in both directions
+func (l *Link) SetDelay(delay time.Duration) {
+	l.mu.Lock()
+	l.delay = delay
+	l.mu.Unlock()
+}
+
+// SetDropRate resets the given fraction (0 to 1) of the new connections as soon as they are accepted
+func (l *Link) SetDropRate(rate float64) {
+	l.mu.Lock()
+	l.dropRate = rate
+	l.mu.Unlock()
+}
+
+// Cut closes the connections and refuses the new ones until Restore is called, as if the node was partitioned from the
+// network while still running
+func (l *Link) Cut() {
+	l.mu.Lock()
+	l.cut = true
+	l.mu.Unlock()
+	l.Reset()
+}
+
+func (l *Link) Restore() {
+	l.mu.Lock()
+	l.cut = false
+	l.mu.Unlock()
+}
+
+// Reset closes the connections going through the link
+func (l *Link) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for conn := range l.conns {
+		_ = conn.Close()
+	}
+}
+
+func (l *Link) Close() {
+	_ = l.listener.Close()
+	l.Reset()
+}
+
+func (l *Link) accept() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+		l.mu.Lock()
+		refused := l.cut || (l.dropRate > 0 && rand.Float64() < l.dropRate)
+		l.mu.Unlock()
+		if refused {
+			_ = conn.Close()
+			continue
+		}
+		go l.forward(conn)
+	}
+}
+
+func (l *Link) forward(conn net.Conn) {
+	targetConn, err := l.dial()
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	l.track(conn, targetConn)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go l.pipe(conn, targetConn, &wg)
+	go l.pipe(targetConn, conn, &wg)
+	wg.Wait()
+	l.untrack(conn, targetConn)
+}
+
+// dial connects to the target, retrying while it refuses the connection for linkDialTimeout: the nodes connect to the
+// meta node before they listen, and the meta node connects back to them during the handshake
+func (l *Link) dial() (net.Conn, error) {
+	deadline := time.Now().Add(linkDialTimeout)
+	for {
+		conn, err := net.Dial("tcp", l.target)
+		if err == nil || time.Now().After(deadline) {
+			return conn, err
+		}
+		time.Sleep(linkDialRetry)
+	}
+}
+
+type linkChunk struct {
+	data []byte
+	at   time.Time
+}
+
+// pipe copies the data from src to dst, holding each chunk for the delay of the link
+func (l *Link) pipe(src, dst net.Conn, wg *sync.WaitGroup) {
+	defer wg.Done()
+	chunks := make(chan linkChunk, 256)
+	go func() {
+		defer close(chunks)
+		for {
+			buffer := make([]byte, linkChunkSize)
+			n, err := src.Read(buffer)
+			if n > 0 {
+				chunks <- linkChunk{data: buffer[:n], at: time.Now()}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	for chunk := range chunks {
+		l.mu.Lock()
+		delay := l.delay
+		l.mu.Unlock()
+		if wait := time.Until(chunk.at.Add(delay)); wait > 0 {
+			time.Sleep(wait)
+		}
+		if _, err := dst.Write(chunk.data); err != nil {
+			break
+		}
+	}
+	_ = src.Close()
+	_ = dst.Close()
+	for range chunks {
+	}
+}
+
+func (l *Link) track(conns ...net.Conn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, conn := range conns {
+		l.conns[conn] = struct{}{}
+	}
+}
+
+func (l *Link) untrack(conns ...net.Conn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, conn := range conns {
+		delete(l.conns, conn)
+	}
+}
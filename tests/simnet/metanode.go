@@ -0,0 +1,504 @@
+package simnet
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/core"
+	fwcrypto "github.com/stratosnet/sds/framework/crypto"
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+	msgtypes "github.com/stratosnet/sds/sds-msg/types"
+)
+
+const (
+	backupTimeout     = 30 * time.Second
+	replicateInterval = time.Second
+)
+
+type metaNodeKey struct{}
+
+// Fault drops or delays the messages of a type received by the meta node
+type Fault struct {
+	// DropRate is the fraction (0 to 1) of the messages ignored
+	DropRate float64
+	Delay    time.Duration
+}
+
+// ResourceNode is what the meta node knows of a resource node
+type ResourceNode struct {
+	Info *protos.PPBaseInfo
+	// Registered is set once the node is registered as a resource node
+	Registered bool
+	Active     bool
+	// Online is set while the node is mining and connected to the meta node
+	Online bool
+
+	conn core.WriteCloser
+}
+
+// StoredFile is what the meta node knows of an uploaded file
+type StoredFile struct {
+	Hash          string
+	Name          string
+	Size          uint64
+	Owner         string
+	EncryptionTag string
+	Slices        []*StoredSlice
+
+	taskId string
+}
+
+// StoredSlice is a slice of a file and the nodes storing it
+type StoredSlice struct {
+	Hash   string
+	Number uint64
+	Size   uint64
+	Offset *protos.SliceOffset
+	// Storers are the P2P addresses of the nodes that reported storing the slice
+	Storers []string
+}
+
+type backupTask struct {
+	fileHash    string
+	sliceNumber uint64
+	receiver    string
+	deadline    time.Time
+}
+
+// MetaNode stands for the meta node of the network. It answers the messages the resource nodes send to the meta node
+// during their startup, the uploads, downloads and backups, and keeps the slices replicated on the online nodes. The
+// message handlers are global, so only one MetaNode can run in a process
+type MetaNode struct {
+	chain      *Chain
+	replicas   int
+	privKey    *fwed25519.PrivKey
+	p2pAddress string
+	p2pPubKey  string
+
+	listener net.Listener
+	link     *Link
+	server   *core.Server
+
+	mu       sync.Mutex
+	nodes    map[string]*ResourceNode
+	files    map[string]*StoredFile
+	backups  map[string]*backupTask
+	faults   map[uint8]Fault
+	received map[uint8]int
+	seq      int
+
+	quit chan struct{}
+}
+
+var (
+	registerOnce sync.Once
+	metaNodeMu   sync.Mutex
+	running      *MetaNode
+)
+
+// NewMetaNode starts the meta node, registered on chain. replicas is the number of online nodes each slice is kept on
+func NewMetaNode(chain *Chain, replicas int) (*MetaNode, error) {
+	metaNodeMu.Lock()
+	defer metaNodeMu.Unlock()
+	if running != nil {
+		return nil, errors.New("a meta node is already running in this process")
+	}
+
+	privKey := fwed25519.GenPrivKey()
+	p2pPubKey, err := fwtypes.P2PPubKeyToBech32(privKey.PubKey())
+	if err != nil {
+		return nil, err
+	}
+	m := &MetaNode{
+		chain:      chain,
+		replicas:   replicas,
+		privKey:    privKey,
+		p2pAddress: fwtypes.P2PAddressBytesToBech32(privKey.PubKey().Address()),
+		p2pPubKey:  p2pPubKey,
+		nodes:      make(map[string]*ResourceNode),
+		files:      make(map[string]*StoredFile),
+		backups:    make(map[string]*backupTask),
+		faults:     make(map[uint8]Fault),
+		received:   make(map[uint8]int),
+		quit:       make(chan struct{}),
+	}
+	if err = chain.AddMetaNode(m.p2pAddress, privKey.PubKey().Bytes()); err != nil {
+		return nil, err
+	}
+
+	registerOnce.Do(registerHandlers)
+	if utils.MyIdWorker == nil {
+		if err = utils.InitIdWorker(privKey.PubKey().Address()[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	m.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed listening for the meta node")
+	}
+	if m.link, err = NewLink(m.listener.Addr().String()); err != nil {
+		_ = m.listener.Close()
+		return nil, err
+	}
+	m.server = core.CreateServer(
+		core.OnCloseOption(m.onClose),
+		core.BufferSizeOption(10000),
+		core.MinAppVersionOption(setting.MinAppVersion),
+		core.P2pAddressOption(m.p2pAddress),
+		core.ContextKVOption([]core.ContextKV{{Key: metaNodeKey{}, Value: m}}),
+		core.MuxOption(true),
+		core.HandshakeAuthOption(&core.HandshakeAuth{
+			P2pPubKey: privKey.PubKey().Bytes(),
+			Sign:      privKey.Sign,
+		}),
+	)
+	go func() {
+		if err := m.server.Start(m.listener); err != nil {
+			utils.DebugLogf("meta node server stopped: %v", err)
+		}
+	}()
+	go m.replicateLoop()
+	running = m
+	return m, nil
+}
+
+func (m *MetaNode) P2PAddress() string {
+	return m.p2pAddress
+}
+
+func (m *MetaNode) P2PPubKey() string {
+	return m.p2pPubKey
+}
+
+// Address is the address the resource nodes reach the meta node at, through its link
+func (m *MetaNode) Address() string {
+	return m.link.Address()
+}
+
+// Link is the network path of the meta node, shared by all the resource nodes
+func (m *MetaNode) Link() *Link {
+	return m.link
+}
+
+// SetFault drops or delays the messages of the type received from now on
+func (m *MetaNode) SetFault(msgType header.MsgType, fault Fault) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.faults[msgType.Id] = fault
+}
+
+func (m *MetaNode) ClearFaults() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.faults = make(map[uint8]Fault)
+}
+
+// Received is the number of messages of the type the meta node handled, the dropped ones excluded
+func (m *MetaNode) Received(msgType header.MsgType) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.received[msgType.Id]
+}
+
+// Node returns a copy of what the meta node knows of the resource node
+func (m *MetaNode) Node(p2pAddress string) (ResourceNode, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	node, ok := m.nodes[p2pAddress]
+	if !ok {
+		return ResourceNode{}, false
+	}
+	return *node, true
+}
+
+// File returns a copy of what the meta node knows of the file
+func (m *MetaNode) File(fileHash string) (*StoredFile, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, ok := m.files[fileHash]
+	if !ok {
+		return nil, false
+	}
+	copied := *file
+	copied.Slices = make([]*StoredSlice, 0, len(file.Slices))
+	for _, slice := range file.Slices {
+		copiedSlice := *slice
+		copiedSlice.Storers = append([]string(nil), slice.Storers...)
+		copied.Slices = append(copied.Slices, &copiedSlice)
+	}
+	return &copied, true
+}
+
+// Files returns the hashes of the files uploaded so far
+func (m *MetaNode) Files() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var hashes []string
+	for hash := range m.files {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+	return hashes
+}
+
+// Activate activates the resource node on chain and notifies it, as the meta node does once the activation tx is
+// committed
+func (m *MetaNode) Activate(p2pAddress string) error {
+	m.mu.Lock()
+	node, ok := m.nodes[p2pAddress]
+	if !ok || !node.Registered {
+		m.mu.Unlock()
+		return errors.Errorf("resource node %v is not registered", p2pAddress)
+	}
+	node.Active = true
+	conn := node.conn
+	m.mu.Unlock()
+
+	m.chain.SetResourceNodeActive(p2pAddress, true)
+	if conn == nil {
+		return nil
+	}
+	notice := &protos.RspActivatePP{
+		Result:          &protos.Result{State: protos.ResultState_RES_SUCCESS},
+		ActivationState: msgtypes.PP_ACTIVE,
+	}
+	return m.write(context.Background(), conn, notice, header.NoticeActivatedPP)
+}
+
+// AddResourceNode registers an active resource node beforehand, as if it was registered and activated in an earlier
+// session
+func (m *MetaNode) AddResourceNode(info *protos.PPBaseInfo, p2pPubKey []byte) error {
+	if err := m.chain.AddResourceNode(info.P2PAddress, p2pPubKey, info.WalletAddress, true); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes[info.P2PAddress] = &ResourceNode{Info: info, Registered: true, Active: true}
+	return nil
+}
+
+func (m *MetaNode) Stop() {
+	metaNodeMu.Lock()
+	defer metaNodeMu.Unlock()
+	close(m.quit)
+	m.link.Close()
+	m.server.Stop()
+	if running == m {
+		running = nil
+	}
+}
+
+func (m *MetaNode) onClose(conn core.WriteCloser) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, node := range m.nodes {
+		if node.conn == conn {
+			node.conn = nil
+			node.Online = false
+		}
+	}
+}
+
+// write signs and sends the message. Responses carry the reqId of the request they answer
+func (m *MetaNode) write(ctx context.Context, conn core.WriteCloser, message proto.Message, msgType header.MsgType) error {
+	body, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	msgBuf := &msg.RelayMsgBuf{
+		MSGHead: header.MakeMessageHeader(1, setting.AppVersion, uint32(len(body)), msgType),
+		MSGBody: body,
+		MSGSign: msg.MessageSign{
+			P2pPubKey:  m.privKey.PubKey().Bytes(),
+			P2pAddress: m.p2pAddress,
+			Signer:     m.privKey.Sign,
+		},
+	}
+	msgBuf.MSGHead.ReqId = core.GetReqIdFromContext(ctx)
+	return conn.Write(msgBuf, ctx)
+}
+
+func (m *MetaNode) reply(ctx context.Context, conn core.WriteCloser, message proto.Message, msgType header.MsgType) {
+	if err := m.write(ctx, conn, message, msgType); err != nil {
+		utils.ErrorLogf("meta node failed sending [%v]: %v", msgType.Name, err)
+	}
+}
+
+// nodeSign signs the message the way the resource nodes verify the messages relayed on behalf of the meta node
+func (m *MetaNode) nodeSign(message proto.Message) []byte {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		utils.ErrorLog("meta node failed encoding a message to sign", err)
+		return nil
+	}
+	sign, err := m.privKey.Sign(fwcrypto.CalcHashBytes(data))
+	if err != nil {
+		utils.ErrorLog("meta node failed signing a message", err)
+		return nil
+	}
+	return sign
+}
+
+// onlineNodes returns the online nodes but the excluded ones, in a rotating order to spread the slices. The caller
+// holds the lock
+func (m *MetaNode) onlineNodes(excluded map[string]bool) []*ResourceNode {
+	var addresses []string
+	for address, node := range m.nodes {
+		if node.Online && node.Info != nil && !excluded[address] {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	sort.Strings(addresses)
+	m.seq++
+	var nodes []*ResourceNode
+	for i := range addresses {
+		nodes = append(nodes, m.nodes[addresses[(i+m.seq)%len(addresses)]])
+	}
+	return nodes
+}
+
+func (m *MetaNode) nodeInfo(p2pAddress string) *protos.PPBaseInfo {
+	if node, ok := m.nodes[p2pAddress]; ok && node.Info != nil {
+		return node.Info
+	}
+	return &protos.PPBaseInfo{P2PAddress: p2pAddress}
+}
+
+func (m *MetaNode) replicateLoop() {
+	ticker := time.NewTicker(replicateInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.quit:
+			return
+		case <-ticker.C:
+			m.replicate()
+		}
+	}
+}
+
+// replicate asks the online nodes to back the slices up until each one is stored by enough online nodes
+func (m *MetaNode) replicate() {
+	type notice struct {
+		conn    core.WriteCloser
+		message *protos.NoticeFileSliceBackup
+	}
+	var notices []notice
+
+	m.mu.Lock()
+	now := time.Now()
+	pending := make(map[string]bool)
+	for taskId, task := range m.backups {
+		if now.After(task.deadline) {
+			delete(m.backups, taskId)
+			continue
+		}
+		pending[task.fileHash+sliceKey(task.sliceNumber)] = true
+	}
+	for _, file := range m.files {
+		for _, slice := range file.Slices {
+			if pending[file.Hash+sliceKey(slice.Number)] {
+				continue
+			}
+			var source *ResourceNode
+			online := 0
+			excluded := make(map[string]bool)
+			for _, storer := range slice.Storers {
+				excluded[storer] = true
+				if node, ok := m.nodes[storer]; ok && node.Online {
+					online++
+					source = node
+				}
+			}
+			if source == nil || online >= m.replicas {
+				continue
+			}
+			receivers := m.onlineNodes(excluded)
+			if len(receivers) == 0 {
+				continue
+			}
+			receiver := receivers[0]
+			taskId := newTaskId()
+			message := &protos.NoticeFileSliceBackup{
+				TaskId:           taskId,
+				FileHash:         file.Hash,
+				SliceStorageInfo: &protos.SliceStorageInfo{SliceSize: slice.Size, SliceHash: slice.Hash},
+				SliceNumber:      slice.Number,
+				PpInfo:           source.Info,
+				SpP2PAddress:     m.p2pAddress,
+				Pubkey:           m.privKey.PubKey().Bytes(),
+				ToP2PAddress:     receiver.Info.P2PAddress,
+				TimeStamp:        now.Unix(),
+			}
+			message.NodeSign = m.nodeSign(message)
+			m.backups[taskId] = &backupTask{
+				fileHash:    file.Hash,
+				sliceNumber: slice.Number,
+				receiver:    receiver.Info.P2PAddress,
+				deadline:    now.Add(backupTimeout),
+			}
+			notices = append(notices, notice{conn: receiver.conn, message: message})
+		}
+	}
+	m.mu.Unlock()
+
+	for _, n := range notices {
+		ctx := core.CreateContextWithReqId(context.Background(), core.GenerateNewReqId(header.NoticeFileSliceBackup.Id))
+		m.reply(ctx, n.conn, n.message, header.NoticeFileSliceBackup)
+	}
+}
+
+func newTaskId() string {
+	return utils.GetRandomString(16)
+}
+
+func sliceKey(sliceNumber uint64) string {
+	return "#" + strconv.FormatUint(sliceNumber, 10)
+}
+
+// inject applies the fault set for the message type. It returns the delay to handle the message after, and false
+// when the message is dropped
+func (m *MetaNode) inject(msgType uint8) (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fault := m.faults[msgType]
+	if fault.DropRate > 0 && rand.Float64() < fault.DropRate {
+		return 0, false
+	}
+	m.received[msgType]++
+	return fault.Delay, true
+}
+
+func unmarshal(ctx context.Context, target proto.Message) bool {
+	if err := proto.Unmarshal(core.MessageFromContext(ctx).MSGBody, target); err != nil {
+		utils.ErrorLog("meta node failed decoding a message", err)
+		return false
+	}
+	return true
+}
+
+func success() *protos.Result {
+	return &protos.Result{State: protos.ResultState_RES_SUCCESS}
+}
+
+func failure(message string) *protos.Result {
+	return &protos.Result{State: protos.ResultState_RES_FAIL, Msg: message}
+}
@@ -0,0 +1,483 @@
+package simnet
+
+import (
+	"context"
+	"time"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg/header"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/sds-msg/protos"
+	msgtypes "github.com/stratosnet/sds/sds-msg/types"
+)
+
+type metaNodeHandler struct {
+	msgType header.MsgType
+	handle  func(*MetaNode, context.Context, core.WriteCloser)
+}
+
+var metaNodeHandlers = []metaNodeHandler{
+	{header.ReqGetSPList, (*MetaNode).getSPList},
+	{header.ReqGetPPStatus, (*MetaNode).getPPStatus},
+	{header.ReqRegisterNewPP, (*MetaNode).registerNewPP},
+	{header.ReqRegister, (*MetaNode).register},
+	{header.ReqMining, (*MetaNode).mining},
+	{header.ReqReportNodeStatus, (*MetaNode).reportNodeStatus},
+	{header.ReqGetWalletOz, (*MetaNode).getWalletOz},
+	{header.ReqUploadFile, (*MetaNode).uploadFile},
+	{header.ReqReportUploadSliceResult, (*MetaNode).reportUploadSliceResult},
+	{header.ReqUploadSlicesWrong, (*MetaNode).uploadSlicesWrong},
+	{header.ReqFileStorageInfo, (*MetaNode).fileStorageInfo},
+	{header.ReqDownloadFileWrong, (*MetaNode).downloadFileWrong},
+	{header.ReqReportDownloadResult, (*MetaNode).reportDownloadResult},
+	{header.ReqReportBackupSliceResult, (*MetaNode).reportBackupSliceResult},
+	{header.ReqTransferDownloadWrong, (*MetaNode).transferDownloadWrong},
+}
+
+// registerHandlers registers the handlers of the meta node messages. They find the running MetaNode in the context of
+// the connection, and apply its faults
+func registerHandlers() {
+	for _, handler := range metaNodeHandlers {
+		handler := handler
+		core.Register(handler.msgType, func(ctx context.Context, conn core.WriteCloser) {
+			m, ok := ctx.Value(metaNodeKey{}).(*MetaNode)
+			if !ok {
+				return
+			}
+			delay, ok := m.inject(handler.msgType.Id)
+			if !ok {
+				utils.DebugLogf("meta node dropped [%v]", handler.msgType.Name)
+				return
+			}
+			if delay == 0 {
+				handler.handle(m, ctx, conn)
+				return
+			}
+			go func() {
+				time.Sleep(delay)
+				handler.handle(m, ctx, conn)
+			}()
+		})
+	}
+}
+
+func (m *MetaNode) getSPList(ctx context.Context, conn core.WriteCloser) {
+	m.reply(ctx, conn, &protos.RspGetSPList{
+		SpList: []*protos.SPBaseInfo{{
+			P2PAddress:     m.p2pAddress,
+			P2PPubKey:      m.p2pPubKey,
+			NetworkAddress: m.Address(),
+		}},
+		Result: success(),
+	}, header.RspGetSPList)
+}
+
+func (m *MetaNode) getPPStatus(ctx context.Context, conn core.WriteCloser) {
+	p2pAddress := core.GetSrcP2pAddrFromContext(ctx)
+	m.mu.Lock()
+	node, ok := m.nodes[p2pAddress]
+	rsp := &protos.RspGetPPStatus{Result: success(), State: int32(protos.PPState_OFFLINE)}
+	switch {
+	case !ok || !node.Registered:
+		rsp.Result = failure("Please register first")
+	case node.Active:
+		rsp.IsActive = msgtypes.PP_ACTIVE
+		if node.Online {
+			rsp.State = int32(protos.PPState_ONLINE)
+		}
+	default:
+		rsp.IsActive = msgtypes.PP_INACTIVE
+	}
+	if ok {
+		node.conn = conn
+	}
+	m.mu.Unlock()
+	m.reply(ctx, conn, rsp, header.RspGetPPStatus)
+}
+
+func (m *MetaNode) registerNewPP(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqRegisterNewPP
+	if !unmarshal(ctx, &target) {
+		return
+	}
+	walletAddress := ""
+	if target.Signature != nil {
+		walletAddress = target.Signature.Address
+	}
+
+	m.mu.Lock()
+	node, alreadyPp := m.nodes[target.P2PAddress]
+	if !alreadyPp {
+		node = &ResourceNode{}
+		m.nodes[target.P2PAddress] = node
+	}
+	node.Registered = true
+	node.conn = conn
+	node.Info = &protos.PPBaseInfo{
+		P2PAddress:         target.P2PAddress,
+		WalletAddress:      walletAddress,
+		NetworkAddress:     target.NetworkAddress,
+		BeneficiaryAddress: target.BeneficiaryAddress,
+	}
+	active := node.Active
+	m.mu.Unlock()
+
+	rsp := &protos.RspRegisterNewPP{Result: success(), AlreadyPp: alreadyPp}
+	if err := m.chain.AddResourceNode(target.P2PAddress, target.PubKey, walletAddress, active); err != nil {
+		rsp.Result = failure(err.Error())
+	}
+	m.reply(ctx, conn, rsp, header.RspRegisterNewPP)
+}
+
+func (m *MetaNode) register(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqRegister
+	if !unmarshal(ctx, &target) || target.Address == nil {
+		return
+	}
+
+	m.mu.Lock()
+	node, ok := m.nodes[target.Address.P2PAddress]
+	rsp := &protos.RspRegister{Result: success(), P2PAddress: target.Address.P2PAddress}
+	if ok && node.Registered {
+		node.Info = target.Address
+		node.conn = conn
+		rsp.IsPP = true
+	} else {
+		rsp.Result = failure("Please register first")
+	}
+	m.mu.Unlock()
+	m.reply(ctx, conn, rsp, header.RspRegister)
+}
+
+func (m *MetaNode) mining(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqMining
+	if !unmarshal(ctx, &target) || target.Address == nil {
+		return
+	}
+
+	m.mu.Lock()
+	node, ok := m.nodes[target.Address.P2PAddress]
+	rsp := &protos.RspMining{Result: success()}
+	switch {
+	case !ok || !node.Registered:
+		rsp.Result = failure("Please register first")
+	case !node.Active:
+		rsp.Result = failure("the node is not activated")
+	default:
+		node.Info = target.Address
+		node.conn = conn
+	}
+	m.mu.Unlock()
+	m.reply(ctx, conn, rsp, header.RspMining)
+}
+
+// reportNodeStatus marks the node online: the node reports its status once it listens for the other nodes
+func (m *MetaNode) reportNodeStatus(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqReportNodeStatus
+	if !unmarshal(ctx, &target) {
+		return
+	}
+
+	m.mu.Lock()
+	node, ok := m.nodes[target.P2PAddress]
+	rsp := &protos.RspReportNodeStatus{Result: success(), Ppstate: int32(protos.PPState_ONLINE)}
+	if ok && node.Active {
+		node.Online = true
+		node.conn = conn
+	} else {
+		rsp.Result = failure("the node is not activated")
+		rsp.Ppstate = int32(protos.PPState_OFFLINE)
+	}
+	m.mu.Unlock()
+	m.reply(ctx, conn, rsp, header.RspReportNodeStatus)
+}
+
+func (m *MetaNode) getWalletOz(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqGetWalletOz
+	if !unmarshal(ctx, &target) {
+		return
+	}
+	m.reply(ctx, conn, &protos.RspGetWalletOz{
+		WalletOz:       "1000000000000",
+		SequenceNumber: "1",
+		WalletAddress:  target.WalletAddress,
+		Result:         success(),
+	}, header.RspGetWalletOz)
+}
+
+func (m *MetaNode) uploadFile(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqUploadFile
+	if !unmarshal(ctx, &target) || target.FileInfo == nil || target.MyAddress == nil {
+		return
+	}
+	owner := target.FileInfo.OwnerWalletAddress
+	if owner == "" && target.Signature != nil {
+		owner = target.Signature.Address
+	}
+	rsp := &protos.RspUploadFile{
+		FileHash:           target.FileInfo.FileHash,
+		TaskId:             newTaskId(),
+		Result:             success(),
+		OwnerWalletAddress: owner,
+		IsEncrypted:        target.FileInfo.EncryptionTag != "",
+		SpP2PAddress:       m.p2pAddress,
+		TimeStamp:          time.Now().Unix(),
+	}
+
+	m.mu.Lock()
+	if _, ok := m.files[rsp.FileHash]; ok {
+		// Uploaded already: nothing left to send
+		m.mu.Unlock()
+		rsp.NodeSign = m.nodeSign(rsp)
+		m.reply(ctx, conn, rsp, header.RspUploadFile)
+		return
+	}
+	destinations := m.onlineNodes(map[string]bool{target.MyAddress.P2PAddress: true})
+	if len(destinations) == 0 {
+		m.mu.Unlock()
+		rsp.Result = failure("no online node to store the file")
+		m.reply(ctx, conn, rsp, header.RspUploadFile)
+		return
+	}
+	file := &StoredFile{
+		Hash:          target.FileInfo.FileHash,
+		Name:          target.FileInfo.FileName,
+		Size:          target.FileInfo.FileSize,
+		Owner:         owner,
+		EncryptionTag: target.FileInfo.EncryptionTag,
+		taskId:        rsp.TaskId,
+	}
+	for i, slice := range target.Slices {
+		slice.PpInfo = destinations[i%len(destinations)].Info
+		file.Slices = append(file.Slices, &StoredSlice{
+			Hash:   slice.SliceHash,
+			Number: slice.SliceNumber,
+			Size:   slice.SliceSize,
+			Offset: slice.SliceOffset,
+		})
+	}
+	m.files[file.Hash] = file
+	m.mu.Unlock()
+
+	rsp.Slices = target.Slices
+	rsp.TotalSlice = int64(len(target.Slices))
+	rsp.NodeSign = m.nodeSign(rsp)
+	m.reply(ctx, conn, rsp, header.RspUploadFile)
+}
+
+// reportUploadSliceResult records the slice as stored once its destination reports receiving it
+func (m *MetaNode) reportUploadSliceResult(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReportUploadSliceResult
+	if !unmarshal(ctx, &target) || target.Slice == nil {
+		return
+	}
+	if target.UploadSuccess && target.Slice.PpInfo != nil && target.Slice.PpInfo.P2PAddress == target.P2PAddress {
+		m.addStorer(target.FileHash, target.Slice.SliceNumber, target.P2PAddress)
+	}
+	m.reply(ctx, conn, &protos.RspReportUploadSliceResult{Result: success(), Slice: target.Slice},
+		header.RspReportUploadSliceResult)
+}
+
+func (m *MetaNode) uploadSlicesWrong(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqUploadSlicesWrong
+	if !unmarshal(ctx, &target) || target.MyAddress == nil {
+		return
+	}
+	excluded := map[string]bool{target.MyAddress.P2PAddress: true}
+	for _, destination := range target.ExcludedDestinations {
+		excluded[destination.P2PAddress] = true
+	}
+
+	m.mu.Lock()
+	file, ok := m.files[target.FileHash]
+	destinations := m.onlineNodes(excluded)
+	m.mu.Unlock()
+	rsp := &protos.RspUploadSlicesWrong{
+		Result:     success(),
+		FileHash:   target.FileHash,
+		UploadType: target.UploadType,
+		TaskId:     target.TaskId,
+	}
+	if !ok || len(destinations) == 0 {
+		rsp.Result = failure("no online node to store the slices")
+		m.reply(ctx, conn, rsp, header.RspUploadSlicesWrong)
+		return
+	}
+
+	// Every slice listed needs a new destination, FailedSlices only tells which ones failed rather than timed out
+	for _, slice := range target.Slices {
+		slice.PpInfo = destinations[len(rsp.Slices)%len(destinations)].Info
+		rsp.Slices = append(rsp.Slices, slice)
+	}
+	rsp.RspUploadFile = &protos.RspUploadFile{
+		Slices:             rsp.Slices,
+		FileHash:           target.FileHash,
+		TaskId:             target.TaskId,
+		TotalSlice:         int64(len(file.Slices)),
+		Result:             success(),
+		OwnerWalletAddress: file.Owner,
+		IsEncrypted:        file.EncryptionTag != "",
+		SpP2PAddress:       m.p2pAddress,
+		TimeStamp:          time.Now().Unix(),
+	}
+	rsp.RspUploadFile.NodeSign = m.nodeSign(rsp.RspUploadFile)
+	m.reply(ctx, conn, rsp, header.RspUploadSlicesWrong)
+}
+
+func (m *MetaNode) fileStorageInfo(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqFileStorageInfo
+	if !unmarshal(ctx, &target) || target.FileIndexes == nil {
+		return
+	}
+	_, ownerAddress, fileHash, _, err := fwtypes.ParseFileHandle(target.FileIndexes.FilePath)
+	if err != nil {
+		rsp := &protos.RspFileStorageInfo{SpP2PAddress: m.p2pAddress, Result: failure(err.Error())}
+		rsp.NodeSign = m.nodeSign(rsp)
+		m.reply(ctx, conn, rsp, header.RspFileStorageInfo)
+		return
+	}
+	m.reply(ctx, conn, m.storageInfo(fileHash, ownerAddress, target.FileIndexes, nil, nil), header.RspFileStorageInfo)
+}
+
+// downloadFileWrong gives other storers for the failed slices
+func (m *MetaNode) downloadFileWrong(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqDownloadFileWrong
+	if !unmarshal(ctx, &target) || target.FileIndexes == nil {
+		return
+	}
+	failedSlices := make(map[string]bool)
+	for _, sliceHash := range target.FailedSlices {
+		failedSlices[sliceHash] = true
+	}
+	failedNodes := make(map[string]bool)
+	for _, node := range target.FailedPpNodes {
+		failedNodes[node.P2PAddress] = true
+	}
+	// The retries only carry the hash of the file, the owner is the node downloading it
+	rsp := m.storageInfo(target.FileHash, target.FileIndexes.WalletAddress, target.FileIndexes, failedSlices, failedNodes)
+	m.reply(ctx, conn, rsp, header.RspDownloadFileWrong)
+}
+
+// storageInfo tells where to download the slices of the file owned by ownerAddress from. When onlySlices is set, only
+// these slices are included
+func (m *MetaNode) storageInfo(fileHash, ownerAddress string, indexes *protos.FileIndexes, onlySlices, excludedNodes map[string]bool) *protos.RspFileStorageInfo {
+	rsp := &protos.RspFileStorageInfo{
+		P2PAddress:    indexes.P2PAddress,
+		WalletAddress: indexes.WalletAddress,
+		SavePath:      indexes.SavePath,
+		SpP2PAddress:  m.p2pAddress,
+		TaskId:        newTaskId(),
+		TimeStamp:     time.Now().Unix(),
+		Result:        success(),
+	}
+	m.mu.Lock()
+	file, ok := m.files[fileHash]
+	if ok {
+		rsp.FileHash = file.Hash
+		rsp.FileName = file.Name
+		rsp.FileSize = file.Size
+		rsp.EncryptionTag = file.EncryptionTag
+		if indexes.SaveAs != "" {
+			rsp.FileName = indexes.SaveAs
+		}
+		for _, slice := range file.Slices {
+			if onlySlices != nil && !onlySlices[slice.Hash] {
+				continue
+			}
+			var storer *ResourceNode
+			for _, address := range slice.Storers {
+				if node, found := m.nodes[address]; found && node.Online && !excludedNodes[address] {
+					storer = node
+					break
+				}
+			}
+			if storer == nil {
+				rsp.Result = failure("no online node stores slice " + slice.Hash)
+				break
+			}
+			rsp.SliceInfo = append(rsp.SliceInfo, &protos.DownloadSliceInfo{
+				SliceStorageInfo: &protos.SliceStorageInfo{SliceSize: slice.Size, SliceHash: slice.Hash},
+				SliceNumber:      slice.Number,
+				StoragePpInfo:    storer.Info,
+				TaskId:           rsp.TaskId,
+				SliceOffset:      slice.Offset,
+			})
+		}
+	}
+	m.mu.Unlock()
+
+	switch {
+	case !ok:
+		rsp.Result = failure("file " + fileHash + " not found")
+	case file.Owner != ownerAddress || file.Owner != indexes.WalletAddress:
+		rsp.Result = failure("only the owner of the file can download it")
+	}
+	if rsp.Result.State != protos.ResultState_RES_SUCCESS {
+		rsp.SliceInfo = nil
+	}
+	rsp.NodeSign = m.nodeSign(rsp)
+	return rsp
+}
+
+func (m *MetaNode) reportDownloadResult(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqReportDownloadResult
+	if !unmarshal(ctx, &target) {
+		return
+	}
+	m.reply(ctx, conn, &protos.RspReportDownloadResult{Result: success(), SliceInfo: target.SliceInfo},
+		header.RspReportDownloadResult)
+}
+
+// reportBackupSliceResult records the slice as stored once the receiver of the backup reports it
+func (m *MetaNode) reportBackupSliceResult(ctx context.Context, conn core.WriteCloser) {
+	var target protos.ReqReportBackupSliceResult
+	if !unmarshal(ctx, &target) {
+		return
+	}
+	if target.IsReceiver {
+		m.mu.Lock()
+		task, ok := m.backups[target.TaskId]
+		delete(m.backups, target.TaskId)
+		m.mu.Unlock()
+		if ok && target.BackupSuccess {
+			m.addStorer(task.fileHash, task.sliceNumber, task.receiver)
+		}
+	}
+	m.reply(ctx, conn, &protos.RspReportBackupSliceResult{
+		TaskId:    target.TaskId,
+		Result:    success(),
+		SliceHash: target.SliceHash,
+	}, header.RspReportBackupSliceResult)
+}
+
+// transferDownloadWrong gives up on the backup, the next round of replication picks another receiver
+func (m *MetaNode) transferDownloadWrong(ctx context.Context, _ core.WriteCloser) {
+	var target protos.ReqTransferDownloadWrong
+	if !unmarshal(ctx, &target) {
+		return
+	}
+	m.mu.Lock()
+	delete(m.backups, target.TaskId)
+	m.mu.Unlock()
+}
+
+func (m *MetaNode) addStorer(fileHash string, sliceNumber uint64, p2pAddress string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, ok := m.files[fileHash]
+	if !ok {
+		return
+	}
+	for _, slice := range file.Slices {
+		if slice.Number != sliceNumber {
+			continue
+		}
+		for _, storer := range slice.Storers {
+			if storer == p2pAddress {
+				return
+			}
+		}
+		slice.Storers = append(slice.Storers, p2pAddress)
+	}
+}
@@ -0,0 +1,211 @@
+// Package simnet runs a simulated SDS network over loopback, for the tests exercising the resource nodes end to end:
+// uploads, backups and downloads across several nodes, with faults injected on the way.
+//
+// The meta node and the chain grpc server run in the test process. The state of a resource node is global to its
+// process (config, keys, tasks), so each resource node runs in a copy of the test binary with its own home folder:
+// the TestMain of the package calls RunNode first, which runs the node instead of the tests when the process was
+// started as one. The nodes are started, killed and restarted as processes, and they are driven through their ipc
+// endpoint. All the traffic between the nodes goes through their links, where the faults are injected.
+//
+// The scenarios run with the other tests, and are skipped with -short:
+//
+//	go test ./tests/simnet
+package simnet
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const pollInterval = 200 * time.Millisecond
+
+// Option configures the simulated network
+type Option func(*Network)
+
+// WithReplicas sets the number of online nodes the meta node keeps each slice on. The default is 2
+func WithReplicas(replicas int) Option {
+	return func(n *Network) {
+		n.replicas = replicas
+	}
+}
+
+// Network is a simulated SDS network over loopback: a stand-in for the chain and for the meta node running in the
+// process, and resource nodes running in copies of the test binary
+type Network struct {
+	Chain    *Chain
+	MetaNode *MetaNode
+	Nodes    []*Node
+
+	dir      string
+	replicas int
+}
+
+// NewNetwork starts the chain and the meta node. dir holds the homes of the nodes
+func NewNetwork(dir string, opts ...Option) (*Network, error) {
+	n := &Network{dir: dir, replicas: 2}
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	var err error
+	if n.Chain, err = NewChain(); err != nil {
+		return nil, err
+	}
+	if n.MetaNode, err = NewMetaNode(n.Chain, n.replicas); err != nil {
+		n.Chain.Stop()
+		return nil, err
+	}
+	return n, nil
+}
+
+// AddNode creates a node registered and activated in an earlier session, and starts it. It goes online by itself
+func (n *Network) AddNode() (*Node, error) {
+	node, err := n.AddUnregisteredNode()
+	if err != nil {
+		return nil, err
+	}
+	info := &protos.PPBaseInfo{
+		P2PAddress:         node.P2PAddress(),
+		WalletAddress:      node.WalletAddress(),
+		NetworkAddress:     node.Link().Address(),
+		BeneficiaryAddress: node.WalletAddress(),
+	}
+	if err = n.MetaNode.AddResourceNode(info, node.p2pPubKey); err != nil {
+		return nil, err
+	}
+	return node, node.Start()
+}
+
+// AddUnregisteredNode creates a node unknown to the meta node, without starting it. Once started, it has to be
+// registered with the registerPP terminal command and activated with MetaNode.Activate before it can start mining
+func (n *Network) AddUnregisteredNode() (*Node, error) {
+	name := "node" + strconv.Itoa(len(n.Nodes))
+	node, err := newNode(filepath.Join(n.dir, name), name, n.Chain, n.MetaNode)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed creating %v", name)
+	}
+	n.Nodes = append(n.Nodes, node)
+	return node, nil
+}
+
+// WaitOnline waits for the node to be mining and connected to the meta node
+func (n *Network) WaitOnline(node *Node, timeout time.Duration) error {
+	return waitFor(timeout, func() (bool, error) {
+		info, ok := n.MetaNode.Node(node.P2PAddress())
+		return ok && info.Online, nil
+	}, "node "+node.Name+" is not online")
+}
+
+// WaitOffline waits for the meta node to notice the node is gone
+func (n *Network) WaitOffline(node *Node, timeout time.Duration) error {
+	return waitFor(timeout, func() (bool, error) {
+		info, ok := n.MetaNode.Node(node.P2PAddress())
+		return ok && !info.Online, nil
+	}, "node "+node.Name+" is still online")
+}
+
+// Upload uploads the file from the node. It returns the hash of the file once every slice is stored by a node
+func (n *Network) Upload(node *Node, path string, timeout time.Duration) (string, error) {
+	existing := make(map[string]bool)
+	for _, hash := range n.MetaNode.Files() {
+		existing[hash] = true
+	}
+	if _, err := node.Terminal("upload", path); err != nil {
+		return "", err
+	}
+
+	var fileHash string
+	err := waitFor(timeout, func() (bool, error) {
+		for _, hash := range n.MetaNode.Files() {
+			if !existing[hash] {
+				fileHash = hash
+				return true, nil
+			}
+		}
+		return false, nil
+	}, "the upload of "+path+" didn't start")
+	if err != nil {
+		return "", err
+	}
+	return fileHash, n.WaitStored(fileHash, 1, timeout)
+}
+
+// WaitStored waits for every slice of the file to be stored by at least replicas online nodes
+func (n *Network) WaitStored(fileHash string, replicas int, timeout time.Duration) error {
+	return waitFor(timeout, func() (bool, error) {
+		file, ok := n.MetaNode.File(fileHash)
+		if !ok {
+			return false, errors.Errorf("unknown file %v", fileHash)
+		}
+		for _, slice := range file.Slices {
+			online := 0
+			for _, storer := range slice.Storers {
+				if info, ok := n.MetaNode.Node(storer); ok && info.Online {
+					online++
+				}
+			}
+			if online < replicas {
+				return false, nil
+			}
+		}
+		return true, nil
+	}, "file "+fileHash+" is not stored by "+strconv.Itoa(replicas)+" online nodes")
+}
+
+// Download downloads the file to the node, and returns its content. Only the owner of the file can download it
+func (n *Network) Download(node *Node, fileHash string, timeout time.Duration) ([]byte, error) {
+	file, ok := n.MetaNode.File(fileHash)
+	if !ok {
+		return nil, errors.Errorf("unknown file %v", fileHash)
+	}
+	name := "simnet-" + fileHash
+	path := node.DownloadPath(name)
+	_ = os.Remove(path)
+	if _, err := node.Terminal("download", "sdm://"+file.Owner+"/"+fileHash, name); err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	err := waitFor(timeout, func() (bool, error) {
+		info, err := os.Stat(path)
+		if err != nil || uint64(info.Size()) != file.Size {
+			return false, nil
+		}
+		data, err = os.ReadFile(path)
+		return err == nil, err
+	}, "file "+fileHash+" was not downloaded by node "+node.Name)
+	return data, err
+}
+
+// Close stops the nodes, the meta node and the chain
+func (n *Network) Close() {
+	for _, node := range n.Nodes {
+		_ = node.Stop()
+		node.Link().Close()
+	}
+	n.MetaNode.Stop()
+	n.Chain.Stop()
+}
+
+func waitFor(timeout time.Duration, condition func() (bool, error), message string) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := condition()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.Errorf("%v after %v", message, timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
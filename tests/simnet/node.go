@@ -0,0 +1,268 @@
+package simnet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/stratosnet/sds/cmd/common"
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/nat"
+	"github.com/stratosnet/sds/pp/serv"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/rpc"
+)
+
+const (
+	nodePassword = "simnet"
+	stopTimeout  = 10 * time.Second
+	// nodeHomeEnv is set to the home of the node in the processes started as a node
+	nodeHomeEnv = "SIMNET_NODE_HOME"
+)
+
+var (
+	portsMu   sync.Mutex
+	usedPorts = make(map[int]bool)
+)
+
+// Node is a resource node of the simulated network, running in a copy of the test binary. The other nodes and the meta
+// node reach it through its link
+type Node struct {
+	Name string
+
+	home          string
+	link          *Link
+	p2pAddress    string
+	p2pPubKey     []byte
+	walletAddress string
+
+	mu      sync.Mutex
+	process *exec.Cmd
+	done    chan struct{}
+}
+
+// newNode creates the keys and the config of a node in home
+func newNode(home, name string, chain *Chain, metaNode *MetaNode) (*Node, error) {
+	n := &Node{Name: name, home: home}
+	accountsPath := filepath.Join(home, "accounts")
+
+	mnemonic, err := fwtypes.NewMnemonic()
+	if err != nil {
+		return nil, err
+	}
+	walletAddress, _, err := fwtypes.CreateWallet(accountsPath, name, nodePassword, mnemonic, "", setting.HDPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating the wallet")
+	}
+	n.walletAddress = fwtypes.WalletAddressBytesToBech32(walletAddress.Bytes())
+
+	p2pKey := fwed25519.GenPrivKey()
+	p2pAddress, err := fwtypes.CreateP2PKey(accountsPath, "p2pkey", nodePassword, hex.EncodeToString(p2pKey.Bytes()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating the P2P key")
+	}
+	n.p2pAddress = fwtypes.P2PAddressBytesToBech32(p2pAddress.Bytes())
+	n.p2pPubKey = p2pKey.PubKey().Bytes()
+
+	localPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	if n.link, err = NewLink(net.JoinHostPort("127.0.0.1", localPort)); err != nil {
+		return nil, err
+	}
+
+	cfg := setting.DefaultConfig()
+	cfg.Blockchain.GrpcServer = chain.Address()
+	cfg.Blockchain.Insecure = true
+	cfg.Keys.P2PAddress = n.p2pAddress
+	cfg.Keys.P2PPassword = nodePassword
+	cfg.Keys.WalletAddress = n.walletAddress
+	cfg.Keys.WalletPassword = nodePassword
+	cfg.Keys.BeneficiaryAddress = n.walletAddress
+	cfg.Node.Debug = true
+	connectivity := &cfg.Node.Connectivity
+	connectivity.SeedMetaNode.P2PAddress = metaNode.P2PAddress()
+	connectivity.SeedMetaNode.P2PPublicKey = metaNode.P2PPubKey()
+	connectivity.SeedMetaNode.NetworkAddress = metaNode.Address()
+	connectivity.NetworkAddress = "127.0.0.1"
+	connectivity.NetworkPort = n.link.Port()
+	connectivity.LocalPort = localPort
+	connectivity.Nat = nat.MethodNone
+	ports := []*string{&connectivity.MetricsPort, &connectivity.RpcPort, &cfg.Monitor.Port,
+		&cfg.Streaming.InternalPort, &cfg.Streaming.RestPort, &cfg.WebServer.Port}
+	for _, port := range ports {
+		if *port, err = freePort(); err != nil {
+			return nil, err
+		}
+	}
+	if err = os.MkdirAll(filepath.Join(home, "config"), 0700); err != nil {
+		return nil, err
+	}
+	if err = utils.WriteTomlConfig(cfg, filepath.Join(home, "config", "config.toml")); err != nil {
+		return nil, errors.Wrap(err, "failed writing the config")
+	}
+	return n, nil
+}
+
+func (n *Node) P2PAddress() string {
+	return n.p2pAddress
+}
+
+func (n *Node) WalletAddress() string {
+	return n.walletAddress
+}
+
+func (n *Node) Home() string {
+	return n.home
+}
+
+// Link is the network path of the node. Its faults apply to the traffic the node receives from the other nodes and
+// the meta node connecting back to it
+func (n *Node) Link() *Link {
+	return n.link
+}
+
+// DownloadPath is where the node saves the files downloaded as name
+func (n *Node) DownloadPath(name string) string {
+	return filepath.Join(n.home, "download", name)
+}
+
+// RunNode runs the resource node and exits when the process was started as a node of a simulated network, and returns
+// at once otherwise. The TestMain of the packages using the network calls it before running the tests
+func RunNode() {
+	home := os.Getenv(nodeHomeEnv)
+	if home == "" {
+		return
+	}
+	cmd := &cobra.Command{
+		Use:               "start",
+		PersistentPreRunE: common.RootPreRunE,
+		PreRunE:           common.NodePreRunE,
+		RunE:              common.NodePP,
+		SilenceUsage:      true,
+	}
+	cmd.PersistentFlags().String(common.Home, home, "path for the node")
+	cmd.PersistentFlags().String(common.Config, common.DefaultConfigPath, "configuration file path")
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// Start runs the node in a copy of the test binary. Its output goes to node.log in the home of the node
+func (n *Node) Start() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.process != nil {
+		return errors.Errorf("node %v is already running", n.Name)
+	}
+	binary, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	output, err := os.OpenFile(filepath.Join(n.home, "node.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	process := exec.Command(binary)
+	process.Env = append(os.Environ(), nodeHomeEnv+"="+n.home)
+	process.Stdout = output
+	process.Stderr = output
+	if err = process.Start(); err != nil {
+		_ = output.Close()
+		return errors.Wrapf(err, "failed starting node %v", n.Name)
+	}
+	done := make(chan struct{})
+	go func() {
+		_ = process.Wait()
+		_ = output.Close()
+		close(done)
+	}()
+	n.process = process
+	n.done = done
+	return nil
+}
+
+// Stop interrupts the node and waits for it to exit, killing it after a while
+func (n *Node) Stop() error {
+	return n.signal(syscall.SIGINT)
+}
+
+// Kill kills the node process, as if the node crashed
+func (n *Node) Kill() error {
+	return n.signal(syscall.SIGKILL)
+}
+
+func (n *Node) Running() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.process != nil
+}
+
+func (n *Node) signal(sig os.Signal) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.process == nil {
+		return nil
+	}
+	if err := n.process.Process.Signal(sig); err != nil {
+		return err
+	}
+	select {
+	case <-n.done:
+	case <-time.After(stopTimeout):
+		_ = n.process.Process.Kill()
+		<-n.done
+	}
+	n.process = nil
+	return nil
+}
+
+// Terminal calls a terminal command of the running node over IPC, e.g. Terminal("upload", path)
+func (n *Node) Terminal(method string, params ...string) (string, error) {
+	c, err := rpc.Dial(setting.DefaultIPCEndpoint(n.home))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed connecting to node %v", n.Name)
+	}
+	defer c.Close()
+
+	var result serv.CmdResult
+	if err = c.Call(&result, "sds_"+method, append([]string{uuid.New().String()}, params...)); err != nil {
+		return "", err
+	}
+	return result.Msg, nil
+}
+
+// freePort returns a port free on loopback, and never the same one twice: the ports are only bound once the nodes start
+func freePort() (string, error) {
+	portsMu.Lock()
+	defer portsMu.Unlock()
+	for {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return "", err
+		}
+		port := listener.Addr().(*net.TCPAddr).Port
+		_ = listener.Close()
+		if !usedPorts[port] {
+			usedPorts[port] = true
+			return strconv.Itoa(port), nil
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package simnet
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stratosnet/sds/framework/msg/header"
+)
+
+const (
+	timeout  = 2 * time.Minute
+	fileSize = 40 * 1024 * 1024
+)
+
+func TestMain(m *testing.M) {
+	RunNode()
+	os.Exit(m.Run())
+}
+
+// TestUploadBackupDownload uploads a file, kills a node storing it, and downloads the file from the remaining replicas
+// while the meta node drops some of the download reports
+func TestUploadBackupDownload(t *testing.T) {
+	network := startNetwork(t, 4)
+	uploader := network.Nodes[0]
+	content, fileHash := upload(t, network, uploader)
+	if err := network.WaitStored(fileHash, 2, timeout); err != nil {
+		t.Fatal(err)
+	}
+
+	file, _ := network.MetaNode.File(fileHash)
+	killStorer(t, network, file.Slices[0], uploader)
+	network.MetaNode.SetFault(header.ReqReportDownloadResult, Fault{DropRate: 0.5, Delay: 100 * time.Millisecond})
+
+	download(t, network, uploader, fileHash, content)
+}
+
+// TestBackupAfterNodeKill kills a node storing a file, and waits for the backup notices of the meta node to bring the
+// slices back to two online replicas
+func TestBackupAfterNodeKill(t *testing.T) {
+	network := startNetwork(t, 4)
+	uploader := network.Nodes[0]
+	content, fileHash := upload(t, network, uploader)
+	if err := network.WaitStored(fileHash, 2, timeout); err != nil {
+		t.Fatal(err)
+	}
+	backups := network.MetaNode.Received(header.ReqReportBackupSliceResult)
+
+	file, _ := network.MetaNode.File(fileHash)
+	killed := killStorer(t, network, file.Slices[0], uploader)
+	if err := network.WaitOffline(killed, timeout); err != nil {
+		t.Fatal(err)
+	}
+	if err := network.WaitStored(fileHash, 2, timeout); err != nil {
+		t.Fatal(err)
+	}
+	if network.MetaNode.Received(header.ReqReportBackupSliceResult) <= backups {
+		t.Fatalf("no slice was backed up after %v was killed", killed.Name)
+	}
+
+	download(t, network, uploader, fileHash, content)
+}
+
+// TestLinkFaults uploads a file while the links are slow and one of the nodes drops half the connections, then downloads
+// it while one of the nodes storing it is cut from the network without the meta node noticing
+func TestLinkFaults(t *testing.T) {
+	network := startNetwork(t, 4)
+	uploader := network.Nodes[0]
+	network.MetaNode.Link().SetDelay(20 * time.Millisecond)
+	for _, node := range network.Nodes {
+		node.Link().SetDelay(50 * time.Millisecond)
+	}
+	flaky := network.Nodes[1]
+	flaky.Link().SetDropRate(0.5)
+	content, fileHash := upload(t, network, uploader)
+	if err := network.WaitStored(fileHash, 2, timeout); err != nil {
+		t.Fatal(err)
+	}
+
+	flaky.Link().SetDropRate(0)
+	file, _ := network.MetaNode.File(fileHash)
+	for _, node := range network.Nodes[1:] {
+		if node.P2PAddress() == file.Slices[0].Storers[0] {
+			node.Link().Cut()
+		}
+	}
+
+	download(t, network, uploader, fileHash, content)
+}
+
+// TestRegisterNewNode registers a node unknown to the meta node, activates it and starts mining
+func TestRegisterNewNode(t *testing.T) {
+	network := startNetwork(t, 0)
+
+	node, err := network.AddUnregisteredNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = node.Start(); err != nil {
+		t.Fatal(err)
+	}
+	err = waitFor(timeout, func() (bool, error) {
+		_, err := node.Terminal("registerPP")
+		return err == nil, nil
+	}, "the node didn't register")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = waitFor(timeout, func() (bool, error) {
+		info, ok := network.MetaNode.Node(node.P2PAddress())
+		return ok && info.Registered, nil
+	}, "the meta node didn't register the node")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = network.MetaNode.Activate(node.P2PAddress()); err != nil {
+		t.Fatal(err)
+	}
+	err = waitFor(timeout, func() (bool, error) {
+		_, err := node.Terminal("startMining")
+		return err == nil, nil
+	}, "the node didn't start mining")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = network.WaitOnline(node, timeout); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// startNetwork starts a network of nodes registered beforehand, and waits for them to be online
+func startNetwork(t *testing.T, nodes int) *Network {
+	if testing.Short() {
+		t.Skip("the simulated network is skipped in short mode")
+	}
+	network, err := NewNetwork(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(network.Close)
+
+	for i := 0; i < nodes; i++ {
+		if _, err = network.AddNode(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, node := range network.Nodes {
+		if err = network.WaitOnline(node, timeout); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return network
+}
+
+// upload uploads a random file from the node, and returns its content and its hash once it is stored
+func upload(t *testing.T, network *Network, node *Node) ([]byte, string) {
+	content := make([]byte, fileSize)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(node.Home(), "upload.bin")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	fileHash, err := network.Upload(node, path, timeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content, fileHash
+}
+
+func download(t *testing.T, network *Network, node *Node, fileHash string, content []byte) {
+	downloaded, err := network.Download(node, fileHash, timeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(downloaded, content) {
+		t.Fatal("the downloaded file differs from the uploaded one")
+	}
+}
+
+// killStorer kills a node storing the slice, other than the one given
+func killStorer(t *testing.T, network *Network, slice *StoredSlice, other *Node) *Node {
+	for _, node := range network.Nodes {
+		for _, storer := range slice.Storers {
+			if node != other && node.P2PAddress() == storer {
+				if err := node.Kill(); err != nil {
+					t.Fatal(err)
+				}
+				return node
+			}
+		}
+	}
+	t.Fatal("no node to kill stores the slice")
+	return nil
+}